@@ -3,7 +3,8 @@ package main
 import "time"
 
 type appConfig struct {
-	GrpcPort string `mapstructure:"AUTH_GRPC_PORT"`
+	Environment string `mapstructure:"AUTH_ENVIRONMENT"` // the internal service key may only be missing in development
+	GrpcPort    string `mapstructure:"AUTH_GRPC_PORT"`
 	// Tracing
 	TracingEnable            bool   `mapstructure:"AUTH_TRACING_ENABLE"`
 	TracingJaegerEnable      bool   `mapstructure:"AUTH_TRACING_JAEGER_ENABLE"`
@@ -23,6 +24,8 @@ type appConfig struct {
 	AccessTokenDuration  time.Duration `mapstructure:"AUTH_ACCESS_TOKEN_DURATION"`
 	RefreshTokenDuration time.Duration `mapstructure:"AUTH_REFRESH_TOKEN_DURATION"`
 	UserSessionDuration  time.Duration `mapstructure:"AUTH_USER_SESSION_DURATION"`
+	// Internal Service
+	ServiceKey string `mapstructure:"AUTH_SERVICE_KEY"` // shared with the services calling it, the internal service refuses every call without it
 	// Storage
 	RedisUrl              string `mapstructure:"AUTH_CACHE_URL"`
 	DatabaseMigrationPath string `mapstructure:"AUTH_DATABASE_MIGRATION_PATH"`
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"github.com/escalopa/fingo/pkg/global"
@@ -25,6 +26,9 @@ func main() {
 
 	// Load cofigurations
 	global.CheckError(global.LoadConfig(&cfg, "app", "./auth", "env"), "failed to load configurations")
+	if cfg.ServiceKey == "" && cfg.Environment != "development" {
+		global.CheckError(errors.New("AUTH_SERVICE_KEY is empty"), "failed to load internal service key")
+	}
 
 	ph := hasher.NewBcryptHasher()
 	v := validator.NewValidator()
@@ -24,6 +24,7 @@ func start(appCtx context.Context, uc *application.UseCases) error {
 	opts := []grpc.ServerOption{grpc.ChainUnaryInterceptor(
 		interceptors.TracingUnaryInterceptor(),
 		interceptors.LoggingUnaryInterceptor(),
+		interceptors.ServiceKeyUnaryInterceptor(mygrpc.InternalService, cfg.ServiceKey),
 	)}
 
 	// Load TLS certificates
@@ -35,8 +36,10 @@ func start(appCtx context.Context, uc *application.UseCases) error {
 
 	// Create a new gRPC server
 	handler := mygrpc.NewAuthHandler(uc)
+	internalHandler := mygrpc.NewAuthInternalHandler(uc)
 	server := grpc.NewServer(opts...)
 	pb.RegisterAuthServiceServer(server, handler)
+	pb.RegisterAuthInternalServiceServer(server, internalHandler)
 	reflection.Register(server)
 
 	// Terminate server on shutdown signals
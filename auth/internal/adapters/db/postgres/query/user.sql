@@ -19,6 +19,12 @@ FROM users
 WHERE email = $1
 LIMIT 1;
 
+-- name: GetUserByUsername :one
+SELECT *
+FROM users
+WHERE username = $1
+LIMIT 1;
+
 -- name: DeleteUserByID :execrows
 DELETE
 FROM users
@@ -18,6 +18,7 @@ type Querier interface {
 	GetSessionByID(ctx context.Context, id uuid.UUID) (Session, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
 	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserByUsername(ctx context.Context, username string) (User, error)
 	GetUserDevices(ctx context.Context, userID uuid.UUID) ([]GetUserDevicesRow, error)
 	GetUserSessions(ctx context.Context, userID uuid.UUID) ([]Session, error)
 	UpdateSessionTokens(ctx context.Context, arg UpdateSessionTokensParams) (int64, error)
@@ -67,6 +67,19 @@ func (ur *UserRepository) GetUserByEmail(ctx context.Context, email string) (cor
 	return fromDbUserToCore(user)
 }
 
+func (ur *UserRepository) GetUserByUsername(ctx context.Context, username string) (core.User, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "UserRepository.GetUserByUsername")
+	defer span.End()
+	user, err := ur.q.GetUserByUsername(ctx, username)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return core.User{}, errs.B(err).Code(errs.NotFound).Msgf("no user found with the given username, username: %s", username).Err()
+		}
+		return core.User{}, errs.B(err).Code(errs.Internal).Msgf("failed to get user with username: %s", username).Err()
+	}
+	return fromDbUserToCore(user)
+}
+
 func (ur *UserRepository) DeleteUserByID(ctx context.Context, id uuid.UUID) error {
 	ctx, span := tracer.Tracer().Start(ctx, "UserRepository.DeleteUserByID")
 	defer span.End()
@@ -147,6 +147,52 @@ func TestUserRepository_GetUserByEmail(t *testing.T) {
 	}
 }
 
+func TestUserRepository_GetUserByUsername(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ur, err := NewUserRepository(testPGConn)
+	require.NoError(t, err)
+	// Create user
+	user := randomUser()
+	// Create test cases
+	testCases := []struct {
+		name      string
+		arg       string // username
+		wantError bool
+	}{
+		{
+			name:      "valid username",
+			arg:       user.Username,
+			wantError: false,
+		},
+		{
+			name:      "not found",
+			arg:       gofakeit.Username() + gofakeit.DigitN(8),
+			wantError: true,
+		},
+	}
+	// Create user
+	err = ur.CreateUser(ctx, user)
+	if err != nil {
+		t.Errorf("unexcpected error, got %s", err)
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			u, err := ur.GetUserByUsername(ctx, tc.arg)
+			if err != nil && !tc.wantError {
+				t.Errorf("unexpected error, got %s", err)
+			}
+			if err == nil && tc.wantError {
+				t.Errorf("expected error, got nil")
+			}
+			if err == nil {
+				require.Equal(t, tc.arg, u.Username)
+				require.Equal(t, user.ID, u.ID)
+			}
+		})
+	}
+}
+
 func TestUserRepository_DeleteUserByID(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -17,7 +17,11 @@ var (
 	unauthorizedRequests = []string{
 		"/pb.AuthService/Signup",
 		"/pb.AuthService/Signin",
+		// Internal requests are authenticated with the service key instead
+		"/pb.AuthInternalService/LookupUser",
 	}
+	// InternalService is the prefix of the requests that require the service key
+	InternalService = "/pb.AuthInternalService/"
 )
 
 type AuthInterceptor struct {
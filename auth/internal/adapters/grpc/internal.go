@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/escalopa/fingo/auth/internal/application"
+	"github.com/escalopa/fingo/pb"
+	"github.com/escalopa/fingo/pkg/tracer"
+)
+
+// AuthInternalHandler serves the requests of the other services, it's guarded by the service key
+type AuthInternalHandler struct {
+	uc *application.UseCases
+	pb.UnimplementedAuthInternalServiceServer
+}
+
+func NewAuthInternalHandler(uc *application.UseCases) *AuthInternalHandler {
+	return &AuthInternalHandler{uc: uc}
+}
+
+func (h *AuthInternalHandler) LookupUser(ctx context.Context, req *pb.LookupUserRequest) (_ *pb.LookupUserResponse, err error) {
+	ctx, span := tracer.Tracer().Start(ctx, "AuthInternalHandler.LookupUser")
+	defer span.End()
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+		}
+	}()
+	user, err := h.uc.LookupUser.Execute(ctx, application.LookupUserParams{
+		Username: req.GetUsername(),
+		Email:    req.GetEmail(),
+		UserID:   req.GetUserId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LookupUserResponse{
+		UserId:    user.ID.String(),
+		Username:  user.Username,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Email:     user.Email,
+	}, nil
+}
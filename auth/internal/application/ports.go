@@ -12,6 +12,7 @@ type UserRepository interface {
 	CreateUser(ctx context.Context, arg core.CreateUserParams) error
 	GetUserByID(ctx context.Context, id uuid.UUID) (core.User, error)
 	GetUserByEmail(ctx context.Context, email string) (core.User, error)
+	GetUserByUsername(ctx context.Context, username string) (core.User, error)
 }
 
 // SessionRepository is an interface for interacting with sessions in the database
@@ -20,6 +20,7 @@ func NewUseCases(opts ...func(*UseCases)) *UseCases {
 	}
 	u.Query = Query{
 		GetUserDevices: NewGetUserDevicesCommand(u.v, u.sr),
+		LookupUser:     NewLookupUserCommand(u.v, u.ur),
 	}
 	u.Command = Command{
 		Signin:     NewSigninCommand(u.v, u.h, u.tg, u.ur, u.sr, u.tr, u.mp),
@@ -74,6 +75,7 @@ func WithValidator(v Validator) func(*UseCases) {
 
 type Query struct {
 	GetUserDevices GetUserDevicesCommand
+	LookupUser     LookupUserCommand
 }
 
 type Command struct {
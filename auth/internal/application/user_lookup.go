@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+
+	"github.com/escalopa/fingo/auth/internal/core"
+	"github.com/google/uuid"
+)
+
+// LookupUserParams contains the parameters for the LookupUserCommand, exactly one of them must be set
+type LookupUserParams struct {
+	Username string `validate:"required_without_all=Email UserID,excluded_with=Email UserID,omitempty,alphanum"`
+	Email    string `validate:"required_without_all=Username UserID,excluded_with=UserID,omitempty,email"`
+	UserID   string `validate:"required_without_all=Username Email,omitempty,uuid"`
+}
+
+// LookupUserCommand is the interface for the LookupUserCommandImpl
+type LookupUserCommand interface {
+	Execute(ctx context.Context, params LookupUserParams) (core.User, error)
+}
+
+// LookupUserCommandImpl is the implementation of the LookupUserCommand
+type LookupUserCommandImpl struct {
+	v  Validator
+	ur UserRepository
+}
+
+// Execute finds a user by its username, email or id, so that other services address users without knowing their ids
+// and get the contact details of the users they notify.
+// It's only exposed to the other services, which authenticate with the service key, so no user is required in ctx
+func (c *LookupUserCommandImpl) Execute(ctx context.Context, params LookupUserParams) (core.User, error) {
+	var response core.User
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "LookupUserCommand.Execute")
+		defer span.End()
+		err := c.v.Validate(ctx, params)
+		if err != nil {
+			return err
+		}
+		var user core.User
+		switch {
+		case params.Username != "":
+			user, err = c.ur.GetUserByUsername(ctx, params.Username)
+		case params.Email != "":
+			user, err = c.ur.GetUserByEmail(ctx, params.Email)
+		default:
+			user, err = c.ur.GetUserByID(ctx, uuid.MustParse(params.UserID))
+		}
+		if err != nil {
+			return err
+		}
+		response = user
+		return nil
+	})
+	return response, err
+}
+
+// NewLookupUserCommand returns a new LookupUserCommand with the passed dependencies
+func NewLookupUserCommand(
+	v Validator,
+	ur UserRepository,
+) LookupUserCommand {
+	return &LookupUserCommandImpl{v: v, ur: ur}
+}
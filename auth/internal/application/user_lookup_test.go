@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/auth/internal/core"
+	"github.com/escalopa/fingo/auth/internal/mock"
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupUser_Execute(t *testing.T) {
+
+	tests := []struct {
+		name   string
+		params LookupUserParams
+		stubs  func(params LookupUserParams, v *mock.MockValidator, ur *mock.MockUserRepository) context.Context
+		check  func(t *testing.T, params LookupUserParams, user core.User, err error)
+	}{
+		{
+			name:   "success by username",
+			params: LookupUserParams{Username: gofakeit.Username()},
+			stubs: func(params LookupUserParams, v *mock.MockValidator, ur *mock.MockUserRepository) context.Context {
+				v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil)
+				ur.EXPECT().GetUserByUsername(gomock.Any(), params.Username).Return(core.User{Username: params.Username}, nil)
+				return contextutils.SetUserID(context.Background(), gofakeit.UUID())
+			},
+			check: func(t *testing.T, params LookupUserParams, user core.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, params.Username, user.Username)
+			},
+		},
+		{
+			name:   "success by email",
+			params: LookupUserParams{Email: gofakeit.Email()},
+			stubs: func(params LookupUserParams, v *mock.MockValidator, ur *mock.MockUserRepository) context.Context {
+				v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil)
+				ur.EXPECT().GetUserByEmail(gomock.Any(), params.Email).Return(core.User{Email: params.Email}, nil)
+				return contextutils.SetUserID(context.Background(), gofakeit.UUID())
+			},
+			check: func(t *testing.T, params LookupUserParams, user core.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, params.Email, user.Email)
+			},
+		},
+		{
+			name:   "success by user id",
+			params: LookupUserParams{UserID: gofakeit.UUID()},
+			stubs: func(params LookupUserParams, v *mock.MockValidator, ur *mock.MockUserRepository) context.Context {
+				v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil)
+				ur.EXPECT().GetUserByID(gomock.Any(), uuid.MustParse(params.UserID)).Return(core.User{ID: uuid.MustParse(params.UserID)}, nil)
+				return contextutils.SetUserID(context.Background(), gofakeit.UUID())
+			},
+			check: func(t *testing.T, params LookupUserParams, user core.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, params.UserID, user.ID.String())
+			},
+		},
+		{
+			name:   "validation error",
+			params: LookupUserParams{},
+			stubs: func(params LookupUserParams, v *mock.MockValidator, ur *mock.MockUserRepository) context.Context {
+				v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(gofakeit.Error())
+				return contextutils.SetUserID(context.Background(), gofakeit.UUID())
+			},
+			check: func(t *testing.T, params LookupUserParams, user core.User, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:   "success without user in context",
+			params: LookupUserParams{Username: gofakeit.Username()},
+			stubs: func(params LookupUserParams, v *mock.MockValidator, ur *mock.MockUserRepository) context.Context {
+				v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil)
+				ur.EXPECT().GetUserByUsername(gomock.Any(), params.Username).Return(core.User{Username: params.Username}, nil)
+				return context.Background()
+			},
+			check: func(t *testing.T, params LookupUserParams, user core.User, err error) {
+				require.NoError(t, err)
+				require.Equal(t, params.Username, user.Username)
+			},
+		},
+		{
+			name:   "user not found",
+			params: LookupUserParams{Username: gofakeit.Username()},
+			stubs: func(params LookupUserParams, v *mock.MockValidator, ur *mock.MockUserRepository) context.Context {
+				v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil)
+				ur.EXPECT().GetUserByUsername(gomock.Any(), params.Username).Return(core.User{}, gofakeit.Error())
+				return contextutils.SetUserID(context.Background(), gofakeit.UUID())
+			},
+			check: func(t *testing.T, params LookupUserParams, user core.User, err error) {
+				require.Error(t, err)
+				require.Equal(t, core.User{}, user)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			v := mock.NewMockValidator(ctrl)
+			ur := mock.NewMockUserRepository(ctrl)
+
+			cmd := NewLookupUserCommand(v, ur)
+
+			ctx := tt.stubs(tt.params, v, ur)
+			user, err := cmd.Execute(ctx, tt.params)
+			tt.check(t, tt.params, user, err)
+		})
+	}
+}
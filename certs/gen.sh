@@ -23,3 +23,6 @@ openssl x509 -req -in server-req.pem -days 365 -CA ca-cert.pem -CAkey ca-key.pem
 
 #echo "Client's signed certificate"
 #openssl x509 -in client-cert.pem -noout -text
+
+## 6. Generate the wallet's card vault key once, cards encrypted with a previous key can't be decrypted anymore
+[ -f card-vault.key ] || openssl rand -base64 32 > card-vault.key
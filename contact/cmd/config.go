@@ -17,11 +17,15 @@ type config struct {
 	RabbitmqVerificationCodeQueueName   string `mapstructure:"CONTACT_RABBITMQ_VERIFICATION_CODE_QUEUE_NAME"`
 	RabbitmqResetPasswordTokenQueueName string `mapstructure:"CONTACT_RABBITMQ_RESET_PASSWORD_TOKEN_QUEUE_NAME"`
 	RabbitmqNewSigninSessionQueueName   string `mapstructure:"CONTACT_RABBITMQ_NEW_SIGNIN_SESSION_QUEUE_NAME"`
+	RabbitmqPaymentRequestQueueName     string `mapstructure:"CONTACT_RABBITMQ_PAYMENT_REQUEST_QUEUE_NAME"`
+	RabbitmqBudgetAlertQueueName        string `mapstructure:"CONTACT_RABBITMQ_BUDGET_ALERT_QUEUE_NAME"`
 	// Couier
 	CourierToken                      string `mapstructure:"CONTACT_COURIER_TOKEN"`
 	CourierVerificationTemplateID     string `mapstructure:"CONTACT_COURIER_VERIFICATION_TEMPLATE_ID"`
 	CourierResetPasswordTemplateID    string `mapstructure:"CONTACT_COURIER_RESET_PASSWORD_TEMPLATE_ID"`
 	CourierNewSigninSessionTemplateID string `mapstructure:"CONTACT_COURIER_NEW_SIGNIN_SESSION_TEMPLATE_ID"`
+	CourierPaymentRequestTemplateID   string `mapstructure:"CONTACT_COURIER_PAYMENT_REQUEST_TEMPLATE_ID"`
+	CourierBudgetAlertTemplateID      string `mapstructure:"CONTACT_COURIER_BUDGET_ALERT_TEMPLATE_ID"`
 }
 
 var cfg config
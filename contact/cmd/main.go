@@ -41,6 +41,8 @@ func main() {
 		mycourier.WithVerificationTemplate(cfg.CourierVerificationTemplateID),
 		mycourier.WithResetPasswordTemplate(cfg.CourierResetPasswordTemplateID),
 		mycourier.WithNewSignInSessionTemplate(cfg.CourierNewSigninSessionTemplateID),
+		mycourier.WithPaymentRequestTemplate(cfg.CourierPaymentRequestTemplateID),
+		mycourier.WithBudgetAlertTemplate(cfg.CourierBudgetAlertTemplateID),
 	)
 	global.CheckError(err, "failed to create courier sender")
 	defer func() {
@@ -57,6 +59,8 @@ func main() {
 		rabbitmq.WithVerificationCodeQueue(cfg.RabbitmqVerificationCodeQueueName),
 		rabbitmq.WithResetPasswordTokenQueue(cfg.RabbitmqResetPasswordTokenQueueName),
 		rabbitmq.WithNewSignInSessionQueue(cfg.RabbitmqNewSigninSessionQueueName),
+		rabbitmq.WithPaymentRequestQueue(cfg.RabbitmqPaymentRequestQueueName),
+		rabbitmq.WithBudgetAlertQueue(cfg.RabbitmqBudgetAlertQueueName),
 	)
 	global.CheckError(err, "failed to create rabbitmq consumer")
 	log.Println("connected to rabbitmq")
@@ -18,6 +18,8 @@ type Sender struct {
 	vt   string // verificationTemplate
 	rpt  string // resetPasswordTemplate
 	nsst string // newSignInSessionTemplate
+	prt  string // paymentRequestTemplate
+	bat  string // budgetAlertTemplate
 	exp  time.Duration
 }
 
@@ -39,6 +41,12 @@ func New(token string, opts ...func(*Sender)) (*Sender, error) {
 	if s.nsst == "" {
 		return nil, errs.B().Msg("CourierSender: New sign in session template code is required").Err()
 	}
+	if s.prt == "" {
+		return nil, errs.B().Msg("CourierSender: Payment request template code is required").Err()
+	}
+	if s.bat == "" {
+		return nil, errs.B().Msg("CourierSender: Budget alert template code is required").Err()
+	}
 	if s.exp == 0 {
 		return nil, errs.B().Msg("CourierSender: Expiration time is required").Err()
 	}
@@ -74,6 +82,20 @@ func WithNewSignInSessionTemplate(templateCode string) func(*Sender) {
 	}
 }
 
+// WithPaymentRequestTemplate sets the payment request template code
+func WithPaymentRequestTemplate(templateCode string) func(*Sender) {
+	return func(s *Sender) {
+		s.prt = templateCode
+	}
+}
+
+// WithBudgetAlertTemplate sets the budget alert template code
+func WithBudgetAlertTemplate(templateCode string) func(*Sender) {
+	return func(s *Sender) {
+		s.bat = templateCode
+	}
+}
+
 // SendVerificationCode sends a verification code to the given email
 func (c *Sender) SendVerificationCode(ctx context.Context, params core.SendVerificationCodeMessage) error {
 	ctx, span := tracer.Tracer().Start(ctx, "courier.SendVerificationCode")
@@ -144,6 +166,58 @@ func (c *Sender) SendNewSignInSession(ctx context.Context, params core.SendNewSi
 	return err
 }
 
+// SendPaymentRequest sends an email to notify the payer about money requested by another user
+func (c *Sender) SendPaymentRequest(ctx context.Context, params core.SendPaymentRequestMessage) error {
+	ctx, span := tracer.Tracer().Start(ctx, "courier.SendPaymentRequest")
+	defer span.End()
+	requestID, err := c.c.SendMessage(ctx,
+		courier.SendMessageRequestBody{
+			Message: map[string]interface{}{
+				"to":       map[string]string{"email": params.Email},
+				"template": c.prt,
+				"data": map[string]string{
+					"name":           params.Name,
+					"requester_name": params.RequesterName,
+					"amount":         params.Amount,
+					"note":           params.Note,
+					"expires_at":     params.ExpiresAt,
+				},
+			},
+		},
+	)
+	if err != nil {
+		return errs.B(err).Code(errs.Unknown).Msgf("failed to send payment request email, request ID: %s", requestID).Err()
+	}
+	return err
+}
+
+// SendBudgetAlert sends an email to notify the account's owner that the month's spending crossed a threshold of a budget
+func (c *Sender) SendBudgetAlert(ctx context.Context, params core.SendBudgetAlertMessage) error {
+	ctx, span := tracer.Tracer().Start(ctx, "courier.SendBudgetAlert")
+	defer span.End()
+	requestID, err := c.c.SendMessage(ctx,
+		courier.SendMessageRequestBody{
+			Message: map[string]interface{}{
+				"to":       map[string]string{"email": params.Email},
+				"template": c.bat,
+				"data": map[string]string{
+					"name":         params.Name,
+					"account_name": params.AccountName,
+					"category":     params.Category,
+					"threshold":    strconv.Itoa(params.Threshold),
+					"spent":        params.Spent,
+					"budget":       params.Budget,
+					"month":        params.Month,
+				},
+			},
+		},
+	)
+	if err != nil {
+		return errs.B(err).Code(errs.Unknown).Msgf("failed to send budget alert email, request ID: %s", requestID).Err()
+	}
+	return err
+}
+
 // Close closes the connection with the server
 // Since the courier pkg doesn't have `close` function, this function returns nil
 // This function is required to implement the `Sender` interface
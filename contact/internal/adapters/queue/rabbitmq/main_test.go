@@ -28,6 +28,8 @@ func TestMain(m *testing.M) {
 		WithVerificationCodeQueue("verification_code_queue"),
 		WithResetPasswordTokenQueue("reset_password_token_queue"),
 		WithNewSignInSessionQueue("new_sign_in_session_queue"),
+		WithPaymentRequestQueue("payment_request_queue"),
+		WithBudgetAlertQueue("budget_alert_queue"),
 	)
 	if err != nil {
 		log.Fatal(err, "failed to create rabbitmq consumer")
@@ -19,6 +19,8 @@ type Consumer struct {
 	vcq string // verificationCodeQueueName
 	rsq string // resetPasswordTokenQueueName
 	ssq string // newSignInSessionQueueName
+	prq string // paymentRequestQueueName
+	baq string // budgetAlertQueueName
 }
 
 func NewConsumer(url string, opts ...func(*Consumer)) (*Consumer, error) {
@@ -44,6 +46,14 @@ func NewConsumer(url string, opts ...func(*Consumer)) (*Consumer, error) {
 		return nil, errs.B(err).Code(errs.InvalidArgument).
 			Msg("RabbitMQ Consumer: sendNewSignInSessionQueueName is not set").Err()
 	}
+	if r.prq == "" {
+		return nil, errs.B(err).Code(errs.InvalidArgument).
+			Msg("RabbitMQ Consumer: sendPaymentRequestQueueName is not set").Err()
+	}
+	if r.baq == "" {
+		return nil, errs.B(err).Code(errs.InvalidArgument).
+			Msg("RabbitMQ Consumer: sendBudgetAlertQueueName is not set").Err()
+	}
 
 	return r, nil
 }
@@ -66,6 +76,18 @@ func WithNewSignInSessionQueue(name string) func(*Consumer) {
 	}
 }
 
+func WithPaymentRequestQueue(name string) func(*Consumer) {
+	return func(r *Consumer) {
+		r.prq = name
+	}
+}
+
+func WithBudgetAlertQueue(name string) func(*Consumer) {
+	return func(r *Consumer) {
+		r.baq = name
+	}
+}
+
 func (r *Consumer) HandleSendVerificationsCode(handler func(ctx context.Context, params core.SendVerificationCodeMessage) error) error {
 	messages, err := r.setupQueue(r.vcq)
 	if err != nil {
@@ -120,6 +142,42 @@ func (r *Consumer) HandleSendNewSignInSession(handler func(ctx context.Context,
 	return nil
 }
 
+func (r *Consumer) HandleSendPaymentRequest(handler func(ctx context.Context, params core.SendPaymentRequestMessage) error) error {
+	messages, err := r.setupQueue(r.prq)
+	if err != nil {
+		return errs.B(err).Code(errs.InvalidArgument).Msg("failed to setup queue on send payment request").Err()
+	}
+	for d := range messages {
+		go func(d amqp.Delivery) {
+			_, span := tracer.Tracer().Start(context.Background(), "rabbitmq.HandleSendPaymentRequest")
+			defer span.End()
+			var m core.SendPaymentRequestMessage
+			r.handleMessage(d, &m, func(ctx context.Context) error {
+				return handler(ctx, m)
+			})
+		}(d)
+	}
+	return nil
+}
+
+func (r *Consumer) HandleSendBudgetAlert(handler func(ctx context.Context, params core.SendBudgetAlertMessage) error) error {
+	messages, err := r.setupQueue(r.baq)
+	if err != nil {
+		return errs.B(err).Code(errs.InvalidArgument).Msg("failed to setup queue on send budget alert").Err()
+	}
+	for d := range messages {
+		go func(d amqp.Delivery) {
+			_, span := tracer.Tracer().Start(context.Background(), "rabbitmq.HandleSendBudgetAlert")
+			defer span.End()
+			var m core.SendBudgetAlertMessage
+			r.handleMessage(d, &m, func(ctx context.Context) error {
+				return handler(ctx, m)
+			})
+		}(d)
+	}
+	return nil
+}
+
 func (r *Consumer) handleMessage(msg amqp.Delivery, body interface{}, handle func(context.Context) error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	var err error
@@ -37,6 +37,8 @@ func (s *Server) Start() error {
 		case s.errChan <- s.handleSendEmailVerificationCode():
 		case s.errChan <- s.handleSendResetPasswordToken():
 		case s.errChan <- s.handleSendNewSignInSessionCode():
+		case s.errChan <- s.handleSendPaymentRequest():
+		case s.errChan <- s.handleSendBudgetAlert():
 		}
 	}()
 
@@ -95,3 +97,33 @@ func (s *Server) handleSendNewSignInSessionCode() error {
 	})
 	return err
 }
+
+func (s *Server) handleSendPaymentRequest() error {
+	err := s.cons.HandleSendPaymentRequest(func(ctx context.Context, params core.SendPaymentRequestMessage) error {
+		return s.uc.SendPaymentRequest.Execute(ctx, application.SendPaymentRequestCommandParam{
+			Name:          params.Name,
+			Email:         params.Email,
+			RequesterName: params.RequesterName,
+			Amount:        params.Amount,
+			Note:          params.Note,
+			ExpiresAt:     params.ExpiresAt,
+		})
+	})
+	return err
+}
+
+func (s *Server) handleSendBudgetAlert() error {
+	err := s.cons.HandleSendBudgetAlert(func(ctx context.Context, params core.SendBudgetAlertMessage) error {
+		return s.uc.SendBudgetAlert.Execute(ctx, application.SendBudgetAlertCommandParam{
+			Name:        params.Name,
+			Email:       params.Email,
+			AccountName: params.AccountName,
+			Category:    params.Category,
+			Threshold:   params.Threshold,
+			Spent:       params.Spent,
+			Budget:      params.Budget,
+			Month:       params.Month,
+		})
+	})
+	return err
+}
@@ -23,6 +23,12 @@ func (esm *emailSenderMock) SendResetPasswordToken(_ context.Context, _ core.Sen
 func (esm *emailSenderMock) SendNewSignInSession(_ context.Context, _ core.SendNewSignInSessionMessage) error {
 	return nil
 }
+func (esm *emailSenderMock) SendPaymentRequest(_ context.Context, _ core.SendPaymentRequestMessage) error {
+	return nil
+}
+func (esm *emailSenderMock) SendBudgetAlert(_ context.Context, _ core.SendBudgetAlertMessage) error {
+	return nil
+}
 
 func (esm *emailSenderMock) Close() error { return nil }
 
@@ -10,6 +10,8 @@ type EmailSender interface {
 	SendVerificationCode(ctx context.Context, params core.SendVerificationCodeMessage) error
 	SendResetPasswordToken(ctx context.Context, params core.SendResetPasswordTokenMessage) error
 	SendNewSignInSession(ctx context.Context, params core.SendNewSignInSessionMessage) error
+	SendPaymentRequest(ctx context.Context, params core.SendPaymentRequestMessage) error
+	SendBudgetAlert(ctx context.Context, params core.SendBudgetAlertMessage) error
 	Close() error
 }
 
@@ -21,6 +23,8 @@ type MessageConsumer interface {
 	HandleSendVerificationsCode(handler func(ctx context.Context, params core.SendVerificationCodeMessage) error) error
 	HandleSendResetPasswordToken(handler func(ctx context.Context, params core.SendResetPasswordTokenMessage) error) error
 	HandleSendNewSignInSession(handler func(ctx context.Context, params core.SendNewSignInSessionMessage) error) error
+	HandleSendPaymentRequest(handler func(ctx context.Context, params core.SendPaymentRequestMessage) error) error
+	HandleSendBudgetAlert(handler func(ctx context.Context, params core.SendBudgetAlertMessage) error) error
 	Close() error
 }
 
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+
+	"github.com/escalopa/fingo/contact/internal/core"
+)
+
+type SendBudgetAlertCommandParam struct {
+	Name        string `validate:"required,alpha,min=2,max=50"`
+	Email       string `validate:"required,email"`
+	AccountName string `validate:"required"`
+	Category    string `validate:"max=32"` // empty for the whole spending
+	Threshold   int    `validate:"required,min=1"`
+	Spent       string `validate:"required"`
+	Budget      string `validate:"required"`
+	Month       string `validate:"required"`
+}
+
+type SendBudgetAlertCommand interface {
+	Execute(ctx context.Context, params SendBudgetAlertCommandParam) error
+}
+
+type SendBudgetAlertCommandImpl struct {
+	v  Validator
+	es EmailSender
+}
+
+func NewSendBudgetAlertCommand(v Validator, es EmailSender) SendBudgetAlertCommand {
+	return &SendBudgetAlertCommandImpl{
+		v:  v,
+		es: es,
+	}
+}
+
+// Execute emails the account's owner that the month's spending crossed a threshold of a budget
+func (c *SendBudgetAlertCommandImpl) Execute(ctx context.Context, params SendBudgetAlertCommandParam) error {
+	if err := c.v.Validate(ctx, params); err != nil {
+		return err
+	}
+	err := c.es.SendBudgetAlert(ctx, core.SendBudgetAlertMessage{
+		Name:        params.Name,
+		Email:       params.Email,
+		AccountName: params.AccountName,
+		Category:    params.Category,
+		Threshold:   params.Threshold,
+		Spent:       params.Spent,
+		Budget:      params.Budget,
+		Month:       params.Month,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
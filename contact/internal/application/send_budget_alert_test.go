@@ -0,0 +1,91 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func TestSendBudgetAlertCommandImpl_Execute(t *testing.T) {
+	testCases := []struct {
+		name        string
+		params      SendBudgetAlertCommandParam
+		expectError bool
+	}{
+		{
+			name: "valid",
+			params: SendBudgetAlertCommandParam{
+				Name:        gofakeit.FirstName(),
+				Email:       gofakeit.Email(),
+				AccountName: gofakeit.Word(),
+				Category:    "groceries",
+				Threshold:   80,
+				Spent:       "400.00 USD",
+				Budget:      "500.00 USD",
+				Month:       "March 2023",
+			},
+			expectError: false,
+		},
+		{
+			name: "valid without category",
+			params: SendBudgetAlertCommandParam{
+				Name:        gofakeit.FirstName(),
+				Email:       gofakeit.Email(),
+				AccountName: gofakeit.Word(),
+				Threshold:   100,
+				Spent:       "520.00 USD",
+				Budget:      "500.00 USD",
+				Month:       "March 2023",
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid email",
+			params: SendBudgetAlertCommandParam{
+				Name:        gofakeit.FirstName(),
+				Email:       "invalid",
+				AccountName: gofakeit.Word(),
+				Threshold:   80,
+				Spent:       "400.00 USD",
+				Budget:      "500.00 USD",
+				Month:       "March 2023",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid threshold",
+			params: SendBudgetAlertCommandParam{
+				Name:        gofakeit.FirstName(),
+				Email:       gofakeit.Email(),
+				AccountName: gofakeit.Word(),
+				Spent:       "400.00 USD",
+				Budget:      "500.00 USD",
+				Month:       "March 2023",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid budget",
+			params: SendBudgetAlertCommandParam{
+				Name:        gofakeit.FirstName(),
+				Email:       gofakeit.Email(),
+				AccountName: gofakeit.Word(),
+				Threshold:   80,
+				Spent:       "400.00 USD",
+				Month:       "March 2023",
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// execute command
+			err := testUseCases.SendBudgetAlert.Execute(context.Background(), tc.params)
+			if (err != nil) != tc.expectError {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
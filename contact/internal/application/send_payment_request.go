@@ -0,0 +1,51 @@
+package application
+
+import (
+	"context"
+
+	"github.com/escalopa/fingo/contact/internal/core"
+)
+
+type SendPaymentRequestCommandParam struct {
+	Name          string `validate:"required,alpha,min=2,max=50"`
+	Email         string `validate:"required,email"`
+	RequesterName string `validate:"required,max=101"`
+	Amount        string `validate:"required"`
+	Note          string `validate:"max=256"`
+	ExpiresAt     string `validate:"required"`
+}
+
+type SendPaymentRequestCommand interface {
+	Execute(ctx context.Context, params SendPaymentRequestCommandParam) error
+}
+
+type SendPaymentRequestCommandImpl struct {
+	v  Validator
+	es EmailSender
+}
+
+func NewSendPaymentRequestCommand(v Validator, es EmailSender) SendPaymentRequestCommand {
+	return &SendPaymentRequestCommandImpl{
+		v:  v,
+		es: es,
+	}
+}
+
+// Execute emails the payer about the money requested by another user
+func (c *SendPaymentRequestCommandImpl) Execute(ctx context.Context, params SendPaymentRequestCommandParam) error {
+	if err := c.v.Validate(ctx, params); err != nil {
+		return err
+	}
+	err := c.es.SendPaymentRequest(ctx, core.SendPaymentRequestMessage{
+		Name:          params.Name,
+		Email:         params.Email,
+		RequesterName: params.RequesterName,
+		Amount:        params.Amount,
+		Note:          params.Note,
+		ExpiresAt:     params.ExpiresAt,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
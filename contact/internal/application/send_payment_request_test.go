@@ -0,0 +1,96 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+)
+
+func TestSendPaymentRequestCommandImpl_Execute(t *testing.T) {
+	testCases := []struct {
+		name        string
+		params      SendPaymentRequestCommandParam
+		expectError bool
+	}{
+		{
+			name: "valid",
+			params: SendPaymentRequestCommandParam{
+				Name:          gofakeit.FirstName(),
+				Email:         gofakeit.Email(),
+				RequesterName: gofakeit.Name(),
+				Amount:        "12.50 USD",
+				Note:          gofakeit.Sentence(5),
+				ExpiresAt:     time.Now().Add(time.Hour).Format(time.RFC1123),
+			},
+			expectError: false,
+		},
+		{
+			name: "valid without note",
+			params: SendPaymentRequestCommandParam{
+				Name:          gofakeit.FirstName(),
+				Email:         gofakeit.Email(),
+				RequesterName: gofakeit.Name(),
+				Amount:        "12.50 USD",
+				ExpiresAt:     time.Now().Add(time.Hour).Format(time.RFC1123),
+			},
+			expectError: false,
+		},
+		{
+			name: "invalid email",
+			params: SendPaymentRequestCommandParam{
+				Name:          gofakeit.FirstName(),
+				Email:         "invalid",
+				RequesterName: gofakeit.Name(),
+				Amount:        "12.50 USD",
+				ExpiresAt:     time.Now().Add(time.Hour).Format(time.RFC1123),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid requester name",
+			params: SendPaymentRequestCommandParam{
+				Name:          gofakeit.FirstName(),
+				Email:         gofakeit.Email(),
+				RequesterName: "",
+				Amount:        "12.50 USD",
+				ExpiresAt:     time.Now().Add(time.Hour).Format(time.RFC1123),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid amount",
+			params: SendPaymentRequestCommandParam{
+				Name:          gofakeit.FirstName(),
+				Email:         gofakeit.Email(),
+				RequesterName: gofakeit.Name(),
+				Amount:        "",
+				ExpiresAt:     time.Now().Add(time.Hour).Format(time.RFC1123),
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid note",
+			params: SendPaymentRequestCommandParam{
+				Name:          gofakeit.FirstName(),
+				Email:         gofakeit.Email(),
+				RequesterName: gofakeit.Name(),
+				Amount:        "12.50 USD",
+				Note:          gofakeit.LetterN(257),
+				ExpiresAt:     time.Now().Add(time.Hour).Format(time.RFC1123),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// execute command
+			err := testUseCases.SendPaymentRequest.Execute(context.Background(), tc.params)
+			if (err != nil) != tc.expectError {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ func NewUseCases(opts ...func(cases *UseCases)) *UseCases {
 	uc.SendVerificationCode = NewSendVerificationCodeCommand(uc.v, uc.es, uc.sci)
 	uc.SendResetPasswordToken = NewSendResetPasswordTokenCommand(uc.v, uc.es, uc.spi)
 	uc.SendNewSignInSession = NewSendNewSingInSessionCommand(uc.v, uc.es)
+	uc.SendPaymentRequest = NewSendPaymentRequestCommand(uc.v, uc.es)
+	uc.SendBudgetAlert = NewSendBudgetAlertCommand(uc.v, uc.es)
 	return uc
 }
 
@@ -58,4 +60,6 @@ type Command struct {
 	SendVerificationCode   SendVerificationCodeCommand
 	SendResetPasswordToken SendResetPasswordTokenCommand
 	SendNewSignInSession   SendNewSingInSessionCommand
+	SendPaymentRequest     SendPaymentRequestCommand
+	SendBudgetAlert        SendBudgetAlertCommand
 }
@@ -20,3 +20,23 @@ type SendNewSignInSessionMessage struct {
 	ClientIP  string `json:"client-ip"`
 	UserAgent string `json:"user-agent"`
 }
+
+type SendPaymentRequestMessage struct {
+	Name          string `json:"name"`
+	Email         string `json:"email"`
+	RequesterName string `json:"requester_name"`
+	Amount        string `json:"amount"`
+	Note          string `json:"note"`
+	ExpiresAt     string `json:"expires_at"`
+}
+
+type SendBudgetAlertMessage struct {
+	Name        string `json:"name"`
+	Email       string `json:"email"`
+	AccountName string `json:"account_name"`
+	Category    string `json:"category"`
+	Threshold   int    `json:"threshold"`
+	Spent       string `json:"spent"`
+	Budget      string `json:"budget"`
+	Month       string `json:"month"`
+}
@@ -49,6 +49,20 @@ func (mr *MockEmailSenderMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockEmailSender)(nil).Close))
 }
 
+// SendBudgetAlert mocks base method.
+func (m *MockEmailSender) SendBudgetAlert(ctx context.Context, params core.SendBudgetAlertMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendBudgetAlert", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendBudgetAlert indicates an expected call of SendBudgetAlert.
+func (mr *MockEmailSenderMockRecorder) SendBudgetAlert(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendBudgetAlert", reflect.TypeOf((*MockEmailSender)(nil).SendBudgetAlert), ctx, params)
+}
+
 // SendNewSignInSession mocks base method.
 func (m *MockEmailSender) SendNewSignInSession(ctx context.Context, params core.SendNewSignInSessionMessage) error {
 	m.ctrl.T.Helper()
@@ -63,6 +77,20 @@ func (mr *MockEmailSenderMockRecorder) SendNewSignInSession(ctx, params interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNewSignInSession", reflect.TypeOf((*MockEmailSender)(nil).SendNewSignInSession), ctx, params)
 }
 
+// SendPaymentRequest mocks base method.
+func (m *MockEmailSender) SendPaymentRequest(ctx context.Context, params core.SendPaymentRequestMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPaymentRequest", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPaymentRequest indicates an expected call of SendPaymentRequest.
+func (mr *MockEmailSenderMockRecorder) SendPaymentRequest(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPaymentRequest", reflect.TypeOf((*MockEmailSender)(nil).SendPaymentRequest), ctx, params)
+}
+
 // SendResetPasswordToken mocks base method.
 func (m *MockEmailSender) SendResetPasswordToken(ctx context.Context, params core.SendResetPasswordTokenMessage) error {
 	m.ctrl.T.Helper()
@@ -115,17 +143,17 @@ func (m *MockValidator) EXPECT() *MockValidatorMockRecorder {
 }
 
 // Validate mocks base method.
-func (m *MockValidator) Validate(i interface{}) error {
+func (m *MockValidator) Validate(ctx context.Context, i interface{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Validate", i)
+	ret := m.ctrl.Call(m, "Validate", ctx, i)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Validate indicates an expected call of Validate.
-func (mr *MockValidatorMockRecorder) Validate(i interface{}) *gomock.Call {
+func (mr *MockValidatorMockRecorder) Validate(ctx, i interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockValidator)(nil).Validate), i)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Validate", reflect.TypeOf((*MockValidator)(nil).Validate), ctx, i)
 }
 
 // MockMessageConsumer is a mock of MessageConsumer interface.
@@ -165,6 +193,20 @@ func (mr *MockMessageConsumerMockRecorder) Close() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockMessageConsumer)(nil).Close))
 }
 
+// HandleSendBudgetAlert mocks base method.
+func (m *MockMessageConsumer) HandleSendBudgetAlert(handler func(context.Context, core.SendBudgetAlertMessage) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleSendBudgetAlert", handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleSendBudgetAlert indicates an expected call of HandleSendBudgetAlert.
+func (mr *MockMessageConsumerMockRecorder) HandleSendBudgetAlert(handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSendBudgetAlert", reflect.TypeOf((*MockMessageConsumer)(nil).HandleSendBudgetAlert), handler)
+}
+
 // HandleSendNewSignInSession mocks base method.
 func (m *MockMessageConsumer) HandleSendNewSignInSession(handler func(context.Context, core.SendNewSignInSessionMessage) error) error {
 	m.ctrl.T.Helper()
@@ -179,6 +221,20 @@ func (mr *MockMessageConsumerMockRecorder) HandleSendNewSignInSession(handler in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSendNewSignInSession", reflect.TypeOf((*MockMessageConsumer)(nil).HandleSendNewSignInSession), handler)
 }
 
+// HandleSendPaymentRequest mocks base method.
+func (m *MockMessageConsumer) HandleSendPaymentRequest(handler func(context.Context, core.SendPaymentRequestMessage) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleSendPaymentRequest", handler)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// HandleSendPaymentRequest indicates an expected call of HandleSendPaymentRequest.
+func (mr *MockMessageConsumerMockRecorder) HandleSendPaymentRequest(handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleSendPaymentRequest", reflect.TypeOf((*MockMessageConsumer)(nil).HandleSendPaymentRequest), handler)
+}
+
 // HandleSendResetPasswordToken mocks base method.
 func (m *MockMessageConsumer) HandleSendResetPasswordToken(handler func(context.Context, core.SendResetPasswordTokenMessage) error) error {
 	m.ctrl.T.Helper()
@@ -8,6 +8,7 @@ package pb
 
 import (
 	context "context"
+
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -253,3 +254,91 @@ var AuthService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "auth.proto",
 }
+
+// AuthInternalServiceClient is the client API for AuthInternalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AuthInternalServiceClient interface {
+	// Users
+	LookupUser(ctx context.Context, in *LookupUserRequest, opts ...grpc.CallOption) (*LookupUserResponse, error)
+}
+
+type authInternalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAuthInternalServiceClient(cc grpc.ClientConnInterface) AuthInternalServiceClient {
+	return &authInternalServiceClient{cc}
+}
+
+func (c *authInternalServiceClient) LookupUser(ctx context.Context, in *LookupUserRequest, opts ...grpc.CallOption) (*LookupUserResponse, error) {
+	out := new(LookupUserResponse)
+	err := c.cc.Invoke(ctx, "/pb.AuthInternalService/LookupUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuthInternalServiceServer is the server API for AuthInternalService service.
+// All implementations must embed UnimplementedAuthInternalServiceServer
+// for forward compatibility
+type AuthInternalServiceServer interface {
+	// Users
+	LookupUser(context.Context, *LookupUserRequest) (*LookupUserResponse, error)
+	mustEmbedUnimplementedAuthInternalServiceServer()
+}
+
+// UnimplementedAuthInternalServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAuthInternalServiceServer struct {
+}
+
+func (UnimplementedAuthInternalServiceServer) LookupUser(context.Context, *LookupUserRequest) (*LookupUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LookupUser not implemented")
+}
+func (UnimplementedAuthInternalServiceServer) mustEmbedUnimplementedAuthInternalServiceServer() {}
+
+// UnsafeAuthInternalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AuthInternalServiceServer will
+// result in compilation errors.
+type UnsafeAuthInternalServiceServer interface {
+	mustEmbedUnimplementedAuthInternalServiceServer()
+}
+
+func RegisterAuthInternalServiceServer(s grpc.ServiceRegistrar, srv AuthInternalServiceServer) {
+	s.RegisterService(&AuthInternalService_ServiceDesc, srv)
+}
+
+func _AuthInternalService_LookupUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LookupUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuthInternalServiceServer).LookupUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.AuthInternalService/LookupUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuthInternalServiceServer).LookupUser(ctx, req.(*LookupUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AuthInternalService_ServiceDesc is the grpc.ServiceDesc for AuthInternalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AuthInternalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.AuthInternalService",
+	HandlerType: (*AuthInternalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "LookupUser",
+			Handler:    _AuthInternalService_LookupUser_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "auth.proto",
+}
@@ -28,16 +28,70 @@ type WalletServiceClient interface {
 	// Account
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
 	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
+	// Deprecated: Do not use.
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error)
+	FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error)
+	UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error)
+	SetDefaultAccount(ctx context.Context, in *SetDefaultAccountRequest, opts ...grpc.CallOption) (*SetDefaultAccountResponse, error)
+	// Currency
+	ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error)
 	// Card
 	CreateCard(ctx context.Context, in *CreateCardRequest, opts ...grpc.CallOption) (*CreateCardResponse, error)
 	GetCards(ctx context.Context, in *GetCardsRequest, opts ...grpc.CallOption) (*GetCardsResponse, error)
 	DeleteCard(ctx context.Context, in *DeleteCardRequest, opts ...grpc.CallOption) (*DeleteCardResponse, error)
+	ReplaceCard(ctx context.Context, in *ReplaceCardRequest, opts ...grpc.CallOption) (*ReplaceCardResponse, error)
+	SetCardStatus(ctx context.Context, in *SetCardStatusRequest, opts ...grpc.CallOption) (*SetCardStatusResponse, error)
+	SetCardNickname(ctx context.Context, in *SetCardNicknameRequest, opts ...grpc.CallOption) (*SetCardNicknameResponse, error)
+	SetCardPin(ctx context.Context, in *SetCardPinRequest, opts ...grpc.CallOption) (*SetCardPinResponse, error)
+	VerifyCardPin(ctx context.Context, in *VerifyCardPinRequest, opts ...grpc.CallOption) (*VerifyCardPinResponse, error)
+	RevealCardNumber(ctx context.Context, in *RevealCardNumberRequest, opts ...grpc.CallOption) (*RevealCardNumberResponse, error)
 	// Transfer
 	CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error)
+	BatchTransfer(ctx context.Context, in *BatchTransferRequest, opts ...grpc.CallOption) (*BatchTransferResponse, error)
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error)
 	TransferRollback(ctx context.Context, in *TransferRollbackRequest, opts ...grpc.CallOption) (*TransferRollbackResponse, error)
+	RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*RefundTransactionResponse, error)
+	ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReverseTransactionResponse, error)
+	// Hold
+	AuthorizeHold(ctx context.Context, in *AuthorizeHoldRequest, opts ...grpc.CallOption) (*AuthorizeHoldResponse, error)
+	CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error)
+	VoidHold(ctx context.Context, in *VoidHoldRequest, opts ...grpc.CallOption) (*VoidHoldResponse, error)
+	// Standing order
+	CreateStandingOrder(ctx context.Context, in *CreateStandingOrderRequest, opts ...grpc.CallOption) (*CreateStandingOrderResponse, error)
+	GetStandingOrders(ctx context.Context, in *GetStandingOrdersRequest, opts ...grpc.CallOption) (*GetStandingOrdersResponse, error)
+	UpdateStandingOrder(ctx context.Context, in *UpdateStandingOrderRequest, opts ...grpc.CallOption) (*UpdateStandingOrderResponse, error)
+	DeleteStandingOrder(ctx context.Context, in *DeleteStandingOrderRequest, opts ...grpc.CallOption) (*DeleteStandingOrderResponse, error)
+	GetStandingOrderExecutions(ctx context.Context, in *GetStandingOrderExecutionsRequest, opts ...grpc.CallOption) (*GetStandingOrderExecutionsResponse, error)
+	// Payment request
+	CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error)
+	GetPaymentRequests(ctx context.Context, in *GetPaymentRequestsRequest, opts ...grpc.CallOption) (*GetPaymentRequestsResponse, error)
+	PayPaymentRequest(ctx context.Context, in *PayPaymentRequestRequest, opts ...grpc.CallOption) (*PayPaymentRequestResponse, error)
+	DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*DeclinePaymentRequestResponse, error)
+	CancelPaymentRequest(ctx context.Context, in *CancelPaymentRequestRequest, opts ...grpc.CallOption) (*CancelPaymentRequestResponse, error)
+	// Split
+	CreateSplit(ctx context.Context, in *CreateSplitRequest, opts ...grpc.CallOption) (*CreateSplitResponse, error)
+	GetSplit(ctx context.Context, in *GetSplitRequest, opts ...grpc.CallOption) (*GetSplitResponse, error)
+	GetSplits(ctx context.Context, in *GetSplitsRequest, opts ...grpc.CallOption) (*GetSplitsResponse, error)
+	SettleSplit(ctx context.Context, in *SettleSplitRequest, opts ...grpc.CallOption) (*SettleSplitResponse, error)
+	// Limit
+	GetLimits(ctx context.Context, in *GetLimitsRequest, opts ...grpc.CallOption) (*GetLimitsResponse, error)
+	SetLimit(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*SetLimitResponse, error)
 	// History
 	GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error)
+	StreamTransactionHistory(ctx context.Context, in *StreamTransactionHistoryRequest, opts ...grpc.CallOption) (WalletService_StreamTransactionHistoryClient, error)
+	GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (WalletService_GetStatementClient, error)
+	SetTransactionLabels(ctx context.Context, in *SetTransactionLabelsRequest, opts ...grpc.CallOption) (*SetTransactionLabelsResponse, error)
+	GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error)
+	// Budget
+	SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error)
+	DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error)
+	GetBudgets(ctx context.Context, in *GetBudgetsRequest, opts ...grpc.CallOption) (*GetBudgetsResponse, error)
+	// Pot
+	CreatePot(ctx context.Context, in *CreatePotRequest, opts ...grpc.CallOption) (*CreatePotResponse, error)
+	UpdatePot(ctx context.Context, in *UpdatePotRequest, opts ...grpc.CallOption) (*UpdatePotResponse, error)
+	DeletePot(ctx context.Context, in *DeletePotRequest, opts ...grpc.CallOption) (*DeletePotResponse, error)
+	MovePotMoney(ctx context.Context, in *MovePotMoneyRequest, opts ...grpc.CallOption) (*MovePotMoneyResponse, error)
 }
 
 type walletServiceClient struct {
@@ -75,6 +129,7 @@ func (c *walletServiceClient) GetAccounts(ctx context.Context, in *GetAccountsRe
 	return out, nil
 }
 
+// Deprecated: Do not use.
 func (c *walletServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
 	out := new(DeleteAccountResponse)
 	err := c.cc.Invoke(ctx, "/pb.WalletService/DeleteAccount", in, out, opts...)
@@ -84,6 +139,51 @@ func (c *walletServiceClient) DeleteAccount(ctx context.Context, in *DeleteAccou
 	return out, nil
 }
 
+func (c *walletServiceClient) CloseAccount(ctx context.Context, in *CloseAccountRequest, opts ...grpc.CallOption) (*CloseAccountResponse, error) {
+	out := new(CloseAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CloseAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) FreezeAccount(ctx context.Context, in *FreezeAccountRequest, opts ...grpc.CallOption) (*FreezeAccountResponse, error) {
+	out := new(FreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/FreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UnfreezeAccount(ctx context.Context, in *UnfreezeAccountRequest, opts ...grpc.CallOption) (*UnfreezeAccountResponse, error) {
+	out := new(UnfreezeAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/UnfreezeAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetDefaultAccount(ctx context.Context, in *SetDefaultAccountRequest, opts ...grpc.CallOption) (*SetDefaultAccountResponse, error) {
+	out := new(SetDefaultAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SetDefaultAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ListCurrencies(ctx context.Context, in *ListCurrenciesRequest, opts ...grpc.CallOption) (*ListCurrenciesResponse, error) {
+	out := new(ListCurrenciesResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/ListCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *walletServiceClient) CreateCard(ctx context.Context, in *CreateCardRequest, opts ...grpc.CallOption) (*CreateCardResponse, error) {
 	out := new(CreateCardResponse)
 	err := c.cc.Invoke(ctx, "/pb.WalletService/CreateCard", in, out, opts...)
@@ -108,281 +208,1692 @@ func (c *walletServiceClient) DeleteCard(ctx context.Context, in *DeleteCardRequ
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *walletServiceClient) ReplaceCard(ctx context.Context, in *ReplaceCardRequest, opts ...grpc.CallOption) (*ReplaceCardResponse, error) {
+	out := new(ReplaceCardResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/ReplaceCard", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetCardStatus(ctx context.Context, in *SetCardStatusRequest, opts ...grpc.CallOption) (*SetCardStatusResponse, error) {
+	out := new(SetCardStatusResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SetCardStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetCardNickname(ctx context.Context, in *SetCardNicknameRequest, opts ...grpc.CallOption) (*SetCardNicknameResponse, error) {
+	out := new(SetCardNicknameResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SetCardNickname", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetCardPin(ctx context.Context, in *SetCardPinRequest, opts ...grpc.CallOption) (*SetCardPinResponse, error) {
+	out := new(SetCardPinResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SetCardPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VerifyCardPin(ctx context.Context, in *VerifyCardPinRequest, opts ...grpc.CallOption) (*VerifyCardPinResponse, error) {
+	out := new(VerifyCardPinResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/VerifyCardPin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RevealCardNumber(ctx context.Context, in *RevealCardNumberRequest, opts ...grpc.CallOption) (*RevealCardNumberResponse, error) {
+	out := new(RevealCardNumberResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/RevealCardNumber", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error) {
+	out := new(CreateTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CreateTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) BatchTransfer(ctx context.Context, in *BatchTransferRequest, opts ...grpc.CallOption) (*BatchTransferResponse, error) {
+	out := new(BatchTransferResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/BatchTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*CreateQuoteResponse, error) {
+	out := new(CreateQuoteResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CreateQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) TransferRollback(ctx context.Context, in *TransferRollbackRequest, opts ...grpc.CallOption) (*TransferRollbackResponse, error) {
+	out := new(TransferRollbackResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/TransferRollback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) RefundTransaction(ctx context.Context, in *RefundTransactionRequest, opts ...grpc.CallOption) (*RefundTransactionResponse, error) {
+	out := new(RefundTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/RefundTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) ReverseTransaction(ctx context.Context, in *ReverseTransactionRequest, opts ...grpc.CallOption) (*ReverseTransactionResponse, error) {
+	out := new(ReverseTransactionResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/ReverseTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) AuthorizeHold(ctx context.Context, in *AuthorizeHoldRequest, opts ...grpc.CallOption) (*AuthorizeHoldResponse, error) {
+	out := new(AuthorizeHoldResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/AuthorizeHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CaptureHold(ctx context.Context, in *CaptureHoldRequest, opts ...grpc.CallOption) (*CaptureHoldResponse, error) {
+	out := new(CaptureHoldResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CaptureHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) VoidHold(ctx context.Context, in *VoidHoldRequest, opts ...grpc.CallOption) (*VoidHoldResponse, error) {
+	out := new(VoidHoldResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/VoidHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateStandingOrder(ctx context.Context, in *CreateStandingOrderRequest, opts ...grpc.CallOption) (*CreateStandingOrderResponse, error) {
+	out := new(CreateStandingOrderResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CreateStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetStandingOrders(ctx context.Context, in *GetStandingOrdersRequest, opts ...grpc.CallOption) (*GetStandingOrdersResponse, error) {
+	out := new(GetStandingOrdersResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetStandingOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UpdateStandingOrder(ctx context.Context, in *UpdateStandingOrderRequest, opts ...grpc.CallOption) (*UpdateStandingOrderResponse, error) {
+	out := new(UpdateStandingOrderResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/UpdateStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DeleteStandingOrder(ctx context.Context, in *DeleteStandingOrderRequest, opts ...grpc.CallOption) (*DeleteStandingOrderResponse, error) {
+	out := new(DeleteStandingOrderResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/DeleteStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetStandingOrderExecutions(ctx context.Context, in *GetStandingOrderExecutionsRequest, opts ...grpc.CallOption) (*GetStandingOrderExecutionsResponse, error) {
+	out := new(GetStandingOrderExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetStandingOrderExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreatePaymentRequest(ctx context.Context, in *CreatePaymentRequestRequest, opts ...grpc.CallOption) (*CreatePaymentRequestResponse, error) {
+	out := new(CreatePaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CreatePaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetPaymentRequests(ctx context.Context, in *GetPaymentRequestsRequest, opts ...grpc.CallOption) (*GetPaymentRequestsResponse, error) {
+	out := new(GetPaymentRequestsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetPaymentRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) PayPaymentRequest(ctx context.Context, in *PayPaymentRequestRequest, opts ...grpc.CallOption) (*PayPaymentRequestResponse, error) {
+	out := new(PayPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/PayPaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DeclinePaymentRequest(ctx context.Context, in *DeclinePaymentRequestRequest, opts ...grpc.CallOption) (*DeclinePaymentRequestResponse, error) {
+	out := new(DeclinePaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/DeclinePaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CancelPaymentRequest(ctx context.Context, in *CancelPaymentRequestRequest, opts ...grpc.CallOption) (*CancelPaymentRequestResponse, error) {
+	out := new(CancelPaymentRequestResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CancelPaymentRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreateSplit(ctx context.Context, in *CreateSplitRequest, opts ...grpc.CallOption) (*CreateSplitResponse, error) {
+	out := new(CreateSplitResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CreateSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetSplit(ctx context.Context, in *GetSplitRequest, opts ...grpc.CallOption) (*GetSplitResponse, error) {
+	out := new(GetSplitResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetSplits(ctx context.Context, in *GetSplitsRequest, opts ...grpc.CallOption) (*GetSplitsResponse, error) {
+	out := new(GetSplitsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetSplits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SettleSplit(ctx context.Context, in *SettleSplitRequest, opts ...grpc.CallOption) (*SettleSplitResponse, error) {
+	out := new(SettleSplitResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SettleSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetLimits(ctx context.Context, in *GetLimitsRequest, opts ...grpc.CallOption) (*GetLimitsResponse, error) {
+	out := new(GetLimitsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetLimits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetLimit(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*SetLimitResponse, error) {
+	out := new(SetLimitResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SetLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
+	out := new(GetTransactionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetTransactionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) StreamTransactionHistory(ctx context.Context, in *StreamTransactionHistoryRequest, opts ...grpc.CallOption) (WalletService_StreamTransactionHistoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[0], "/pb.WalletService/StreamTransactionHistory", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceStreamTransactionHistoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_StreamTransactionHistoryClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type walletServiceStreamTransactionHistoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceStreamTransactionHistoryClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) GetStatement(ctx context.Context, in *GetStatementRequest, opts ...grpc.CallOption) (WalletService_GetStatementClient, error) {
+	stream, err := c.cc.NewStream(ctx, &WalletService_ServiceDesc.Streams[1], "/pb.WalletService/GetStatement", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &walletServiceGetStatementClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WalletService_GetStatementClient interface {
+	Recv() (*GetStatementResponse, error)
+	grpc.ClientStream
+}
+
+type walletServiceGetStatementClient struct {
+	grpc.ClientStream
+}
+
+func (x *walletServiceGetStatementClient) Recv() (*GetStatementResponse, error) {
+	m := new(GetStatementResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *walletServiceClient) SetTransactionLabels(ctx context.Context, in *SetTransactionLabelsRequest, opts ...grpc.CallOption) (*SetTransactionLabelsResponse, error) {
+	out := new(SetTransactionLabelsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SetTransactionLabels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetSpendingSummary(ctx context.Context, in *GetSpendingSummaryRequest, opts ...grpc.CallOption) (*GetSpendingSummaryResponse, error) {
+	out := new(GetSpendingSummaryResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetSpendingSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) SetBudget(ctx context.Context, in *SetBudgetRequest, opts ...grpc.CallOption) (*SetBudgetResponse, error) {
+	out := new(SetBudgetResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/SetBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DeleteBudget(ctx context.Context, in *DeleteBudgetRequest, opts ...grpc.CallOption) (*DeleteBudgetResponse, error) {
+	out := new(DeleteBudgetResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/DeleteBudget", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) GetBudgets(ctx context.Context, in *GetBudgetsRequest, opts ...grpc.CallOption) (*GetBudgetsResponse, error) {
+	out := new(GetBudgetsResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/GetBudgets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) CreatePot(ctx context.Context, in *CreatePotRequest, opts ...grpc.CallOption) (*CreatePotResponse, error) {
+	out := new(CreatePotResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/CreatePot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) UpdatePot(ctx context.Context, in *UpdatePotRequest, opts ...grpc.CallOption) (*UpdatePotResponse, error) {
+	out := new(UpdatePotResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/UpdatePot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) DeletePot(ctx context.Context, in *DeletePotRequest, opts ...grpc.CallOption) (*DeletePotResponse, error) {
+	out := new(DeletePotResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/DeletePot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletServiceClient) MovePotMoney(ctx context.Context, in *MovePotMoneyRequest, opts ...grpc.CallOption) (*MovePotMoneyResponse, error) {
+	out := new(MovePotMoneyResponse)
+	err := c.cc.Invoke(ctx, "/pb.WalletService/MovePotMoney", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WalletServiceServer is the server API for WalletService service.
+// All implementations must embed UnimplementedWalletServiceServer
+// for forward compatibility
+type WalletServiceServer interface {
+	// User
+	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
+	// Account
+	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
+	// Deprecated: Do not use.
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error)
+	FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error)
+	UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error)
+	SetDefaultAccount(context.Context, *SetDefaultAccountRequest) (*SetDefaultAccountResponse, error)
+	// Currency
+	ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error)
+	// Card
+	CreateCard(context.Context, *CreateCardRequest) (*CreateCardResponse, error)
+	GetCards(context.Context, *GetCardsRequest) (*GetCardsResponse, error)
+	DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error)
+	ReplaceCard(context.Context, *ReplaceCardRequest) (*ReplaceCardResponse, error)
+	SetCardStatus(context.Context, *SetCardStatusRequest) (*SetCardStatusResponse, error)
+	SetCardNickname(context.Context, *SetCardNicknameRequest) (*SetCardNicknameResponse, error)
+	SetCardPin(context.Context, *SetCardPinRequest) (*SetCardPinResponse, error)
+	VerifyCardPin(context.Context, *VerifyCardPinRequest) (*VerifyCardPinResponse, error)
+	RevealCardNumber(context.Context, *RevealCardNumberRequest) (*RevealCardNumberResponse, error)
+	// Transfer
+	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
+	BatchTransfer(context.Context, *BatchTransferRequest) (*BatchTransferResponse, error)
+	CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error)
+	TransferRollback(context.Context, *TransferRollbackRequest) (*TransferRollbackResponse, error)
+	RefundTransaction(context.Context, *RefundTransactionRequest) (*RefundTransactionResponse, error)
+	ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReverseTransactionResponse, error)
+	// Hold
+	AuthorizeHold(context.Context, *AuthorizeHoldRequest) (*AuthorizeHoldResponse, error)
+	CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error)
+	VoidHold(context.Context, *VoidHoldRequest) (*VoidHoldResponse, error)
+	// Standing order
+	CreateStandingOrder(context.Context, *CreateStandingOrderRequest) (*CreateStandingOrderResponse, error)
+	GetStandingOrders(context.Context, *GetStandingOrdersRequest) (*GetStandingOrdersResponse, error)
+	UpdateStandingOrder(context.Context, *UpdateStandingOrderRequest) (*UpdateStandingOrderResponse, error)
+	DeleteStandingOrder(context.Context, *DeleteStandingOrderRequest) (*DeleteStandingOrderResponse, error)
+	GetStandingOrderExecutions(context.Context, *GetStandingOrderExecutionsRequest) (*GetStandingOrderExecutionsResponse, error)
+	// Payment request
+	CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error)
+	GetPaymentRequests(context.Context, *GetPaymentRequestsRequest) (*GetPaymentRequestsResponse, error)
+	PayPaymentRequest(context.Context, *PayPaymentRequestRequest) (*PayPaymentRequestResponse, error)
+	DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest) (*DeclinePaymentRequestResponse, error)
+	CancelPaymentRequest(context.Context, *CancelPaymentRequestRequest) (*CancelPaymentRequestResponse, error)
+	// Split
+	CreateSplit(context.Context, *CreateSplitRequest) (*CreateSplitResponse, error)
+	GetSplit(context.Context, *GetSplitRequest) (*GetSplitResponse, error)
+	GetSplits(context.Context, *GetSplitsRequest) (*GetSplitsResponse, error)
+	SettleSplit(context.Context, *SettleSplitRequest) (*SettleSplitResponse, error)
+	// Limit
+	GetLimits(context.Context, *GetLimitsRequest) (*GetLimitsResponse, error)
+	SetLimit(context.Context, *SetLimitRequest) (*SetLimitResponse, error)
+	// History
+	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
+	StreamTransactionHistory(*StreamTransactionHistoryRequest, WalletService_StreamTransactionHistoryServer) error
+	GetStatement(*GetStatementRequest, WalletService_GetStatementServer) error
+	SetTransactionLabels(context.Context, *SetTransactionLabelsRequest) (*SetTransactionLabelsResponse, error)
+	GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error)
+	// Budget
+	SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error)
+	DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error)
+	GetBudgets(context.Context, *GetBudgetsRequest) (*GetBudgetsResponse, error)
+	// Pot
+	CreatePot(context.Context, *CreatePotRequest) (*CreatePotResponse, error)
+	UpdatePot(context.Context, *UpdatePotRequest) (*UpdatePotResponse, error)
+	DeletePot(context.Context, *DeletePotRequest) (*DeletePotResponse, error)
+	MovePotMoney(context.Context, *MovePotMoneyRequest) (*MovePotMoneyResponse, error)
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+// UnimplementedWalletServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedWalletServiceServer struct {
+}
+
+func (UnimplementedWalletServiceServer) CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
+}
+func (UnimplementedWalletServiceServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
+}
+func (UnimplementedWalletServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
+}
+func (UnimplementedWalletServiceServer) CloseAccount(context.Context, *CloseAccountRequest) (*CloseAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseAccount not implemented")
+}
+func (UnimplementedWalletServiceServer) FreezeAccount(context.Context, *FreezeAccountRequest) (*FreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAccount not implemented")
+}
+func (UnimplementedWalletServiceServer) UnfreezeAccount(context.Context, *UnfreezeAccountRequest) (*UnfreezeAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAccount not implemented")
+}
+func (UnimplementedWalletServiceServer) SetDefaultAccount(context.Context, *SetDefaultAccountRequest) (*SetDefaultAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDefaultAccount not implemented")
+}
+func (UnimplementedWalletServiceServer) ListCurrencies(context.Context, *ListCurrenciesRequest) (*ListCurrenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCurrencies not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateCard(context.Context, *CreateCardRequest) (*CreateCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCard not implemented")
+}
+func (UnimplementedWalletServiceServer) GetCards(context.Context, *GetCardsRequest) (*GetCardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCards not implemented")
+}
+func (UnimplementedWalletServiceServer) DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCard not implemented")
+}
+func (UnimplementedWalletServiceServer) ReplaceCard(context.Context, *ReplaceCardRequest) (*ReplaceCardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceCard not implemented")
+}
+func (UnimplementedWalletServiceServer) SetCardStatus(context.Context, *SetCardStatusRequest) (*SetCardStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCardStatus not implemented")
+}
+func (UnimplementedWalletServiceServer) SetCardNickname(context.Context, *SetCardNicknameRequest) (*SetCardNicknameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCardNickname not implemented")
+}
+func (UnimplementedWalletServiceServer) SetCardPin(context.Context, *SetCardPinRequest) (*SetCardPinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCardPin not implemented")
+}
+func (UnimplementedWalletServiceServer) VerifyCardPin(context.Context, *VerifyCardPinRequest) (*VerifyCardPinResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCardPin not implemented")
+}
+func (UnimplementedWalletServiceServer) RevealCardNumber(context.Context, *RevealCardNumberRequest) (*RevealCardNumberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealCardNumber not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) BatchTransfer(context.Context, *BatchTransferRequest) (*BatchTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchTransfer not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateQuote(context.Context, *CreateQuoteRequest) (*CreateQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuote not implemented")
+}
+func (UnimplementedWalletServiceServer) TransferRollback(context.Context, *TransferRollbackRequest) (*TransferRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferRollback not implemented")
+}
+func (UnimplementedWalletServiceServer) RefundTransaction(context.Context, *RefundTransactionRequest) (*RefundTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) ReverseTransaction(context.Context, *ReverseTransactionRequest) (*ReverseTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransaction not implemented")
+}
+func (UnimplementedWalletServiceServer) AuthorizeHold(context.Context, *AuthorizeHoldRequest) (*AuthorizeHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeHold not implemented")
+}
+func (UnimplementedWalletServiceServer) CaptureHold(context.Context, *CaptureHoldRequest) (*CaptureHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptureHold not implemented")
+}
+func (UnimplementedWalletServiceServer) VoidHold(context.Context, *VoidHoldRequest) (*VoidHoldResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidHold not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateStandingOrder(context.Context, *CreateStandingOrderRequest) (*CreateStandingOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStandingOrder not implemented")
+}
+func (UnimplementedWalletServiceServer) GetStandingOrders(context.Context, *GetStandingOrdersRequest) (*GetStandingOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandingOrders not implemented")
+}
+func (UnimplementedWalletServiceServer) UpdateStandingOrder(context.Context, *UpdateStandingOrderRequest) (*UpdateStandingOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStandingOrder not implemented")
+}
+func (UnimplementedWalletServiceServer) DeleteStandingOrder(context.Context, *DeleteStandingOrderRequest) (*DeleteStandingOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteStandingOrder not implemented")
+}
+func (UnimplementedWalletServiceServer) GetStandingOrderExecutions(context.Context, *GetStandingOrderExecutionsRequest) (*GetStandingOrderExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStandingOrderExecutions not implemented")
+}
+func (UnimplementedWalletServiceServer) CreatePaymentRequest(context.Context, *CreatePaymentRequestRequest) (*CreatePaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentRequest not implemented")
+}
+func (UnimplementedWalletServiceServer) GetPaymentRequests(context.Context, *GetPaymentRequestsRequest) (*GetPaymentRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentRequests not implemented")
+}
+func (UnimplementedWalletServiceServer) PayPaymentRequest(context.Context, *PayPaymentRequestRequest) (*PayPaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayPaymentRequest not implemented")
+}
+func (UnimplementedWalletServiceServer) DeclinePaymentRequest(context.Context, *DeclinePaymentRequestRequest) (*DeclinePaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclinePaymentRequest not implemented")
+}
+func (UnimplementedWalletServiceServer) CancelPaymentRequest(context.Context, *CancelPaymentRequestRequest) (*CancelPaymentRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentRequest not implemented")
+}
+func (UnimplementedWalletServiceServer) CreateSplit(context.Context, *CreateSplitRequest) (*CreateSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSplit not implemented")
+}
+func (UnimplementedWalletServiceServer) GetSplit(context.Context, *GetSplitRequest) (*GetSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSplit not implemented")
+}
+func (UnimplementedWalletServiceServer) GetSplits(context.Context, *GetSplitsRequest) (*GetSplitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSplits not implemented")
+}
+func (UnimplementedWalletServiceServer) SettleSplit(context.Context, *SettleSplitRequest) (*SettleSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleSplit not implemented")
+}
+func (UnimplementedWalletServiceServer) GetLimits(context.Context, *GetLimitsRequest) (*GetLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLimits not implemented")
+}
+func (UnimplementedWalletServiceServer) SetLimit(context.Context, *SetLimitRequest) (*SetLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLimit not implemented")
+}
+func (UnimplementedWalletServiceServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
+}
+func (UnimplementedWalletServiceServer) StreamTransactionHistory(*StreamTransactionHistoryRequest, WalletService_StreamTransactionHistoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTransactionHistory not implemented")
+}
+func (UnimplementedWalletServiceServer) GetStatement(*GetStatementRequest, WalletService_GetStatementServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetStatement not implemented")
+}
+func (UnimplementedWalletServiceServer) SetTransactionLabels(context.Context, *SetTransactionLabelsRequest) (*SetTransactionLabelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTransactionLabels not implemented")
+}
+func (UnimplementedWalletServiceServer) GetSpendingSummary(context.Context, *GetSpendingSummaryRequest) (*GetSpendingSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSpendingSummary not implemented")
+}
+func (UnimplementedWalletServiceServer) SetBudget(context.Context, *SetBudgetRequest) (*SetBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudget not implemented")
+}
+func (UnimplementedWalletServiceServer) DeleteBudget(context.Context, *DeleteBudgetRequest) (*DeleteBudgetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBudget not implemented")
+}
+func (UnimplementedWalletServiceServer) GetBudgets(context.Context, *GetBudgetsRequest) (*GetBudgetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBudgets not implemented")
+}
+func (UnimplementedWalletServiceServer) CreatePot(context.Context, *CreatePotRequest) (*CreatePotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePot not implemented")
+}
+func (UnimplementedWalletServiceServer) UpdatePot(context.Context, *UpdatePotRequest) (*UpdatePotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePot not implemented")
+}
+func (UnimplementedWalletServiceServer) DeletePot(context.Context, *DeletePotRequest) (*DeletePotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePot not implemented")
+}
+func (UnimplementedWalletServiceServer) MovePotMoney(context.Context, *MovePotMoneyRequest) (*MovePotMoneyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MovePotMoney not implemented")
+}
+func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
+
+// UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WalletServiceServer will
+// result in compilation errors.
+type UnsafeWalletServiceServer interface {
+	mustEmbedUnimplementedWalletServiceServer()
+}
+
+func RegisterWalletServiceServer(s grpc.ServiceRegistrar, srv WalletServiceServer) {
+	s.RegisterService(&WalletService_ServiceDesc, srv)
+}
+
+func _WalletService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreateWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreateAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetAccounts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CloseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CloseAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CloseAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CloseAccount(ctx, req.(*CloseAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_FreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).FreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/FreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).FreezeAccount(ctx, req.(*FreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_UnfreezeAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UnfreezeAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/UnfreezeAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UnfreezeAccount(ctx, req.(*UnfreezeAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetDefaultAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDefaultAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetDefaultAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/SetDefaultAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetDefaultAccount(ctx, req.(*SetDefaultAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ListCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCurrenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ListCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/ListCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ListCurrencies(ctx, req.(*ListCurrenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreateCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateCard(ctx, req.(*CreateCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetCards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetCards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetCards(ctx, req.(*GetCardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DeleteCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeleteCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/DeleteCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeleteCard(ctx, req.(*DeleteCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ReplaceCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceCardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ReplaceCard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/ReplaceCard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ReplaceCard(ctx, req.(*ReplaceCardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetCardStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCardStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetCardStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/SetCardStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetCardStatus(ctx, req.(*SetCardStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetCardNickname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCardNicknameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetCardNickname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/SetCardNickname",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetCardNickname(ctx, req.(*SetCardNicknameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SetCardPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCardPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetCardPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/SetCardPin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetCardPin(ctx, req.(*SetCardPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VerifyCardPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCardPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VerifyCardPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/VerifyCardPin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VerifyCardPin(ctx, req.(*VerifyCardPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RevealCardNumber_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevealCardNumberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RevealCardNumber(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/RevealCardNumber",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RevealCardNumber(ctx, req.(*RevealCardNumberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreateTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateTransaction(ctx, req.(*CreateTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_BatchTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).BatchTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/BatchTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).BatchTransfer(ctx, req.(*BatchTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreateQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateQuote(ctx, req.(*CreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_TransferRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).TransferRollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/TransferRollback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).TransferRollback(ctx, req.(*TransferRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_RefundTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).RefundTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/RefundTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).RefundTransaction(ctx, req.(*RefundTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_ReverseTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).ReverseTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/ReverseTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).ReverseTransaction(ctx, req.(*ReverseTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_AuthorizeHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).AuthorizeHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/AuthorizeHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).AuthorizeHold(ctx, req.(*AuthorizeHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CaptureHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptureHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CaptureHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CaptureHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CaptureHold(ctx, req.(*CaptureHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_VoidHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidHoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).VoidHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/VoidHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).VoidHold(ctx, req.(*VoidHoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreateStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateStandingOrder(ctx, req.(*CreateStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetStandingOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetStandingOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetStandingOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetStandingOrders(ctx, req.(*GetStandingOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_UpdateStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).UpdateStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/UpdateStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).UpdateStandingOrder(ctx, req.(*UpdateStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DeleteStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeleteStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/DeleteStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeleteStandingOrder(ctx, req.(*DeleteStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetStandingOrderExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStandingOrderExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetStandingOrderExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetStandingOrderExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetStandingOrderExecutions(ctx, req.(*GetStandingOrderExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreatePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreatePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreatePaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreatePaymentRequest(ctx, req.(*CreatePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetPaymentRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetPaymentRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetPaymentRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetPaymentRequests(ctx, req.(*GetPaymentRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_PayPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PayPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).PayPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/PayPaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).PayPaymentRequest(ctx, req.(*PayPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_DeclinePaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeclinePaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).DeclinePaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/DeclinePaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).DeclinePaymentRequest(ctx, req.(*DeclinePaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CancelPaymentRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPaymentRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CancelPaymentRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CancelPaymentRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CancelPaymentRequest(ctx, req.(*CancelPaymentRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_CreateSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).CreateSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/CreateSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).CreateSplit(ctx, req.(*CreateSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetSplit(ctx, req.(*GetSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetSplits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSplitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetSplits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetSplits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetSplits(ctx, req.(*GetSplitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_SettleSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SettleSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/SettleSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SettleSplit(ctx, req.(*SettleSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WalletService_GetLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLimitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetLimits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetLimits(ctx, req.(*GetLimitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) CreateTransaction(ctx context.Context, in *CreateTransactionRequest, opts ...grpc.CallOption) (*CreateTransactionResponse, error) {
-	out := new(CreateTransactionResponse)
-	err := c.cc.Invoke(ctx, "/pb.WalletService/CreateTransaction", in, out, opts...)
-	if err != nil {
+func _WalletService_SetLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLimitRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).SetLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/SetLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).SetLimit(ctx, req.(*SetLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) TransferRollback(ctx context.Context, in *TransferRollbackRequest, opts ...grpc.CallOption) (*TransferRollbackResponse, error) {
-	out := new(TransferRollbackResponse)
-	err := c.cc.Invoke(ctx, "/pb.WalletService/TransferRollback", in, out, opts...)
-	if err != nil {
+func _WalletService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(WalletServiceServer).GetTransactionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.WalletService/GetTransactionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletServiceServer).GetTransactionHistory(ctx, req.(*GetTransactionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *walletServiceClient) GetTransactionHistory(ctx context.Context, in *GetTransactionHistoryRequest, opts ...grpc.CallOption) (*GetTransactionHistoryResponse, error) {
-	out := new(GetTransactionHistoryResponse)
-	err := c.cc.Invoke(ctx, "/pb.WalletService/GetTransactionHistory", in, out, opts...)
-	if err != nil {
-		return nil, err
+func _WalletService_StreamTransactionHistory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamTransactionHistoryRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return out, nil
+	return srv.(WalletServiceServer).StreamTransactionHistory(m, &walletServiceStreamTransactionHistoryServer{stream})
 }
 
-// WalletServiceServer is the server API for WalletService service.
-// All implementations must embed UnimplementedWalletServiceServer
-// for forward compatibility
-type WalletServiceServer interface {
-	// User
-	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
-	// Account
-	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
-	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
-	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
-	// Card
-	CreateCard(context.Context, *CreateCardRequest) (*CreateCardResponse, error)
-	GetCards(context.Context, *GetCardsRequest) (*GetCardsResponse, error)
-	DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error)
-	// Transfer
-	CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error)
-	TransferRollback(context.Context, *TransferRollbackRequest) (*TransferRollbackResponse, error)
-	// History
-	GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error)
-	mustEmbedUnimplementedWalletServiceServer()
+type WalletService_StreamTransactionHistoryServer interface {
+	Send(*Transaction) error
+	grpc.ServerStream
 }
 
-// UnimplementedWalletServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedWalletServiceServer struct {
+type walletServiceStreamTransactionHistoryServer struct {
+	grpc.ServerStream
 }
 
-func (UnimplementedWalletServiceServer) CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
-}
-func (UnimplementedWalletServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
-}
-func (UnimplementedWalletServiceServer) GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccounts not implemented")
-}
-func (UnimplementedWalletServiceServer) DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteAccount not implemented")
-}
-func (UnimplementedWalletServiceServer) CreateCard(context.Context, *CreateCardRequest) (*CreateCardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCard not implemented")
+func (x *walletServiceStreamTransactionHistoryServer) Send(m *Transaction) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedWalletServiceServer) GetCards(context.Context, *GetCardsRequest) (*GetCardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCards not implemented")
-}
-func (UnimplementedWalletServiceServer) DeleteCard(context.Context, *DeleteCardRequest) (*DeleteCardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCard not implemented")
-}
-func (UnimplementedWalletServiceServer) CreateTransaction(context.Context, *CreateTransactionRequest) (*CreateTransactionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTransaction not implemented")
-}
-func (UnimplementedWalletServiceServer) TransferRollback(context.Context, *TransferRollbackRequest) (*TransferRollbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferRollback not implemented")
-}
-func (UnimplementedWalletServiceServer) GetTransactionHistory(context.Context, *GetTransactionHistoryRequest) (*GetTransactionHistoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionHistory not implemented")
+
+func _WalletService_GetStatement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetStatementRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WalletServiceServer).GetStatement(m, &walletServiceGetStatementServer{stream})
 }
-func (UnimplementedWalletServiceServer) mustEmbedUnimplementedWalletServiceServer() {}
 
-// UnsafeWalletServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to WalletServiceServer will
-// result in compilation errors.
-type UnsafeWalletServiceServer interface {
-	mustEmbedUnimplementedWalletServiceServer()
+type WalletService_GetStatementServer interface {
+	Send(*GetStatementResponse) error
+	grpc.ServerStream
 }
 
-func RegisterWalletServiceServer(s grpc.ServiceRegistrar, srv WalletServiceServer) {
-	s.RegisterService(&WalletService_ServiceDesc, srv)
+type walletServiceGetStatementServer struct {
+	grpc.ServerStream
 }
 
-func _WalletService_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateWalletRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(WalletServiceServer).CreateWallet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/pb.WalletService/CreateWallet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CreateWallet(ctx, req.(*CreateWalletRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (x *walletServiceGetStatementServer) Send(m *GetStatementResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _WalletService_CreateAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAccountRequest)
+func _WalletService_SetTransactionLabels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTransactionLabelsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).CreateAccount(ctx, in)
+		return srv.(WalletServiceServer).SetTransactionLabels(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/CreateAccount",
+		FullMethod: "/pb.WalletService/SetTransactionLabels",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CreateAccount(ctx, req.(*CreateAccountRequest))
+		return srv.(WalletServiceServer).SetTransactionLabels(ctx, req.(*SetTransactionLabelsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountsRequest)
+func _WalletService_GetSpendingSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSpendingSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).GetAccounts(ctx, in)
+		return srv.(WalletServiceServer).GetSpendingSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/GetAccounts",
+		FullMethod: "/pb.WalletService/GetSpendingSummary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).GetAccounts(ctx, req.(*GetAccountsRequest))
+		return srv.(WalletServiceServer).GetSpendingSummary(ctx, req.(*GetSpendingSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteAccountRequest)
+func _WalletService_SetBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBudgetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).DeleteAccount(ctx, in)
+		return srv.(WalletServiceServer).SetBudget(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/DeleteAccount",
+		FullMethod: "/pb.WalletService/SetBudget",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+		return srv.(WalletServiceServer).SetBudget(ctx, req.(*SetBudgetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_CreateCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCardRequest)
+func _WalletService_DeleteBudget_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBudgetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).CreateCard(ctx, in)
+		return srv.(WalletServiceServer).DeleteBudget(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/CreateCard",
+		FullMethod: "/pb.WalletService/DeleteBudget",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CreateCard(ctx, req.(*CreateCardRequest))
+		return srv.(WalletServiceServer).DeleteBudget(ctx, req.(*DeleteBudgetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCardsRequest)
+func _WalletService_GetBudgets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBudgetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).GetCards(ctx, in)
+		return srv.(WalletServiceServer).GetBudgets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/GetCards",
+		FullMethod: "/pb.WalletService/GetBudgets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).GetCards(ctx, req.(*GetCardsRequest))
+		return srv.(WalletServiceServer).GetBudgets(ctx, req.(*GetBudgetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_DeleteCard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteCardRequest)
+func _WalletService_CreatePot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).DeleteCard(ctx, in)
+		return srv.(WalletServiceServer).CreatePot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/DeleteCard",
+		FullMethod: "/pb.WalletService/CreatePot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).DeleteCard(ctx, req.(*DeleteCardRequest))
+		return srv.(WalletServiceServer).CreatePot(ctx, req.(*CreatePotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_CreateTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTransactionRequest)
+func _WalletService_UpdatePot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).CreateTransaction(ctx, in)
+		return srv.(WalletServiceServer).UpdatePot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/CreateTransaction",
+		FullMethod: "/pb.WalletService/UpdatePot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).CreateTransaction(ctx, req.(*CreateTransactionRequest))
+		return srv.(WalletServiceServer).UpdatePot(ctx, req.(*UpdatePotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_TransferRollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(TransferRollbackRequest)
+func _WalletService_DeletePot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePotRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).TransferRollback(ctx, in)
+		return srv.(WalletServiceServer).DeletePot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/TransferRollback",
+		FullMethod: "/pb.WalletService/DeletePot",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).TransferRollback(ctx, req.(*TransferRollbackRequest))
+		return srv.(WalletServiceServer).DeletePot(ctx, req.(*DeletePotRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WalletService_GetTransactionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTransactionHistoryRequest)
+func _WalletService_MovePotMoney_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MovePotMoneyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WalletServiceServer).GetTransactionHistory(ctx, in)
+		return srv.(WalletServiceServer).MovePotMoney(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.WalletService/GetTransactionHistory",
+		FullMethod: "/pb.WalletService/MovePotMoney",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WalletServiceServer).GetTransactionHistory(ctx, req.(*GetTransactionHistoryRequest))
+		return srv.(WalletServiceServer).MovePotMoney(ctx, req.(*MovePotMoneyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -410,6 +1921,26 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteAccount",
 			Handler:    _WalletService_DeleteAccount_Handler,
 		},
+		{
+			MethodName: "CloseAccount",
+			Handler:    _WalletService_CloseAccount_Handler,
+		},
+		{
+			MethodName: "FreezeAccount",
+			Handler:    _WalletService_FreezeAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeAccount",
+			Handler:    _WalletService_UnfreezeAccount_Handler,
+		},
+		{
+			MethodName: "SetDefaultAccount",
+			Handler:    _WalletService_SetDefaultAccount_Handler,
+		},
+		{
+			MethodName: "ListCurrencies",
+			Handler:    _WalletService_ListCurrencies_Handler,
+		},
 		{
 			MethodName: "CreateCard",
 			Handler:    _WalletService_CreateCard_Handler,
@@ -422,19 +1953,182 @@ var WalletService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCard",
 			Handler:    _WalletService_DeleteCard_Handler,
 		},
+		{
+			MethodName: "ReplaceCard",
+			Handler:    _WalletService_ReplaceCard_Handler,
+		},
+		{
+			MethodName: "SetCardStatus",
+			Handler:    _WalletService_SetCardStatus_Handler,
+		},
+		{
+			MethodName: "SetCardNickname",
+			Handler:    _WalletService_SetCardNickname_Handler,
+		},
+		{
+			MethodName: "SetCardPin",
+			Handler:    _WalletService_SetCardPin_Handler,
+		},
+		{
+			MethodName: "VerifyCardPin",
+			Handler:    _WalletService_VerifyCardPin_Handler,
+		},
+		{
+			MethodName: "RevealCardNumber",
+			Handler:    _WalletService_RevealCardNumber_Handler,
+		},
 		{
 			MethodName: "CreateTransaction",
 			Handler:    _WalletService_CreateTransaction_Handler,
 		},
+		{
+			MethodName: "BatchTransfer",
+			Handler:    _WalletService_BatchTransfer_Handler,
+		},
+		{
+			MethodName: "CreateQuote",
+			Handler:    _WalletService_CreateQuote_Handler,
+		},
 		{
 			MethodName: "TransferRollback",
 			Handler:    _WalletService_TransferRollback_Handler,
 		},
+		{
+			MethodName: "RefundTransaction",
+			Handler:    _WalletService_RefundTransaction_Handler,
+		},
+		{
+			MethodName: "ReverseTransaction",
+			Handler:    _WalletService_ReverseTransaction_Handler,
+		},
+		{
+			MethodName: "AuthorizeHold",
+			Handler:    _WalletService_AuthorizeHold_Handler,
+		},
+		{
+			MethodName: "CaptureHold",
+			Handler:    _WalletService_CaptureHold_Handler,
+		},
+		{
+			MethodName: "VoidHold",
+			Handler:    _WalletService_VoidHold_Handler,
+		},
+		{
+			MethodName: "CreateStandingOrder",
+			Handler:    _WalletService_CreateStandingOrder_Handler,
+		},
+		{
+			MethodName: "GetStandingOrders",
+			Handler:    _WalletService_GetStandingOrders_Handler,
+		},
+		{
+			MethodName: "UpdateStandingOrder",
+			Handler:    _WalletService_UpdateStandingOrder_Handler,
+		},
+		{
+			MethodName: "DeleteStandingOrder",
+			Handler:    _WalletService_DeleteStandingOrder_Handler,
+		},
+		{
+			MethodName: "GetStandingOrderExecutions",
+			Handler:    _WalletService_GetStandingOrderExecutions_Handler,
+		},
+		{
+			MethodName: "CreatePaymentRequest",
+			Handler:    _WalletService_CreatePaymentRequest_Handler,
+		},
+		{
+			MethodName: "GetPaymentRequests",
+			Handler:    _WalletService_GetPaymentRequests_Handler,
+		},
+		{
+			MethodName: "PayPaymentRequest",
+			Handler:    _WalletService_PayPaymentRequest_Handler,
+		},
+		{
+			MethodName: "DeclinePaymentRequest",
+			Handler:    _WalletService_DeclinePaymentRequest_Handler,
+		},
+		{
+			MethodName: "CancelPaymentRequest",
+			Handler:    _WalletService_CancelPaymentRequest_Handler,
+		},
+		{
+			MethodName: "CreateSplit",
+			Handler:    _WalletService_CreateSplit_Handler,
+		},
+		{
+			MethodName: "GetSplit",
+			Handler:    _WalletService_GetSplit_Handler,
+		},
+		{
+			MethodName: "GetSplits",
+			Handler:    _WalletService_GetSplits_Handler,
+		},
+		{
+			MethodName: "SettleSplit",
+			Handler:    _WalletService_SettleSplit_Handler,
+		},
+		{
+			MethodName: "GetLimits",
+			Handler:    _WalletService_GetLimits_Handler,
+		},
+		{
+			MethodName: "SetLimit",
+			Handler:    _WalletService_SetLimit_Handler,
+		},
 		{
 			MethodName: "GetTransactionHistory",
 			Handler:    _WalletService_GetTransactionHistory_Handler,
 		},
+		{
+			MethodName: "SetTransactionLabels",
+			Handler:    _WalletService_SetTransactionLabels_Handler,
+		},
+		{
+			MethodName: "GetSpendingSummary",
+			Handler:    _WalletService_GetSpendingSummary_Handler,
+		},
+		{
+			MethodName: "SetBudget",
+			Handler:    _WalletService_SetBudget_Handler,
+		},
+		{
+			MethodName: "DeleteBudget",
+			Handler:    _WalletService_DeleteBudget_Handler,
+		},
+		{
+			MethodName: "GetBudgets",
+			Handler:    _WalletService_GetBudgets_Handler,
+		},
+		{
+			MethodName: "CreatePot",
+			Handler:    _WalletService_CreatePot_Handler,
+		},
+		{
+			MethodName: "UpdatePot",
+			Handler:    _WalletService_UpdatePot_Handler,
+		},
+		{
+			MethodName: "DeletePot",
+			Handler:    _WalletService_DeletePot_Handler,
+		},
+		{
+			MethodName: "MovePotMoney",
+			Handler:    _WalletService_MovePotMoney_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTransactionHistory",
+			Handler:       _WalletService_StreamTransactionHistory_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetStatement",
+			Handler:       _WalletService_GetStatement_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "wallet.proto",
 }
@@ -11,6 +11,7 @@ import (
 const (
 	authorizationHeader = "authorization"
 	authorizationType   = "Bearer"
+	serviceKeyHeader    = "x-service-key"
 )
 
 func GetAccessToken(ctx context.Context) (string, error) {
@@ -32,3 +33,21 @@ func GetAccessToken(ctx context.Context) (string, error) {
 	}
 	return "", errs.B().Code(errs.Unauthenticated).Msg("missing metadata for authorization").Err()
 }
+
+// GetServiceKey returns the key a service sent to authenticate itself on an internal request
+func GetServiceKey(ctx context.Context) (string, error) {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		keys := md.Get(serviceKeyHeader)
+		if len(keys) == 0 || keys[0] == "" {
+			return "", errs.B().Code(errs.Unauthenticated).Msg("missing service key header").Err()
+		}
+		return keys[0], nil
+	}
+	return "", errs.B().Code(errs.Unauthenticated).Msg("missing metadata for service key").Err()
+}
+
+// SetServiceKey attaches the service key to the outgoing metadata, so that internal requests are authenticated
+// as coming from a service instead of a user
+func SetServiceKey(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, serviceKeyHeader, key)
+}
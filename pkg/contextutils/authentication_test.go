@@ -58,3 +58,58 @@ func TestGetAccessToken(t *testing.T) {
 		}
 	}
 }
+
+func TestGetServiceKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		expErr bool
+	}{
+		{
+			name: "success",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				serviceKeyHeader, gofakeit.UUID(),
+			)),
+			expErr: false,
+		},
+		{
+			name: "missing service key header",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				authorizationHeader, authorizationType+" "+gofakeit.UUID(),
+			)),
+			expErr: true,
+		},
+		{
+			name: "empty service key",
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs(
+				serviceKeyHeader, "",
+			)),
+			expErr: true,
+		},
+		{
+			name:   "missing metadata",
+			ctx:    context.Background(),
+			expErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		key, err := GetServiceKey(tt.ctx)
+		require.Equal(t, err != nil, tt.expErr)
+		if !tt.expErr {
+			require.NotEmpty(t, key)
+		}
+	}
+}
+
+func TestSetServiceKey(t *testing.T) {
+	key := gofakeit.UUID()
+	ctx := SetServiceKey(context.Background(), key)
+	md, ok := metadata.FromOutgoingContext(ctx)
+	require.True(t, ok)
+	require.Equal(t, []string{key}, md.Get(serviceKeyHeader))
+	// The key is read back once the outgoing metadata is received
+	got, err := GetServiceKey(metadata.NewIncomingContext(context.Background(), md))
+	require.NoError(t, err)
+	require.Equal(t, key, got)
+}
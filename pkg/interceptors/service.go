@@ -0,0 +1,34 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/lordvidex/errs"
+	"google.golang.org/grpc"
+)
+
+// ServiceKeyUnaryInterceptor returns a UnaryServerInterceptor that only lets requests carrying the service key reach
+// the methods of internalService (e.g. "/pb.AuthInternalService/"), other requests are passed through untouched.
+// Internal services are called by other services on behalf of the system, so they're guarded by a key shared between
+// the services instead of a user's access token.
+func ServiceKeyUnaryInterceptor(
+	internalService string,
+	serviceKey string,
+) func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !strings.HasPrefix(info.FullMethod, internalService) {
+			return handler(ctx, req)
+		}
+		key, err := contextutils.GetServiceKey(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if serviceKey == "" || subtle.ConstantTimeCompare([]byte(key), []byte(serviceKey)) != 1 {
+			return nil, errs.B().Code(errs.Unauthenticated).Msg("invalid service key").Err()
+		}
+		return handler(ctx, req)
+	}
+}
@@ -40,3 +40,45 @@ func TokenUnaryInterceptor(
 		return handler(ctx, req)
 	}
 }
+
+// TokenStreamInterceptor returns a StreamServerInterceptor that validates the access token and set the user id in the stream's context.
+// It's the streaming counterpart of TokenUnaryInterceptor, with the same arguments.
+func TokenStreamInterceptor(
+	unauthorizedRequests []string,
+	tokenValidator func(ctx context.Context, token string) (string, error),
+) func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Extract clientIP & userAgent of the current request
+		ctx := ss.Context()
+		clientIP, userAgent := contextutils.GetMetadata(ctx)
+		ctx = contextutils.SetForwardMetadata(ctx, clientIP, userAgent)
+		// check if request is unauthorized and skip auth
+		for _, request := range unauthorizedRequests {
+			if info.FullMethod == request {
+				return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+			}
+		}
+		// Get access token from context
+		accessToken, err := contextutils.GetAccessToken(ctx)
+		if err != nil {
+			return err
+		}
+		userID, err := tokenValidator(ctx, accessToken)
+		if err != nil {
+			return errs.B(err).Code(errs.Unauthenticated).Msg("failed to validate token").Err()
+		}
+		// Set user-id in context
+		ctx = contextutils.SetUserID(ctx, userID)
+		return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+// contextStream is a server stream with its context replaced
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -3,8 +3,10 @@ package main
 import "time"
 
 type config struct {
+	Environment  string `mapstructure:"WALLET_ENVIRONMENT"` // development generates the missing card vault key & allows a missing auth service key
 	GrpcPort     string `mapstructure:"WALLET_GRPC_PORT"`
 	TokenGrpcUrl string `mapstructure:"TOKEN_GRPC_URL"`
+	AuthGrpcUrl  string `mapstructure:"AUTH_GRPC_URL"`
 	// Tracing
 	TracingEnable            bool   `mapstructure:"WALLET_TRACING_ENABLE"`
 	TracingJaegerEnable      bool   `mapstructure:"WALLET_TRACING_JAEGER_ENABLE"`
@@ -18,13 +20,51 @@ type config struct {
 	// Token server
 	TokenGrpcTlsEnable       bool   `mapstructure:"TOKEN_GRPC_TLS_ENABLE"`
 	TokenGrpcTlsUserCertFile string `mapstructure:"WALLET_TOKEN_GRPC_TLS_USER_CERT_FILE"`
+	// Auth server
+	AuthGrpcTlsEnable       bool   `mapstructure:"AUTH_GRPC_TLS_ENABLE"`
+	AuthGrpcTlsUserCertFile string `mapstructure:"WALLET_AUTH_GRPC_TLS_USER_CERT_FILE"`
+	AuthServiceKey          string `mapstructure:"AUTH_SERVICE_KEY"` // key shared with auth to call its internal service
 	// Storage
 	DatabaseUrl           string `mapstructure:"WALLET_DATABASE_URL"`
 	DatabaseMigrationPath string `mapstructure:"WALLET_DATABASE_MIGRATION_PATH"`
 	// Card generation
-	CardNumberLength int `mapstructure:"WALLET_CARD_NUMBER_LENGTH"`
+	CardNumberLength  int    `mapstructure:"WALLET_CARD_NUMBER_LENGTH"`
+	CardBin           string `mapstructure:"WALLET_CARD_BIN"` // prefix of the issued cards numbers
+	CardValidityYears int    `mapstructure:"WALLET_CARD_VALIDITY_YEARS"`
+	// Card PIN
+	CardPinMaxAttempts int           `mapstructure:"WALLET_CARD_PIN_MAX_ATTEMPTS"` // failed attempts in a row locking the PIN
+	CardPinLockout     time.Duration `mapstructure:"WALLET_CARD_PIN_LOCKOUT"`
+	// Card vault
+	CardVaultKeyFile         string `mapstructure:"WALLET_CARD_VAULT_KEY_FILE"`          // base64 encoded 32 bytes key encrypting the cards numbers
+	CardVaultPreviousKeyFile string `mapstructure:"WALLET_CARD_VAULT_PREVIOUS_KEY_FILE"` // key being rotated out, the cards it encrypted are rekeyed on startup
+	// Statements
+	StatementBankID string `mapstructure:"WALLET_STATEMENT_BANK_ID"` // bank id of the accounts in the OFX statements
 	// Locker
 	LockerCleanupDuration time.Duration `mapstructure:"WALLET_LOCKER_CLEANUP_DURATION"`
+	// Idempotency
+	IdempotencyRetention     time.Duration `mapstructure:"WALLET_IDEMPOTENCY_RETENTION"`
+	IdempotencyPurgeInterval time.Duration `mapstructure:"WALLET_IDEMPOTENCY_PURGE_INTERVAL"` // clears the keys past their retention so they can be used again
+	// Ledger
+	LedgerReconcileInterval time.Duration `mapstructure:"WALLET_LEDGER_RECONCILE_INTERVAL"`
+	// Exchange
+	ExchangeRatesFile string        `mapstructure:"WALLET_EXCHANGE_RATES_FILE"`
+	ExchangeQuoteTTL  time.Duration `mapstructure:"WALLET_EXCHANGE_QUOTE_TTL"`
+	// Holds
+	HoldTTL            time.Duration `mapstructure:"WALLET_HOLD_TTL"`
+	HoldExpireInterval time.Duration `mapstructure:"WALLET_HOLD_EXPIRE_INTERVAL"`
+	// Standing orders
+	StandingOrderInterval time.Duration `mapstructure:"WALLET_STANDING_ORDER_INTERVAL"`
+	// Payment requests
+	PaymentRequestTTL            time.Duration `mapstructure:"WALLET_PAYMENT_REQUEST_TTL"`
+	PaymentRequestExpireInterval time.Duration `mapstructure:"WALLET_PAYMENT_REQUEST_EXPIRE_INTERVAL"`
+	// Budgets
+	BudgetCheckInterval time.Duration `mapstructure:"WALLET_BUDGET_CHECK_INTERVAL"`
+	// RabbitMQ
+	RabbitmqUrl                     string `mapstructure:"WALLET_RABBITMQ_URL"`
+	RabbitmqPaymentRequestQueueName string `mapstructure:"WALLET_RABBITMQ_PAYMENT_REQUEST_QUEUE_NAME"`
+	RabbitmqBudgetAlertQueueName    string `mapstructure:"WALLET_RABBITMQ_BUDGET_ALERT_QUEUE_NAME"`
+	// Ops
+	OpsUserIDs []string `mapstructure:"WALLET_OPS_USER_IDS"` // comma separated users ids allowed to reverse any transaction, to raise limits & to freeze accounts
 }
 
 var cfg config
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/escalopa/fingo/wallet/internal/application"
+)
+
+// startJobs runs the background jobs until appCtx is done
+func startJobs(appCtx context.Context, uc *application.UseCases) {
+	go runEvery(appCtx, cfg.LedgerReconcileInterval, "ledger reconciliation", func(ctx context.Context) error {
+		mismatches, err := uc.ReconcileLedger.Execute(ctx, application.ReconcileLedgerParams{})
+		if err != nil {
+			return err
+		}
+		for _, m := range mismatches {
+			log.Printf("ledger mismatch on account %d, stored balance: %d, ledger balance: %d",
+				m.AccountID, m.StoredBalance, m.LedgerBalance)
+		}
+		return nil
+	})
+	go runEvery(appCtx, cfg.IdempotencyPurgeInterval, "idempotency keys purge", func(ctx context.Context) error {
+		purged, err := uc.PurgeIdempotencyKeys.Execute(ctx, application.PurgeIdempotencyKeysParams{})
+		if err != nil {
+			return err
+		}
+		if purged > 0 {
+			log.Printf("%d idempotency keys purged", purged)
+		}
+		return nil
+	})
+	go runEvery(appCtx, cfg.HoldExpireInterval, "holds expiry", func(ctx context.Context) error {
+		expired, err := uc.ExpireHolds.Execute(ctx, application.ExpireHoldsParams{})
+		if err != nil {
+			return err
+		}
+		if expired > 0 {
+			log.Printf("%d holds expired", expired)
+		}
+		return nil
+	})
+	go runEvery(appCtx, cfg.PaymentRequestExpireInterval, "payment requests expiry", func(ctx context.Context) error {
+		expired, err := uc.ExpirePaymentRequests.Execute(ctx, application.ExpirePaymentRequestsParams{})
+		if err != nil {
+			return err
+		}
+		if expired > 0 {
+			log.Printf("%d payment requests expired", expired)
+		}
+		return nil
+	})
+	go runEvery(appCtx, cfg.StandingOrderInterval, "standing orders", func(ctx context.Context) error {
+		executed, err := uc.ExecuteStandingOrders.Execute(ctx, application.ExecuteStandingOrdersParams{})
+		if executed > 0 {
+			log.Printf("%d standing orders executed", executed)
+		}
+		return err
+	})
+	go runEvery(appCtx, cfg.BudgetCheckInterval, "budget alerts", func(ctx context.Context) error {
+		sent, err := uc.CheckBudgets.Execute(ctx, application.CheckBudgetsParams{})
+		if sent > 0 {
+			log.Printf("%d budget alerts sent", sent)
+		}
+		return err
+	})
+}
+
+// runEvery calls job every interval until ctx is done, a zero interval disables the job
+func runEvery(ctx context.Context, interval time.Duration, name string, job func(ctx context.Context) error) {
+	if interval <= 0 {
+		log.Printf("%s job disabled", name)
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if err := job(ctx); err != nil {
+				log.Printf("%s job failed: %v", name, err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
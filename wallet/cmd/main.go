@@ -2,16 +2,25 @@ package main
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"github.com/escalopa/fingo/pkg/global"
+	"github.com/escalopa/fingo/pkg/tls"
 	"github.com/escalopa/fingo/pkg/tracer"
 	"github.com/escalopa/fingo/pkg/validator"
 
+	"github.com/escalopa/fingo/wallet/internal/adapters/auth"
 	"github.com/escalopa/fingo/wallet/internal/adapters/db"
+	"github.com/escalopa/fingo/wallet/internal/adapters/exchange"
+	"github.com/escalopa/fingo/wallet/internal/adapters/hasher"
 	"github.com/escalopa/fingo/wallet/internal/adapters/locker"
 	"github.com/escalopa/fingo/wallet/internal/adapters/numgen"
+	"github.com/escalopa/fingo/wallet/internal/adapters/queue/rabbitmq"
+	"github.com/escalopa/fingo/wallet/internal/adapters/statement"
+	"github.com/escalopa/fingo/wallet/internal/adapters/vault"
 	"github.com/escalopa/fingo/wallet/internal/application"
+	"github.com/google/uuid"
 )
 
 func main() {
@@ -23,6 +32,9 @@ func main() {
 
 	// Load cofigurations
 	global.CheckError(global.LoadConfig(&cfg, "app", "./wallet", "env"), "failed to load configurations")
+	if cfg.AuthServiceKey == "" && cfg.Environment != "development" {
+		global.CheckError(errors.New("AUTH_SERVICE_KEY is empty"), "failed to load auth service key")
+	}
 
 	// Create validator
 	v := validator.NewValidator()
@@ -40,11 +52,66 @@ func main() {
 	ur := db.NewUserRepository(conn)
 	cr := db.NewCardRepository(conn)
 	ar := db.NewAccountRepository(conn)
+	cur := db.NewCurrencyRepository(conn)
 	tr := db.NewTransactionRepository(conn)
+	lr := db.NewLedgerRepository(conn)
+	qr := db.NewQuoteRepository(conn)
+	hr := db.NewHoldRepository(conn)
+	sr := db.NewStandingOrderRepository(conn)
+	pr := db.NewPaymentRequestRepository(conn)
+	spr := db.NewSplitRepository(conn)
+	lmr := db.NewLimitRepository(conn)
+	br := db.NewBudgetRepository(conn)
+	ptr := db.NewPotRepository(conn)
+
+	// Load exchange rates
+	erp, err := exchange.LoadStaticRateProvider(cfg.ExchangeRatesFile)
+	global.CheckError(err, "failed to load exchange rates")
+	log.Println("exchange rates loaded from:", cfg.ExchangeRatesFile)
+
+	// Connect to the auth service to find the recipients addressed by username or email
+	authCreds, err := tls.LoadClientTLS(cfg.AuthGrpcTlsEnable, cfg.AuthGrpcTlsUserCertFile)
+	global.CheckError(err, "failed to load auth TLS certificates")
+	ud, err := auth.NewUserDirectory(cfg.AuthGrpcUrl, authCreds, cfg.AuthServiceKey)
+	global.CheckError(err, "failed to create auth user directory")
+	log.Println("auth user directory created")
+
+	// Create a rabbitmq producer to notify users through the contact service
+	rbp, err := rabbitmq.NewProducer(cfg.RabbitmqUrl,
+		rabbitmq.WithPaymentRequestQueue(cfg.RabbitmqPaymentRequestQueueName),
+		rabbitmq.WithBudgetAlertQueue(cfg.RabbitmqBudgetAlertQueueName),
+	)
+	global.CheckError(err, "failed to connect to rabbitmq")
+	defer func() {
+		if err := rbp.Close(); err != nil {
+			log.Println("failed to close rabbitmq producer", err)
+		}
+	}()
+	log.Println("successfully connected to rabbitmq")
 
 	// Create a new number generator
-	cng := numgen.NewNumGen(cfg.CardNumberLength)
-	log.Println("Card number generator created with card number length:", cfg.CardNumberLength)
+	cng, err := numgen.NewNumGen(cfg.CardNumberLength, cfg.CardBin)
+	global.CheckError(err, "failed to create card number generator")
+	log.Println("Card number generator created with card number length:", cfg.CardNumberLength, "bin:", cfg.CardBin)
+
+	// Create a hasher for the cards CVVs
+	sh := hasher.NewBcryptHasher()
+
+	// Load the vault encrypting the cards numbers
+	cv, err := vault.LoadVault(cfg.CardVaultKeyFile, cfg.Environment == "development")
+	global.CheckError(err, "failed to load card vault")
+	log.Println("card vault loaded")
+
+	// Load the vault of the key being rotated out if any
+	var pcv application.CardVault
+	if cfg.CardVaultPreviousKeyFile != "" {
+		pcv, err = vault.LoadVault(cfg.CardVaultPreviousKeyFile, false)
+		global.CheckError(err, "failed to load previous card vault")
+		log.Println("previous card vault loaded")
+	}
+
+	// Create the statements renderer
+	str := statement.NewRenderer(cfg.StatementBankID)
 
 	// Create a new tracer
 	t, err := tracer.LoadTracer(
@@ -61,6 +128,13 @@ func main() {
 	// Create an ids locker
 	l := locker.NewLocker(appCtx, cfg.LockerCleanupDuration)
 
+	// Parse operations users ids
+	opsUserIDs := make([]uuid.UUID, len(cfg.OpsUserIDs))
+	for i, id := range cfg.OpsUserIDs {
+		opsUserIDs[i], err = uuid.Parse(id)
+		global.CheckError(err, "failed to parse ops user id")
+	}
+
 	// Create use cases
 	uc := application.NewUseCases(
 		application.WithValidator(v),
@@ -68,10 +142,47 @@ func main() {
 		application.WithUserRepository(ur),
 		application.WithCardRepository(cr),
 		application.WithAccountRepository(ar),
+		application.WithCurrencyRepository(cur),
 		application.WithTransactionRepository(tr),
+		application.WithLedgerRepository(lr),
+		application.WithQuoteRepository(qr),
+		application.WithHoldRepository(hr),
+		application.WithStandingOrderRepository(sr),
+		application.WithPaymentRequestRepository(pr),
+		application.WithSplitRepository(spr),
+		application.WithLimitRepository(lmr),
+		application.WithBudgetRepository(br),
+		application.WithPotRepository(ptr),
+		application.WithExchangeRateProvider(erp),
 		application.WithCardNumberGenerator(cng),
+		application.WithSecretHasher(sh),
+		application.WithCardVault(cv),
+		application.WithPreviousCardVault(pcv),
+		application.WithStatementRenderer(str),
+		application.WithUserDirectory(ud),
+		application.WithMessageProducer(rbp),
+		application.WithIdempotencyRetention(cfg.IdempotencyRetention),
+		application.WithQuoteTTL(cfg.ExchangeQuoteTTL),
+		application.WithHoldTTL(cfg.HoldTTL),
+		application.WithPaymentRequestTTL(cfg.PaymentRequestTTL),
+		application.WithCardValidity(cfg.CardValidityYears),
+		application.WithCardPINLockout(cfg.CardPinMaxAttempts, cfg.CardPinLockout),
+		application.WithOpsUsers(opsUserIDs),
 	)
 
+	// Re-encrypt the numbers of the cards encrypted with the previous key
+	rekeyed, err := uc.RekeyCards.Execute(appCtx, application.RekeyCardsParams{})
+	global.CheckError(err, "failed to rekey cards")
+	log.Println("cards rekeyed:", rekeyed)
+
+	// Encrypt the numbers of the cards created before the vault
+	vaulted, err := uc.VaultCards.Execute(appCtx, application.VaultCardsParams{})
+	global.CheckError(err, "failed to vault cards")
+	log.Println("cards vaulted:", vaulted)
+
+	// Start background jobs
+	startJobs(appCtx, uc)
+
 	// Start gRPC server
 	global.CheckError(start(appCtx, uc), "failed to start gRPC server")
 }
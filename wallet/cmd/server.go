@@ -80,7 +80,7 @@ func loadInterceptor(opts *[]grpc.ServerOption) error {
 	if err != nil {
 		return errs.B(err).Msg("failed to create token gRPC interceptor").Err()
 	}
-	*opts = append(*opts, grpc.UnaryInterceptor(interceptor.Unary()))
+	*opts = append(*opts, grpc.UnaryInterceptor(interceptor.Unary()), grpc.StreamInterceptor(interceptor.Stream()))
 	log.Println("created gRPC token interceptor")
 	return nil
 }
@@ -0,0 +1,73 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/escalopa/fingo/pb"
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+// UserDirectory looks users up in the internal service of auth
+type UserDirectory struct {
+	c   pb.AuthInternalServiceClient
+	key string
+}
+
+// NewUserDirectory returns a new UserDirectory connected to the auth service, key is the service key shared with auth
+func NewUserDirectory(url string, creds credentials.TransportCredentials, key string) (*UserDirectory, error) {
+	client, err := grpc.Dial(url, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, errs.B(err).Code(errs.InvalidArgument).Msg("failed to connect to auth service").Err()
+	}
+	return &UserDirectory{c: pb.NewAuthInternalServiceClient(client), key: key}, nil
+}
+
+// LookupUser returns the user with the given username, email or external id
+// The lookup is authenticated with the service key, so it works without a user's token, e.g. in background jobs
+func (ud *UserDirectory) LookupUser(ctx context.Context, params core.LookupUserParams) (core.User, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "UserDirectory.LookupUser")
+	defer span.End()
+	ctx = contextutils.SetServiceKey(ctx, ud.key)
+	req := &pb.LookupUserRequest{}
+	switch {
+	case params.Username != "":
+		req.User = &pb.LookupUserRequest_Username{Username: params.Username}
+	case params.Email != "":
+		req.User = &pb.LookupUserRequest_Email{Email: params.Email}
+	default:
+		req.User = &pb.LookupUserRequest_UserId{UserId: params.UserID.String()}
+	}
+	response, err := ud.c.LookupUser(ctx, req)
+	if err != nil {
+		if isNotFound(err) {
+			return core.User{}, errs.B(err).Code(errs.NotFound).Msg("user not found").Err()
+		}
+		return core.User{}, errs.B(err).Code(errs.Internal).Msg("failed to lookup user").Err()
+	}
+	userID, err := uuid.Parse(response.GetUserId())
+	if err != nil {
+		return core.User{}, errs.B(err).Code(errs.Internal).Msg("invalid user id").Err()
+	}
+	return core.User{
+		ID:        userID,
+		Username:  response.GetUsername(),
+		FirstName: response.GetFirstName(),
+		LastName:  response.GetLastName(),
+		Email:     response.GetEmail(),
+	}, nil
+}
+
+// isNotFound reports whether the auth service found no user, its errors reach us with their code in the message
+func isNotFound(err error) bool {
+	s := status.Convert(err)
+	return s.Code() == codes.NotFound || strings.HasPrefix(s.Message(), errs.NotFound.String()+":")
+}
@@ -3,10 +3,13 @@ package db
 import (
 	"context"
 	"database/sql"
+	"fmt"
+	"time"
 
 	"github.com/escalopa/fingo/pkg/tracer"
 	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
 	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
 	"github.com/lordvidex/errs"
 )
 
@@ -29,7 +32,7 @@ func (r *AccountRepository) CreateAccount(ctx context.Context, params core.Creat
 	}
 	defer func() { err = deferTx(tx, err) }()
 	// Get currency id
-	currencyID, err := r.q.GetCurrencyByName(ctx, tx, params.Currency.String())
+	currency, err := r.q.GetCurrencyByCode(ctx, tx, params.Currency.String())
 	if err != nil {
 		return errs.B(err).Code(errs.NotFound).Msg("failed to get currency id").Err()
 	}
@@ -37,7 +40,7 @@ func (r *AccountRepository) CreateAccount(ctx context.Context, params core.Creat
 	err = r.q.CreateAccount(ctx, tx, sqlc.CreateAccountParams{
 		UserID:     params.UserID,
 		Name:       params.Name,
-		CurrencyID: currencyID,
+		CurrencyID: currency.ID,
 	})
 	if err != nil {
 		if IsUniqueViolationError(err) {
@@ -69,6 +72,26 @@ func (r *AccountRepository) GetAccount(ctx context.Context, accountID int64) (co
 	return fromDBAccountToAccount(account), nil
 }
 
+// GetAccountBalanceAt returns the ledger balance of the account right before `at`, before the transactions created from then on
+func (r *AccountRepository) GetAccountBalanceAt(ctx context.Context, accountID int64, at time.Time) (int64, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "AccountRepository.GetAccountBalanceAt")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	balance, err := r.q.GetAccountBalanceAt(ctx, tx, sqlc.GetAccountBalanceAtParams{At: at, ID: accountID})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return 0, errorNotFound(err, "account not found")
+		} else {
+			return 0, errorQuery(err, "failed to get account balance")
+		}
+	}
+	return balance, nil
+}
+
 // GetAccounts returns all accounts for given user
 func (r *AccountRepository) GetAccounts(ctx context.Context, userID int64) ([]core.Account, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "AccountRepository.GetAccounts")
@@ -93,43 +116,168 @@ func (r *AccountRepository) GetAccounts(ctx context.Context, userID int64) ([]co
 	return res, nil
 }
 
-// DeleteAccount deletes account by given id
-func (r *AccountRepository) DeleteAccount(ctx context.Context, accountID int64) error {
-	ctx, span := tracer.Tracer().Start(ctx, "AccountRepository.DeleteAccount")
+// GetReceivingAccount returns the account receiving the payments addressed to a user in a currency,
+// the user's default account in that currency or else its oldest one
+func (r *AccountRepository) GetReceivingAccount(ctx context.Context, userID uuid.UUID, currency core.Currency) (core.Account, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "AccountRepository.GetReceivingAccount")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Account{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	accountID, err := r.q.GetReceivingAccount(ctx, tx, sqlc.GetReceivingAccountParams{
+		ExternalID: userID,
+		Code:       currency.String(),
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Account{}, errorNotFound(err, fmt.Sprintf("recipient has no account in %s", currency))
+		} else {
+			return core.Account{}, errorQuery(err, "failed to get receiving account")
+		}
+	}
+	account, err := r.q.GetAccount(ctx, tx, accountID)
+	if err != nil {
+		return core.Account{}, errorQuery(err, "failed to get account")
+	}
+	return fromDBAccountToAccount(account), nil
+}
+
+// SetDefaultAccount makes the account its owner's default account in its currency, replacing the previous one
+func (r *AccountRepository) SetDefaultAccount(ctx context.Context, accountID int64) error {
+	ctx, span := tracer.Tracer().Start(ctx, "AccountRepository.SetDefaultAccount")
 	defer span.End()
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return errorTxNotStarted(err)
 	}
 	defer func() { err = deferTx(tx, err) }()
-	// Delete account
-	err = r.q.DeleteAccount(ctx, tx, accountID)
+	account, err := r.q.GetAccount(ctx, tx, accountID)
 	if err != nil {
 		if IsNotFoundError(err) {
 			return errorNotFound(err, "account not found")
+		} else {
+			return errorQuery(err, "failed to get account")
 		}
-		return errorQuery(err, "failed to delete account")
+	}
+	err = r.q.ClearDefaultAccount(ctx, tx, sqlc.ClearDefaultAccountParams{
+		UserID:     account.UserID,
+		CurrencyID: account.CurrencyID,
+	})
+	if err != nil {
+		return errorQuery(err, "failed to clear default account")
+	}
+	err = r.q.SetDefaultAccount(ctx, tx, accountID)
+	if err != nil {
+		return errorQuery(err, "failed to set default account")
+	}
+	return nil
+}
+
+// SetAccountStatus freezes or unfreezes an account, `byOps` records whether an operations user froze it
+func (r *AccountRepository) SetAccountStatus(ctx context.Context, accountID int64, status core.AccountStatus, byOps bool) error {
+	ctx, span := tracer.Tracer().Start(ctx, "AccountRepository.SetAccountStatus")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = r.q.SetAccountStatus(ctx, tx, sqlc.SetAccountStatusParams{
+		ID:          accountID,
+		Status:      string(status),
+		FrozenByOps: status == core.AccountStatusFrozen && byOps,
+	})
+	if err != nil {
+		return errorQuery(err, "failed to set account status")
 	}
 	return nil
 }
 
+// CloseAccount closes an account after sweeping its balance into `sweepToAccountID` in the same db transaction
+// The account & its transactions are kept, its empty pots are deleted, the caller must check that the accounts can be swept beforehand
+func (r *AccountRepository) CloseAccount(ctx context.Context, accountID int64, sweepToAccountID int64) (core.Transaction, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "AccountRepository.CloseAccount")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Transaction{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	account, err := r.q.GetAccount(ctx, tx, accountID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Transaction{}, errorNotFound(err, "account not found")
+		} else {
+			return core.Transaction{}, errorQuery(err, "failed to get account")
+		}
+	}
+	// Delete the account's pots, their money must be moved back to be swept
+	pots, err := r.q.GetAccountPots(ctx, tx, accountID)
+	if err != nil {
+		return core.Transaction{}, errorQuery(err, "failed to get account pots")
+	}
+	for _, pot := range pots {
+		if pot.Balance != 0 {
+			return core.Transaction{}, errorPotNotEmpty
+		}
+		if _, err = r.q.DeletePot(ctx, tx, pot.ID); err != nil {
+			return core.Transaction{}, errorQuery(err, "failed to delete pot")
+		}
+	}
+	// Sweep the remaining balance, closed accounts hold no money
+	var sweep core.Transaction
+	if account.Balance != 0 {
+		if sweepToAccountID == 0 || account.Balance < 0 {
+			return core.Transaction{}, errorAccountNotEmpty
+		}
+		sweep, err = transfer(ctx, r.q, tx, core.CreateTransactionParams{
+			Amount:        account.Balance,
+			FromAccountID: accountID,
+			ToAccountID:   sweepToAccountID,
+			Reason:        "account closed",
+		})
+		if err != nil {
+			return core.Transaction{}, err
+		}
+	}
+	err = r.q.CloseAccount(ctx, tx, accountID)
+	if err != nil {
+		return core.Transaction{}, errorQuery(err, "failed to close account")
+	}
+	return sweep, nil
+}
+
 // fromDBAccountToAccount converts sqlc.Account to core.Account
 func fromDBAccountToAccount(account sqlc.GetAccountRow) core.Account {
 	return core.Account{
-		ID:       account.ID,
-		OwnerID:  account.UserID,
-		Name:     account.Name,
-		Currency: core.Currency(account.CurrencyName),
-		Balance:  account.Balance,
+		ID:               account.ID,
+		OwnerID:          account.UserID,
+		Name:             account.Name,
+		Currency:         core.Currency(account.CurrencyName),
+		MinorUnits:       int(account.MinorUnits),
+		Balance:          account.Balance,
+		AvailableBalance: account.AvailableBalance,
+		IsDefault:        account.IsDefault,
+		Status:           core.AccountStatus(account.Status),
+		FrozenByOps:      account.FrozenByOps,
+		ClosedAt:         account.ClosedAt.Time,
 	}
 }
 
 func fromDBAccountsToAccount(account sqlc.GetAccountsRow) core.Account {
 	return core.Account{
-		ID:       account.ID,
-		OwnerID:  account.UserID,
-		Name:     account.Name,
-		Currency: core.Currency(account.CurrencyName),
-		Balance:  account.Balance,
+		ID:               account.ID,
+		OwnerID:          account.UserID,
+		Name:             account.Name,
+		Currency:         core.Currency(account.CurrencyName),
+		MinorUnits:       int(account.MinorUnits),
+		Balance:          account.Balance,
+		AvailableBalance: account.AvailableBalance,
+		IsDefault:        account.IsDefault,
+		Status:           core.AccountStatus(account.Status),
+		FrozenByOps:      account.FrozenByOps,
+		ClosedAt:         account.ClosedAt.Time,
 	}
 }
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"reflect"
+	"sort"
 	"testing"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v6"
 	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
@@ -126,32 +128,105 @@ func TestAccountRepository_GetAccounts(t *testing.T) {
 	}
 }
 
-func TestAccountRepository_DeleteAccount(t *testing.T) {
-	type fields struct {
-		db *sql.DB
-	}
-	type args struct {
-		ctx       context.Context
-		accountID int64
-	}
-	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		wantErr bool
-	}{
-		// TODO: Add test cases.
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			r := &AccountRepository{
-				db: tt.fields.db,
-			}
-			if err := r.DeleteAccount(tt.args.ctx, tt.args.accountID); (err != nil) != tt.wantErr {
-				t.Errorf("AccountRepository.DeleteAccount() error = %v, wantErr %v", err, tt.wantErr)
-			}
-		})
+func TestAccountRepository_SetAccountStatus(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+	ar := NewAccountRepository(conn)
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.Name(), Currency: core.CurrencyUSD}))
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Equal(t, core.AccountStatusActive, accounts[0].Status)
+
+	// Freeze by an operations user
+	require.NoError(t, ar.SetAccountStatus(ctx, accounts[0].ID, core.AccountStatusFrozen, true))
+	account, err := ar.GetAccount(ctx, accounts[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, core.AccountStatusFrozen, account.Status)
+	require.True(t, account.FrozenByOps)
+	require.False(t, account.CanSend())
+	require.True(t, account.CanReceive())
+
+	// Unfreezing clears the flag
+	require.NoError(t, ar.SetAccountStatus(ctx, accounts[0].ID, core.AccountStatusActive, true))
+	account, err = ar.GetAccount(ctx, accounts[0].ID)
+	require.NoError(t, err)
+	require.Equal(t, core.AccountStatusActive, account.Status)
+	require.False(t, account.FrozenByOps)
+}
+
+func TestAccountRepository_CloseAccount(t *testing.T) {
+	ctx := context.Background()
+	externalID := uuid.New()
+	ur := NewUserRepository(conn)
+	require.NoError(t, ur.CreateUser(ctx, externalID))
+	userID, err := ur.GetUser(ctx, externalID)
+	require.NoError(t, err)
+	ar := NewAccountRepository(conn)
+	for i := 0; i < 2; i++ {
+		require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.Name(), Currency: core.CurrencyUSD}))
 	}
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].ID < accounts[j].ID })
+	from, to := accounts[0].ID, accounts[1].ID
+	require.NoError(t, ar.SetDefaultAccount(ctx, from))
+	deposit, err := NewTransactionRepository(conn).Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from})
+	require.NoError(t, err)
+
+	// A non-empty account isn't closed without a sweep
+	_, err = ar.CloseAccount(ctx, from, 0)
+	require.Error(t, err)
+
+	// The balance is swept into the other account
+	sweep, err := ar.CloseAccount(ctx, from, to)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), sweep.Amount)
+	require.Equal(t, from, sweep.FromAccountID)
+	require.Equal(t, to, sweep.ToAccountID)
+
+	// The closed account & its transactions are kept
+	account, err := ar.GetAccount(ctx, from)
+	require.NoError(t, err)
+	require.Equal(t, core.AccountStatusClosed, account.Status)
+	require.Zero(t, account.Balance)
+	require.False(t, account.IsDefault)
+	require.False(t, account.ClosedAt.IsZero())
+	_, err = NewTransactionRepository(conn).GetTransaction(ctx, deposit.ID)
+	require.NoError(t, err)
+	account, err = ar.GetAccount(ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), account.Balance)
+
+	// Closed accounts receive no payments
+	got, err := ar.GetReceivingAccount(ctx, externalID, core.CurrencyUSD)
+	require.NoError(t, err)
+	require.Equal(t, to, got.ID)
+}
+
+func TestAccountRepository_GetAccountBalanceAt(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+	ar := NewAccountRepository(conn)
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.Name(), Currency: core.CurrencyUSD}))
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	tr := NewTransactionRepository(conn)
+	first, err := tr.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: accounts[0].ID})
+	require.NoError(t, err)
+	second, err := tr.Withdraw(ctx, core.CreateTransactionParams{Amount: 300, FromAccountID: accounts[0].ID})
+	require.NoError(t, err)
+
+	// The balance before a transaction excludes it & the later ones
+	balance, err := ar.GetAccountBalanceAt(ctx, accounts[0].ID, first.CreatedAt)
+	require.NoError(t, err)
+	require.Zero(t, balance)
+	balance, err = ar.GetAccountBalanceAt(ctx, accounts[0].ID, second.CreatedAt)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), balance)
+	balance, err = ar.GetAccountBalanceAt(ctx, accounts[0].ID, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, int64(700), balance)
 }
 
 func Test_fromDBAccountToAccount(t *testing.T) {
@@ -194,6 +269,51 @@ func Test_fromDBAccountsToAccount(t *testing.T) {
 	}
 }
 
+func TestAccountRepository_GetReceivingAccount(t *testing.T) {
+	ctx := context.Background()
+	externalID := uuid.New()
+	ur := NewUserRepository(conn)
+	require.NoError(t, ur.CreateUser(ctx, externalID))
+	userID, err := ur.GetUser(ctx, externalID)
+	require.NoError(t, err)
+
+	ar := NewAccountRepository(conn)
+	// No account in the currency yet
+	_, err = ar.GetReceivingAccount(ctx, externalID, core.CurrencyUSD)
+	require.Error(t, err)
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.Name(), Currency: core.CurrencyUSD}))
+	}
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.Name(), Currency: core.CurrencyEUR}))
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].ID < accounts[j].ID })
+
+	// The oldest account of the currency is used by default
+	got, err := ar.GetReceivingAccount(ctx, externalID, core.CurrencyUSD)
+	require.NoError(t, err)
+	require.Equal(t, accounts[0].ID, got.ID)
+
+	// Until another one is designated
+	require.NoError(t, ar.SetDefaultAccount(ctx, accounts[1].ID))
+	got, err = ar.GetReceivingAccount(ctx, externalID, core.CurrencyUSD)
+	require.NoError(t, err)
+	require.Equal(t, accounts[1].ID, got.ID)
+	require.True(t, got.IsDefault)
+
+	// Designating another account replaces the previous default
+	require.NoError(t, ar.SetDefaultAccount(ctx, accounts[0].ID))
+	got, err = ar.GetReceivingAccount(ctx, externalID, core.CurrencyUSD)
+	require.NoError(t, err)
+	require.Equal(t, accounts[0].ID, got.ID)
+
+	got, err = ar.GetReceivingAccount(ctx, externalID, core.CurrencyEUR)
+	require.NoError(t, err)
+	require.Equal(t, accounts[2].ID, got.ID)
+}
+
 func generateRandomUser(t *testing.T) int64 {
 	// Create user
 	externalID := gofakeit.UUID()
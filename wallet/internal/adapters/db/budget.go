@@ -0,0 +1,132 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type BudgetRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewBudgetRepository(db *sql.DB) *BudgetRepository {
+	return &BudgetRepository{db: db, q: sqlc.New()}
+}
+
+// SetBudget creates or replaces the account's budget of the category, changing the amount resets the month's alerts
+func (r *BudgetRepository) SetBudget(ctx context.Context, params core.SetBudgetParams) (core.Budget, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "BudgetRepository.SetBudget")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Budget{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	budget, err := r.q.SetBudget(ctx, tx, sqlc.SetBudgetParams{
+		AccountID: params.AccountID,
+		Category:  params.Category.String(),
+		Amount:    params.Amount,
+	})
+	if err != nil {
+		return core.Budget{}, errorQuery(err, "failed to set budget")
+	}
+	return fromDBBudgetToBudget(budget), nil
+}
+
+// DeleteBudget deletes the account's budget of the category
+func (r *BudgetRepository) DeleteBudget(ctx context.Context, accountID int64, category core.Category) error {
+	ctx, span := tracer.Tracer().Start(ctx, "BudgetRepository.DeleteBudget")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	deleted, err := r.q.DeleteBudget(ctx, tx, sqlc.DeleteBudgetParams{AccountID: accountID, Category: category.String()})
+	if err != nil {
+		return errorQuery(err, "failed to delete budget")
+	}
+	if deleted == 0 {
+		return errorBudgetNotFound
+	}
+	return nil
+}
+
+// GetAccountBudgets returns the budgets of an account, the whole spending's budget first
+func (r *BudgetRepository) GetAccountBudgets(ctx context.Context, accountID int64) ([]core.Budget, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "BudgetRepository.GetAccountBudgets")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	budgets, err := r.q.GetAccountBudgets(ctx, tx, accountID)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get account budgets")
+	}
+	return fromDBBudgets(budgets), nil
+}
+
+// GetBudgets returns the budgets of the next `limit` accounts with budgets after `afterAccountID` that aren't closed,
+// grouped by account in the accounts order, an account's budgets are never split between pages
+func (r *BudgetRepository) GetBudgets(ctx context.Context, afterAccountID int64, limit int32) ([]core.Budget, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "BudgetRepository.GetBudgets")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	budgets, err := r.q.GetBudgets(ctx, tx, sqlc.GetBudgetsParams{AfterAccountID: afterAccountID, AccountsLimit: limit})
+	if err != nil {
+		return nil, errorQuery(err, "failed to get budgets")
+	}
+	return fromDBBudgets(budgets), nil
+}
+
+// SetBudgetAlerted records the highest threshold of the budget the owner was alerted about within the month
+func (r *BudgetRepository) SetBudgetAlerted(ctx context.Context, accountID int64, category core.Category, month time.Time, threshold int) error {
+	ctx, span := tracer.Tracer().Start(ctx, "BudgetRepository.SetBudgetAlerted")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = r.q.SetBudgetAlerted(ctx, tx, sqlc.SetBudgetAlertedParams{
+		AlertedMonth:     toNullTime(month),
+		AlertedThreshold: int32(threshold),
+		AccountID:        accountID,
+		Category:         category.String(),
+	})
+	if err != nil {
+		return errorQuery(err, "failed to set budget alerted")
+	}
+	return nil
+}
+
+func fromDBBudgets(budgets []sqlc.Budget) []core.Budget {
+	res := make([]core.Budget, len(budgets))
+	for i, b := range budgets {
+		res[i] = fromDBBudgetToBudget(b)
+	}
+	return res
+}
+
+func fromDBBudgetToBudget(b sqlc.Budget) core.Budget {
+	return core.Budget{
+		AccountID:        b.AccountID,
+		Category:         core.Category(b.Category),
+		Amount:           b.Amount,
+		AlertedMonth:     b.AlertedMonth.Time,
+		AlertedThreshold: int(b.AlertedThreshold),
+		UpdatedAt:        b.UpdatedAt,
+	}
+}
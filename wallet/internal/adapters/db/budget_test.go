@@ -0,0 +1,66 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetRepository(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+	ar := NewAccountRepository(conn)
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: "wallet", Currency: core.CurrencyUSD}))
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	accountID := accounts[0].ID
+
+	// Set a budget for the whole spending & one for a category, setting a budget again replaces it
+	br := NewBudgetRepository(conn)
+	_, err = br.SetBudget(ctx, core.SetBudgetParams{AccountID: accountID, Category: core.CategoryDining, Amount: 100})
+	require.NoError(t, err)
+	budget, err := br.SetBudget(ctx, core.SetBudgetParams{AccountID: accountID, Category: core.CategoryDining, Amount: 200})
+	require.NoError(t, err)
+	require.Equal(t, int64(200), budget.Amount)
+	_, err = br.SetBudget(ctx, core.SetBudgetParams{AccountID: accountID, Amount: 1000})
+	require.NoError(t, err)
+	budgets, err := br.GetAccountBudgets(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, budgets, 2)
+	require.Empty(t, budgets[0].Category)
+	require.Equal(t, core.CategoryDining, budgets[1].Category)
+	require.True(t, budgets[1].AlertedMonth.IsZero())
+
+	// Alerts are recorded per month, changing the amount resets them
+	month := core.StartOfMonth(time.Now().UTC())
+	require.NoError(t, br.SetBudgetAlerted(ctx, accountID, core.CategoryDining, month, 80))
+	budget, err = br.SetBudget(ctx, core.SetBudgetParams{AccountID: accountID, Category: core.CategoryDining, Amount: 200})
+	require.NoError(t, err)
+	require.True(t, budget.AlertedMonth.Equal(month))
+	require.Equal(t, 80, budget.AlertedThreshold)
+	budget, err = br.SetBudget(ctx, core.SetBudgetParams{AccountID: accountID, Category: core.CategoryDining, Amount: 300})
+	require.NoError(t, err)
+	require.Zero(t, budget.AlertedThreshold)
+
+	// The budgets of the open accounts are listed by pages of accounts, an account's budgets stay on the same page
+	budgets, err = br.GetBudgets(ctx, accountID-1, 1)
+	require.NoError(t, err)
+	require.Len(t, budgets, 2)
+	require.Equal(t, accountID, budgets[0].AccountID)
+	require.Equal(t, accountID, budgets[1].AccountID)
+	budgets, err = br.GetBudgets(ctx, accountID, 100)
+	require.NoError(t, err)
+	for _, b := range budgets {
+		require.Greater(t, b.AccountID, accountID)
+	}
+
+	// Deleting a budget twice fails
+	require.NoError(t, br.DeleteBudget(ctx, accountID, core.CategoryDining))
+	require.Error(t, br.DeleteBudget(ctx, accountID, core.CategoryDining))
+	budgets, err = br.GetAccountBudgets(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, budgets, 1)
+}
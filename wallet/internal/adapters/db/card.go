@@ -28,16 +28,103 @@ func (r *CardRepository) CreateCard(ctx context.Context, params core.CreateCardP
 	}
 	defer func() { err = deferTx(tx, err) }()
 	// Create card
-	err = r.q.CreateCard(ctx, tx, sqlc.CreateCardParams{
-		AccountID: params.AccountID,
-		Number:    params.Number,
+	return createCard(ctx, r.q, tx, params)
+}
+
+// ReplaceCard issues a new card on the replaced card's account & retires the replaced card
+// The new card takes over the replaced card's limits & its active standing orders
+func (r *CardRepository) ReplaceCard(ctx context.Context, cardNumber string, params core.CreateCardParams) error {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.ReplaceCard")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = createCard(ctx, r.q, tx, params)
+	if err != nil {
+		return err
+	}
+	// Retire the replaced card, lost cards stay lost & the others are blocked
+	err = r.q.RetireCard(ctx, tx, sqlc.RetireCardParams{Number: cardNumber, ReplacedBy: toNullString(params.Number)})
+	if err != nil {
+		return errorQuery(err, "failed to retire card")
+	}
+	err = r.q.CopyCardLimits(ctx, tx, sqlc.CopyCardLimitsParams{ToCard: params.Number, FromCard: toNullString(cardNumber)})
+	if err != nil {
+		return errorQuery(err, "failed to copy card limits")
+	}
+	err = r.q.ReplaceStandingOrdersCard(ctx, tx, sqlc.ReplaceStandingOrdersCardParams{OldCard: cardNumber, NewCard: params.Number})
+	if err != nil {
+		return errorQuery(err, "failed to move standing orders to the new card")
+	}
+	return nil
+}
+
+// SetCardStatus sets the status of a card, expired cards are not stored as such
+func (r *CardRepository) SetCardStatus(ctx context.Context, cardNumber string, status core.CardStatus) error {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.SetCardStatus")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = r.q.SetCardStatus(ctx, tx, sqlc.SetCardStatusParams{Number: cardNumber, Status: string(status)})
+	if err != nil {
+		return errorQuery(err, "failed to set card status")
+	}
+	return nil
+}
+
+// SetCardNickname sets the nickname of a card, an empty nickname removes it
+func (r *CardRepository) SetCardNickname(ctx context.Context, cardNumber string, nickname string) error {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.SetCardNickname")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = r.q.SetCardNickname(ctx, tx, sqlc.SetCardNicknameParams{Number: cardNumber, Nickname: toNullString(nickname)})
+	if err != nil {
+		return errorQuery(err, "failed to set card nickname")
+	}
+	return nil
+}
+
+// SetCardPin sets the hash of a card's PIN & clears its failed attempts & lockout
+func (r *CardRepository) SetCardPin(ctx context.Context, cardNumber string, pinHash string) error {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.SetCardPin")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = r.q.SetCardPin(ctx, tx, sqlc.SetCardPinParams{Number: cardNumber, PinHash: toNullString(pinHash)})
+	if err != nil {
+		return errorQuery(err, "failed to set card pin")
+	}
+	return nil
+}
+
+// SetCardPinAttempts sets the failed PIN attempts of a card & the end of its PIN lockout
+func (r *CardRepository) SetCardPinAttempts(ctx context.Context, cardNumber string, attempts core.CardPINAttempts) error {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.SetCardPinAttempts")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = r.q.SetCardPinAttempts(ctx, tx, sqlc.SetCardPinAttemptsParams{
+		Number:            cardNumber,
+		PinFailedAttempts: int32(attempts.FailedAttempts),
+		PinLockedUntil:    toNullTime(attempts.LockedUntil),
 	})
 	if err != nil {
-		if IsUniqueViolationError(err) {
-			return errorUniqueViolation(err, "card with this number already exists")
-		} else {
-			return errorQuery(err, "failed to create card")
-		}
+		return errorQuery(err, "failed to set card pin attempts")
 	}
 	return nil
 }
@@ -80,15 +167,35 @@ func (r *CardRepository) GetCardAccount(ctx context.Context, cardNumber string)
 		}
 	}
 	account := core.Account{
-		ID:       result.ID,
-		OwnerID:  result.OwnerID,
-		Name:     result.Name,
-		Balance:  result.Balance,
-		Currency: core.Currency(result.Currency),
+		ID:               result.ID,
+		OwnerID:          result.OwnerID,
+		Name:             result.Name,
+		Balance:          result.Balance,
+		AvailableBalance: result.AvailableBalance,
+		Currency:         core.Currency(result.Currency),
+		MinorUnits:       int(result.MinorUnits),
+		Status:           core.AccountStatus(result.Status),
+		FrozenByOps:      result.FrozenByOps,
 	}
 	return account, nil
 }
 
+// GetCardDebited returns the total debited with a card, including its authorized holds
+func (r *CardRepository) GetCardDebited(ctx context.Context, cardNumber string) (int64, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.GetCardDebited")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	debited, err := r.q.GetCardDebited(ctx, tx, cardNumber)
+	if err != nil {
+		return 0, errorQuery(err, "failed to get card debited amount")
+	}
+	return debited, nil
+}
+
 // GetCards returns all cards for a given account
 func (r *CardRepository) GetCards(ctx context.Context, accountID int64) ([]core.Card, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.GetCards")
@@ -115,6 +222,91 @@ func (r *CardRepository) GetCards(ctx context.Context, accountID int64) ([]core.
 	return res, nil
 }
 
+// GetUnvaultedCards returns the numbers of the cards created before their numbers were encrypted
+func (r *CardRepository) GetUnvaultedCards(ctx context.Context) ([]string, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.GetUnvaultedCards")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	numbers, err := r.q.GetUnvaultedCards(ctx, tx)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get unvaulted cards")
+	}
+	return numbers, nil
+}
+
+// GetVaultedCards returns the tokens & encrypted numbers of the vaulted cards
+func (r *CardRepository) GetVaultedCards(ctx context.Context) ([]core.Card, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.GetVaultedCards")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	rows, err := r.q.GetVaultedCards(ctx, tx)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get vaulted cards")
+	}
+	cards := make([]core.Card, len(rows))
+	for i, row := range rows {
+		cards[i] = core.Card{
+			Number:          row.Number,
+			EncryptedNumber: core.EncryptedCardNumber{Ciphertext: row.NumberCiphertext, DataKey: row.NumberDataKey},
+		}
+	}
+	return cards, nil
+}
+
+// VaultCard replaces a card's number with its token & stores the number encrypted
+// The card's limits, reveals & replacements follow the token by cascade, its transactions & standing orders are renamed
+func (r *CardRepository) VaultCard(ctx context.Context, cardNumber string, token string, encrypted core.EncryptedCardNumber) error {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.VaultCard")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = r.q.VaultCard(ctx, tx, sqlc.VaultCardParams{
+		Token:            token,
+		NumberCiphertext: encrypted.Ciphertext,
+		NumberDataKey:    encrypted.DataKey,
+		Number:           cardNumber,
+	})
+	if err != nil {
+		return errorQuery(err, "failed to vault card")
+	}
+	err = r.q.RenameTransactionsCard(ctx, tx, sqlc.RenameTransactionsCardParams{NewCard: token, OldCard: toNullString(cardNumber)})
+	if err != nil {
+		return errorQuery(err, "failed to rename transactions card")
+	}
+	err = r.q.RenameStandingOrdersCard(ctx, tx, sqlc.RenameStandingOrdersCardParams{OldCard: cardNumber, NewCard: token})
+	if err != nil {
+		return errorQuery(err, "failed to rename standing orders card")
+	}
+	return nil
+}
+
+// CreateCardReveal records that a user revealed a card's number
+func (r *CardRepository) CreateCardReveal(ctx context.Context, cardNumber string, userID int64) error {
+	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.CreateCardReveal")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	err = r.q.CreateCardReveal(ctx, tx, sqlc.CreateCardRevealParams{CardNumber: cardNumber, UserID: userID})
+	if err != nil {
+		return errorQuery(err, "failed to record card reveal")
+	}
+	return nil
+}
+
 // DeleteCard deletes a card for a given number
 func (r *CardRepository) DeleteCard(ctx context.Context, cardNumber string) error {
 	ctx, span := tracer.Tracer().Start(ctx, "CardRepository.DeleteCard")
@@ -136,10 +328,52 @@ func (r *CardRepository) DeleteCard(ctx context.Context, cardNumber string) erro
 	return nil
 }
 
+func createCard(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, params core.CreateCardParams) error {
+	err := q.CreateCard(ctx, tx, sqlc.CreateCardParams{
+		AccountID:        params.AccountID,
+		Number:           params.Number,
+		Nickname:         toNullString(params.Nickname),
+		CvvHash:          toNullString(params.CVVHash),
+		ExpiresAt:        params.ExpiresAt,
+		NumberCiphertext: params.EncryptedNumber.Ciphertext,
+		NumberDataKey:    params.EncryptedNumber.DataKey,
+		Kind:             string(params.Kind),
+		SpendingCap:      toNullBound(params.SpendingCap),
+		LockedToAccountID: sql.NullInt64{
+			Int64: params.LockedToAccountID,
+			Valid: params.LockedToAccountID != 0,
+		},
+	})
+	if err != nil {
+		if IsUniqueViolationError(err) {
+			return errorUniqueViolation(err, "card with this number already exists")
+		} else {
+			return errorQuery(err, "failed to create card")
+		}
+	}
+	return nil
+}
+
 // fromDBCardToCard converts a db.Card to a core.Card
 func fromDBCardToCard(card sqlc.Card) core.Card {
 	return core.Card{
 		AccountID: card.AccountID,
 		Number:    card.Number,
+		EncryptedNumber: core.EncryptedCardNumber{
+			Ciphertext: card.NumberCiphertext,
+			DataKey:    card.NumberDataKey,
+		},
+		Nickname:          card.Nickname.String,
+		Status:            core.CardStatus(card.Status),
+		CVVHash:           card.CvvHash.String,
+		ExpiresAt:         card.ExpiresAt,
+		ReplacedBy:        card.ReplacedBy.String,
+		CreatedAt:         card.CreatedAt,
+		PINHash:           card.PinHash.String,
+		PINFailedAttempts: int(card.PinFailedAttempts),
+		PINLockedUntil:    card.PinLockedUntil.Time,
+		Kind:              core.CardKind(card.Kind),
+		SpendingCap:       card.SpendingCap.Int64,
+		LockedToAccountID: card.LockedToAccountID.Int64,
 	}
 }
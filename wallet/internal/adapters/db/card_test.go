@@ -5,9 +5,12 @@ import (
 	"database/sql"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/brianvoe/gofakeit/v6"
 	db "github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
 	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCardRepository(t *testing.T) {
@@ -188,6 +191,161 @@ func TestCardRepository_DeleteCard(t *testing.T) {
 	}
 }
 
+func TestCardRepository_ReplaceCard(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	// Create an account with a lost card & a limit on it
+	ar := NewAccountRepository(conn)
+	err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+	require.NoError(t, err)
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	accountID := accounts[0].ID
+	r := NewCardRepository(conn)
+	oldNumber := gofakeit.CreditCardNumber(nil)
+	expiresAt := core.CardExpiry(time.Now(), 4)
+	err = r.CreateCard(ctx, core.CreateCardParams{AccountID: accountID, Number: oldNumber, Nickname: "groceries", ExpiresAt: expiresAt})
+	require.NoError(t, err)
+	err = r.SetCardStatus(ctx, oldNumber, core.CardStatusLost)
+	require.NoError(t, err)
+	lmr := NewLimitRepository(conn)
+	err = lmr.SetLimit(ctx, core.SpendingLimit{CardNumber: oldNumber, Type: core.TransactionTypeWithdrawal, Daily: 100})
+	require.NoError(t, err)
+
+	// Replace the card, the new card takes over the limit & the old one stays lost
+	newNumber := gofakeit.CreditCardNumber(nil)
+	err = r.ReplaceCard(ctx, oldNumber, core.CreateCardParams{AccountID: accountID, Number: newNumber, Nickname: "groceries", ExpiresAt: expiresAt})
+	require.NoError(t, err)
+	oldCard, err := r.GetCard(ctx, oldNumber)
+	require.NoError(t, err)
+	require.Equal(t, core.CardStatusLost, oldCard.Status)
+	require.Equal(t, newNumber, oldCard.ReplacedBy)
+	newCard, err := r.GetCard(ctx, newNumber)
+	require.NoError(t, err)
+	require.Equal(t, core.CardStatusActive, newCard.Status)
+	require.Equal(t, "groceries", newCard.Nickname)
+	limits, err := lmr.GetCardLimits(ctx, newNumber)
+	require.NoError(t, err)
+	require.Len(t, limits, 1)
+	require.Equal(t, int64(100), limits[0].Daily)
+}
+
+func TestCardRepository_VaultCard(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	// Create an account with a card stored before the vault & a limit on it
+	ar := NewAccountRepository(conn)
+	err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+	require.NoError(t, err)
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	r := NewCardRepository(conn)
+	number := gofakeit.CreditCardNumber(nil)
+	err = r.CreateCard(ctx, core.CreateCardParams{AccountID: accounts[0].ID, Number: number, ExpiresAt: core.CardExpiry(time.Now(), 4)})
+	require.NoError(t, err)
+	lmr := NewLimitRepository(conn)
+	err = lmr.SetLimit(ctx, core.SpendingLimit{CardNumber: number, Type: core.TransactionTypeWithdrawal, Daily: 100})
+	require.NoError(t, err)
+	numbers, err := r.GetUnvaultedCards(ctx)
+	require.NoError(t, err)
+	require.Contains(t, numbers, number)
+
+	// Vault the card, its limit follows the token
+	token := gofakeit.LetterN(60) + number[len(number)-4:]
+	encrypted := core.EncryptedCardNumber{Ciphertext: []byte("ciphertext"), DataKey: []byte("data key")}
+	err = r.VaultCard(ctx, number, token, encrypted)
+	require.NoError(t, err)
+	card, err := r.GetCard(ctx, token)
+	require.NoError(t, err)
+	require.Equal(t, encrypted, card.EncryptedNumber)
+	_, err = r.GetCard(ctx, number)
+	require.Error(t, err)
+	limits, err := lmr.GetCardLimits(ctx, token)
+	require.NoError(t, err)
+	require.Len(t, limits, 1)
+	numbers, err = r.GetUnvaultedCards(ctx)
+	require.NoError(t, err)
+	require.NotContains(t, numbers, token)
+
+	// Reveals are recorded against the token
+	err = r.CreateCardReveal(ctx, token, userID)
+	require.NoError(t, err)
+}
+
+func TestCardRepository_SetCardPin(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	ar := NewAccountRepository(conn)
+	err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+	require.NoError(t, err)
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	r := NewCardRepository(conn)
+	number := gofakeit.CreditCardNumber(nil)
+	err = r.CreateCard(ctx, core.CreateCardParams{AccountID: accounts[0].ID, Number: number, ExpiresAt: core.CardExpiry(time.Now(), 4)})
+	require.NoError(t, err)
+
+	// Lock the PIN, setting a new PIN clears the lockout
+	err = r.SetCardPin(ctx, number, "hash")
+	require.NoError(t, err)
+	lockedUntil := time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond)
+	err = r.SetCardPinAttempts(ctx, number, core.CardPINAttempts{FailedAttempts: 0, LockedUntil: lockedUntil})
+	require.NoError(t, err)
+	card, err := r.GetCard(ctx, number)
+	require.NoError(t, err)
+	require.Equal(t, "hash", card.PINHash)
+	require.True(t, lockedUntil.Equal(card.PINLockedUntil))
+	err = r.SetCardPin(ctx, number, "new hash")
+	require.NoError(t, err)
+	card, err = r.GetCard(ctx, number)
+	require.NoError(t, err)
+	require.Equal(t, "new hash", card.PINHash)
+	require.Zero(t, card.PINFailedAttempts)
+	require.True(t, card.PINLockedUntil.IsZero())
+}
+
+func TestCardRepository_SingleUseCard(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	ar := NewAccountRepository(conn)
+	err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+	require.NoError(t, err)
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	r := NewCardRepository(conn)
+	number := gofakeit.CreditCardNumber(nil)
+	err = r.CreateCard(ctx, core.CreateCardParams{
+		AccountID:   accounts[0].ID,
+		Number:      number,
+		ExpiresAt:   core.CardExpiry(time.Now(), 4),
+		Kind:        core.CardKindSingleUse,
+		SpendingCap: 500,
+	})
+	require.NoError(t, err)
+
+	// Deposits don't block the card, the first debit does
+	tr := NewTransactionRepository(conn)
+	_, err = tr.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: accounts[0].ID, Card: number})
+	require.NoError(t, err)
+	card, err := r.GetCard(ctx, number)
+	require.NoError(t, err)
+	require.Equal(t, core.CardKindSingleUse, card.Kind)
+	require.Equal(t, int64(500), card.SpendingCap)
+	require.Equal(t, core.CardStatusActive, card.Status)
+	_, err = tr.Withdraw(ctx, core.CreateTransactionParams{Amount: 300, FromAccountID: accounts[0].ID, Card: number})
+	require.NoError(t, err)
+	card, err = r.GetCard(ctx, number)
+	require.NoError(t, err)
+	require.Equal(t, core.CardStatusBlocked, card.Status)
+	debited, err := r.GetCardDebited(ctx, number)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), debited)
+}
+
 func Test_fromDBCardToCard(t *testing.T) {
 	type args struct {
 		card db.Card
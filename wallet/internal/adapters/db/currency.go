@@ -0,0 +1,69 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type CurrencyRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewCurrencyRepository(db *sql.DB) *CurrencyRepository {
+	return &CurrencyRepository{db: db, q: sqlc.New()}
+}
+
+// GetCurrency returns a registered currency by its code
+func (r *CurrencyRepository) GetCurrency(ctx context.Context, code core.Currency) (core.CurrencyInfo, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "CurrencyRepository.GetCurrency")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.CurrencyInfo{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	currency, err := r.q.GetCurrencyByCode(ctx, tx, code.String())
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.CurrencyInfo{}, errorNotFound(err, "currency not found")
+		} else {
+			return core.CurrencyInfo{}, errorQuery(err, "failed to get currency")
+		}
+	}
+	return fromDBCurrencyToCurrencyInfo(currency), nil
+}
+
+// GetCurrencies returns all registered currencies, enabled or not
+func (r *CurrencyRepository) GetCurrencies(ctx context.Context) ([]core.CurrencyInfo, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "CurrencyRepository.GetCurrencies")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	currencies, err := r.q.GetCurrencies(ctx, tx)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get currencies")
+	}
+	res := make([]core.CurrencyInfo, len(currencies))
+	for i, c := range currencies {
+		res[i] = fromDBCurrencyToCurrencyInfo(c)
+	}
+	return res, nil
+}
+
+// fromDBCurrencyToCurrencyInfo converts a sqlc.Currency to a core.CurrencyInfo
+func fromDBCurrencyToCurrencyInfo(c sqlc.Currency) core.CurrencyInfo {
+	return core.CurrencyInfo{
+		Code:       core.Currency(c.Code),
+		ISONumber:  int(c.IsoNumber),
+		MinorUnits: int(c.MinorUnits),
+		Enabled:    c.Enabled,
+	}
+}
@@ -0,0 +1,53 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCurrencyRepository_GetCurrency(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    core.Currency
+		want    core.CurrencyInfo
+		wantErr bool
+	}{
+		{
+			name: "enabled",
+			code: core.CurrencyUSD,
+			want: core.CurrencyInfo{Code: core.CurrencyUSD, ISONumber: 840, MinorUnits: 2, Enabled: true},
+		},
+		{
+			name: "disabled",
+			code: "JPY",
+			want: core.CurrencyInfo{Code: "JPY", ISONumber: 392, MinorUnits: 0, Enabled: false},
+		},
+		{
+			name:    "unknown",
+			code:    "XXX",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cr := NewCurrencyRepository(conn)
+			got, err := cr.GetCurrency(context.Background(), tt.code)
+			require.Truef(t, (err != nil) == tt.wantErr, "CurrencyRepository.GetCurrency() error = %v, wantErr %v", err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestCurrencyRepository_GetCurrencies(t *testing.T) {
+	cr := NewCurrencyRepository(conn)
+	currencies, err := cr.GetCurrencies(context.Background())
+	require.NoError(t, err)
+	codes := make([]core.Currency, len(currencies))
+	for i, c := range currencies {
+		codes[i] = c.Code
+	}
+	require.Subset(t, codes, []core.Currency{core.CurrencyUSD, core.CurrencyEUR, core.CurrencyGBP, core.CurrencyRUB, core.CurrencyEGP, "CHF", "JPY"})
+}
@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+)
+
+type QuoteRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewQuoteRepository(db *sql.DB) *QuoteRepository {
+	return &QuoteRepository{db: db, q: sqlc.New()}
+}
+
+// CreateQuote stores an exchange rate locked for a transfer
+func (r *QuoteRepository) CreateQuote(ctx context.Context, params core.CreateQuoteParams) (core.Quote, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "QuoteRepository.CreateQuote")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Quote{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	quote, err := r.q.CreateQuote(ctx, tx, sqlc.CreateQuoteParams{
+		UserID:               params.UserID,
+		SourceAccountID:      params.FromAccountID,
+		DestinationAccountID: params.ToAccountID,
+		Rate:                 params.Rate,
+		SourceAmount:         params.Amount,
+		DestinationAmount:    params.ToAmount,
+		ExpiresAt:            params.ExpiresAt,
+	})
+	if err != nil {
+		return core.Quote{}, errorQuery(err, "failed to create quote")
+	}
+	return fromDBQuoteToQuote(quote), nil
+}
+
+// GetQuote returns a quote by its ID
+func (r *QuoteRepository) GetQuote(ctx context.Context, quoteID uuid.UUID) (core.Quote, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "QuoteRepository.GetQuote")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Quote{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	quote, err := r.q.GetQuote(ctx, tx, quoteID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Quote{}, errorNotFound(err, "quote not found")
+		} else {
+			return core.Quote{}, errorQuery(err, "failed to get quote")
+		}
+	}
+	return fromDBQuoteToQuote(quote), nil
+}
+
+// fromDBQuoteToQuote converts a sqlc.ExchangeQuote to a core.Quote
+func fromDBQuoteToQuote(q sqlc.ExchangeQuote) core.Quote {
+	return core.Quote{
+		ID:            q.ID,
+		UserID:        q.UserID,
+		FromAccountID: q.SourceAccountID,
+		ToAccountID:   q.DestinationAccountID,
+		Rate:          q.Rate,
+		Amount:        q.SourceAmount,
+		ToAmount:      q.DestinationAmount,
+		ExpiresAt:     q.ExpiresAt,
+		UsedAt:        q.UsedAt.Time,
+		CreatedAt:     q.CreatedAt,
+	}
+}
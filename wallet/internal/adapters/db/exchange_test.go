@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuoteRepository_CrossCurrencyTransfer(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	// Create a USD & an EUR account
+	ar := NewAccountRepository(conn)
+	for _, c := range []core.Currency{core.CurrencyUSD, core.CurrencyEUR} {
+		err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: c})
+		require.NoError(t, err)
+	}
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	from, to := accounts[0], accounts[1]
+	if from.Currency != core.CurrencyUSD {
+		from, to = to, from
+	}
+
+	tr := NewTransactionRepository(conn)
+	_, err = tr.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from.ID})
+	require.NoError(t, err)
+
+	// Lock a rate
+	qr := NewQuoteRepository(conn)
+	quote, err := qr.CreateQuote(ctx, core.CreateQuoteParams{
+		UserID:        userID,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+		Rate:          "0.92",
+		Amount:        500,
+		ToAmount:      460,
+		ExpiresAt:     time.Now().UTC().Add(time.Minute),
+	})
+	require.NoError(t, err)
+	got, err := qr.GetQuote(ctx, quote.ID)
+	require.NoError(t, err)
+	require.False(t, got.IsUsed())
+	require.Equal(t, int64(460), got.ToAmount)
+
+	_, err = qr.GetQuote(ctx, uuid.New())
+	require.Error(t, err)
+
+	// Transfer with the quote
+	params := core.CreateTransactionParams{
+		Amount:        500,
+		ToAmount:      460,
+		ExchangeRate:  quote.Rate,
+		QuoteID:       quote.ID,
+		FromAccountID: from.ID,
+		ToAccountID:   to.ID,
+	}
+	transfer, err := tr.Transfer(ctx, params)
+	require.NoError(t, err)
+	require.Equal(t, int64(500), transfer.FromAccountBalance)
+	require.Equal(t, int64(460), transfer.ToAccountBalance)
+	amount, currency := transfer.AmountFor(to.ID)
+	require.Equal(t, int64(460), amount)
+	require.Equal(t, core.CurrencyEUR, currency)
+
+	// The quote can't be used twice
+	got, err = qr.GetQuote(ctx, quote.ID)
+	require.NoError(t, err)
+	require.True(t, got.IsUsed())
+	_, err = tr.Transfer(ctx, params)
+	require.Error(t, err)
+
+	// Reversing restores both balances & keeps the ledger balanced
+	_, err = tr.ReverseTransaction(ctx, core.ReverseTransactionParams{TransactionID: transfer.ID, Amount: transfer.Amount})
+	require.NoError(t, err)
+	accounts, err = ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	for _, a := range accounts {
+		if a.ID == from.ID {
+			require.Equal(t, int64(1000), a.Balance)
+		} else {
+			require.Equal(t, int64(0), a.Balance)
+		}
+	}
+	mismatches, err := NewLedgerRepository(conn).Reconcile(ctx)
+	require.NoError(t, err)
+	for _, m := range mismatches {
+		require.NotContains(t, []int64{from.ID, to.ID}, m.AccountID)
+	}
+}
@@ -0,0 +1,157 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+)
+
+type HoldRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewHoldRepository(db *sql.DB) *HoldRepository {
+	return &HoldRepository{db: db, q: sqlc.New()}
+}
+
+// CreateHold reserves an amount on an account, the caller must check the available balance beforehand
+func (r *HoldRepository) CreateHold(ctx context.Context, params core.CreateHoldParams) (core.Hold, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "HoldRepository.CreateHold")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Hold{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	hold, err := r.q.CreateHold(ctx, tx, sqlc.CreateHoldParams{
+		AccountID:          params.AccountID,
+		RecipientAccountID: params.RecipientAccountID,
+		Amount:             params.Amount,
+		ExpiresAt:          params.ExpiresAt,
+		CardNumber:         toNullString(params.Card),
+	})
+	if err != nil {
+		return core.Hold{}, errorQuery(err, "failed to create hold")
+	}
+	return fromDBHoldToHold(hold), nil
+}
+
+// GetHold returns a hold by its ID
+func (r *HoldRepository) GetHold(ctx context.Context, holdID uuid.UUID) (core.Hold, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "HoldRepository.GetHold")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Hold{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	hold, err := r.q.GetHold(ctx, tx, holdID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Hold{}, errorNotFound(err, "hold not found")
+		} else {
+			return core.Hold{}, errorQuery(err, "failed to get hold")
+		}
+	}
+	return fromDBHoldToHold(hold), nil
+}
+
+// CaptureHold transfers `amount` of an authorized hold to its recipient & releases the rest of the hold
+func (r *HoldRepository) CaptureHold(ctx context.Context, holdID uuid.UUID, amount int64) (core.Transaction, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "HoldRepository.CaptureHold")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Transaction{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	// Mark the hold as captured, fails if it was captured, voided or expired meanwhile
+	hold, err := r.q.CaptureHold(ctx, tx, sqlc.CaptureHoldParams{
+		ID:             holdID,
+		CapturedAmount: sql.NullInt64{Int64: amount, Valid: true},
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Transaction{}, errorHoldNotAuthorized
+		} else {
+			return core.Transaction{}, errorQuery(err, "failed to capture hold")
+		}
+	}
+	// Move the captured amount, with the card that authorized the hold
+	transaction, err := transfer(ctx, r.q, tx, core.CreateTransactionParams{
+		Amount:        amount,
+		FromAccountID: hold.AccountID,
+		ToAccountID:   hold.RecipientAccountID,
+		Card:          hold.CardNumber.String,
+	})
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	err = r.q.SetHoldTransaction(ctx, tx, sqlc.SetHoldTransactionParams{
+		ID:            holdID,
+		TransactionID: uuid.NullUUID{UUID: transaction.ID, Valid: true},
+	})
+	if err != nil {
+		return core.Transaction{}, errorQuery(err, "failed to set hold transaction")
+	}
+	return transaction, nil
+}
+
+// VoidHold releases an authorized hold without moving money
+func (r *HoldRepository) VoidHold(ctx context.Context, holdID uuid.UUID) error {
+	ctx, span := tracer.Tracer().Start(ctx, "HoldRepository.VoidHold")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	_, err = r.q.VoidHold(ctx, tx, holdID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return errorHoldNotAuthorized
+		} else {
+			return errorQuery(err, "failed to void hold")
+		}
+	}
+	return nil
+}
+
+// ExpireHolds marks the authorized holds past their expiry as expired and returns how many were expired
+// Expired holds stop reserving funds as soon as they expire, this only settles their status
+func (r *HoldRepository) ExpireHolds(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "HoldRepository.ExpireHolds")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	expired, err := r.q.ExpireHolds(ctx, tx)
+	if err != nil {
+		return 0, errorQuery(err, "failed to expire holds")
+	}
+	return expired, nil
+}
+
+// fromDBHoldToHold converts a sqlc.Hold to a core.Hold
+func fromDBHoldToHold(h sqlc.Hold) core.Hold {
+	return core.Hold{
+		ID:                 h.ID,
+		AccountID:          h.AccountID,
+		RecipientAccountID: h.RecipientAccountID,
+		Amount:             h.Amount,
+		CapturedAmount:     h.CapturedAmount.Int64,
+		Status:             core.HoldStatus(h.Status),
+		TransactionID:      h.TransactionID.UUID,
+		ExpiresAt:          h.ExpiresAt,
+		CreatedAt:          h.CreatedAt,
+		UpdatedAt:          h.UpdatedAt,
+		Card:               h.CardNumber.String,
+	}
+}
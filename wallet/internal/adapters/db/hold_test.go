@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldRepository(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	// Create two accounts & fund the first one
+	ar := NewAccountRepository(conn)
+	for i := 0; i < 2; i++ {
+		err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+		require.NoError(t, err)
+	}
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	from, to := accounts[0].ID, accounts[1].ID
+	_, err = NewTransactionRepository(conn).Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from})
+	require.NoError(t, err)
+
+	requireBalances := func(accountID, balance, available int64) {
+		account, err := ar.GetAccount(ctx, accountID)
+		require.NoError(t, err)
+		require.Equal(t, balance, account.Balance)
+		require.Equal(t, available, account.AvailableBalance)
+	}
+
+	// Authorize reduces the available balance only
+	hr := NewHoldRepository(conn)
+	hold, err := hr.CreateHold(ctx, core.CreateHoldParams{
+		AccountID:          from,
+		RecipientAccountID: to,
+		Amount:             600,
+		ExpiresAt:          time.Now().UTC().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, core.HoldStatusAuthorized, hold.Status)
+	requireBalances(from, 1000, 400)
+
+	// Partial capture moves the captured amount & releases the rest
+	transaction, err := hr.CaptureHold(ctx, hold.ID, 250)
+	require.NoError(t, err)
+	require.Equal(t, int64(250), transaction.Amount)
+	requireBalances(from, 750, 750)
+	requireBalances(to, 250, 250)
+	hold, err = hr.GetHold(ctx, hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, core.HoldStatusCaptured, hold.Status)
+	require.Equal(t, int64(250), hold.CapturedAmount)
+	require.Equal(t, transaction.ID, hold.TransactionID)
+	_, err = hr.CaptureHold(ctx, hold.ID, 250)
+	require.Error(t, err)
+
+	// Void releases the hold
+	hold, err = hr.CreateHold(ctx, core.CreateHoldParams{
+		AccountID:          from,
+		RecipientAccountID: to,
+		Amount:             100,
+		ExpiresAt:          time.Now().UTC().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	requireBalances(from, 750, 650)
+	require.NoError(t, hr.VoidHold(ctx, hold.ID))
+	requireBalances(from, 750, 750)
+	require.Error(t, hr.VoidHold(ctx, hold.ID))
+
+	// Expired holds don't reserve funds & can't be captured
+	hold, err = hr.CreateHold(ctx, core.CreateHoldParams{
+		AccountID:          from,
+		RecipientAccountID: to,
+		Amount:             100,
+		ExpiresAt:          time.Now().UTC().Add(-time.Minute),
+	})
+	require.NoError(t, err)
+	requireBalances(from, 750, 750)
+	_, err = hr.CaptureHold(ctx, hold.ID, 100)
+	require.Error(t, err)
+	expired, err := hr.ExpireHolds(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, expired, int64(1))
+	hold, err = hr.GetHold(ctx, hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, core.HoldStatusExpired, hold.Status)
+}
@@ -0,0 +1,130 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+)
+
+// System accounts balancing the postings of money entering or leaving the wallet, changing currency or set aside in pots
+const (
+	systemAccountATM  = "atm"
+	systemAccountFX   = "fx"
+	systemAccountPots = "pots"
+)
+
+type LedgerRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewLedgerRepository(db *sql.DB) *LedgerRepository {
+	return &LedgerRepository{db: db, q: sqlc.New()}
+}
+
+// Reconcile flags the accounts whose stored balance disagrees with the sum of their postings
+// and clears the flags of accounts that agree again, the currently flagged accounts are returned
+func (r *LedgerRepository) Reconcile(ctx context.Context) ([]core.LedgerMismatch, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "LedgerRepository.Reconcile")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	// Clear accounts that are balanced again
+	err = r.q.DeleteResolvedLedgerMismatches(ctx, tx)
+	if err != nil {
+		return nil, errorQuery(err, "failed to delete resolved ledger mismatches")
+	}
+	// Flag accounts that are not balanced
+	mismatches, err := r.q.FlagLedgerMismatches(ctx, tx)
+	if err != nil {
+		return nil, errorQuery(err, "failed to flag ledger mismatches")
+	}
+	res := make([]core.LedgerMismatch, len(mismatches))
+	for i, m := range mismatches {
+		res[i] = core.LedgerMismatch{
+			AccountID:     m.AccountID,
+			StoredBalance: m.StoredBalance,
+			LedgerBalance: m.LedgerBalance,
+			DetectedAt:    m.DetectedAt,
+		}
+	}
+	return res, nil
+}
+
+// posting is a single leg of a journal entry, it either targets a user account or a system account
+type posting struct {
+	accountID       int64
+	systemAccountID int64
+	amount          int64 // positive credits the account, negative debits it
+}
+
+// createJournalEntry records a journal entry for the given transaction with its postings
+// postings must sum up to zero, otherwise the db transaction fails to commit
+func createJournalEntry(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, transactionID uuid.UUID, description string, postings ...posting) error {
+	entryID, err := q.CreateJournalEntry(ctx, tx, sqlc.CreateJournalEntryParams{
+		TransactionID: uuid.NullUUID{UUID: transactionID, Valid: true},
+		Description:   description,
+	})
+	if err != nil {
+		return errorQuery(err, "failed to create journal entry")
+	}
+	for _, p := range postings {
+		err = q.CreatePosting(ctx, tx, sqlc.CreatePostingParams{
+			JournalEntryID:  entryID,
+			AccountID:       sql.NullInt64{Int64: p.accountID, Valid: p.accountID != 0},
+			SystemAccountID: sql.NullInt64{Int64: p.systemAccountID, Valid: p.systemAccountID != 0},
+			Amount:          p.amount,
+		})
+		if err != nil {
+			return errorQuery(err, "failed to create posting")
+		}
+	}
+	return nil
+}
+
+// transferPostings returns the postings moving `amount` out of `fromAccountID` & `toAmount` into `toAccountID`
+// when exchange is set the accounts have different currencies, the fx system accounts of both currencies balance the entry
+func transferPostings(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, fromAccountID, toAccountID, amount, toAmount int64, exchange bool) ([]posting, error) {
+	postings := []posting{
+		{accountID: fromAccountID, amount: -amount},
+		{accountID: toAccountID, amount: toAmount},
+	}
+	if !exchange {
+		return postings, nil
+	}
+	fromFxID, err := getSystemAccountID(ctx, q, tx, systemAccountFX, fromAccountID)
+	if err != nil {
+		return nil, err
+	}
+	toFxID, err := getSystemAccountID(ctx, q, tx, systemAccountFX, toAccountID)
+	if err != nil {
+		return nil, err
+	}
+	return append(postings,
+		posting{systemAccountID: fromFxID, amount: amount},
+		posting{systemAccountID: toFxID, amount: -toAmount},
+	), nil
+}
+
+// getSystemAccountID returns the id of the system account with the given name in the currency of the given account
+func getSystemAccountID(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, name string, accountID int64) (int64, error) {
+	id, err := q.GetAccountSystemAccount(ctx, tx, sqlc.GetAccountSystemAccountParams{
+		Name: name,
+		ID:   accountID,
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return 0, errorNotFound(err, "system account not found")
+		} else {
+			return 0, errorQuery(err, "failed to get system account")
+		}
+	}
+	return id, nil
+}
@@ -0,0 +1,72 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLedgerRepository_Reconcile(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	// Create two accounts & move money between them
+	ar := NewAccountRepository(conn)
+	for i := 0; i < 2; i++ {
+		err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+		require.NoError(t, err)
+	}
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	from, to := accounts[0].ID, accounts[1].ID
+
+	tr := NewTransactionRepository(conn)
+	_, err = tr.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from})
+	require.NoError(t, err)
+	transfer, err := tr.Transfer(ctx, core.CreateTransactionParams{Amount: 400, FromAccountID: from, ToAccountID: to})
+	require.NoError(t, err)
+	_, err = tr.Withdraw(ctx, core.CreateTransactionParams{Amount: 100, FromAccountID: to})
+	require.NoError(t, err)
+	_, err = tr.ReverseTransaction(ctx, core.ReverseTransactionParams{TransactionID: transfer.ID, Amount: transfer.Amount})
+	require.NoError(t, err)
+
+	flagged := func(mismatches []core.LedgerMismatch, accountID int64) (core.LedgerMismatch, bool) {
+		for _, m := range mismatches {
+			if m.AccountID == accountID {
+				return m, true
+			}
+		}
+		return core.LedgerMismatch{}, false
+	}
+
+	// Balances agree with the ledger
+	lr := NewLedgerRepository(conn)
+	mismatches, err := lr.Reconcile(ctx)
+	require.NoError(t, err)
+	_, ok := flagged(mismatches, from)
+	require.False(t, ok)
+	_, ok = flagged(mismatches, to)
+	require.False(t, ok)
+
+	// Tamper with the stored balance
+	_, err = conn.ExecContext(ctx, "UPDATE accounts SET balance = balance + 1 WHERE id = $1", from)
+	require.NoError(t, err)
+	mismatches, err = lr.Reconcile(ctx)
+	require.NoError(t, err)
+	m, ok := flagged(mismatches, from)
+	require.True(t, ok)
+	require.Equal(t, int64(1001), m.StoredBalance)
+	require.Equal(t, int64(1000), m.LedgerBalance)
+
+	// Restore the stored balance
+	_, err = conn.ExecContext(ctx, "UPDATE accounts SET balance = balance - 1 WHERE id = $1", from)
+	require.NoError(t, err)
+	mismatches, err = lr.Reconcile(ctx)
+	require.NoError(t, err)
+	_, ok = flagged(mismatches, from)
+	require.False(t, ok)
+}
@@ -0,0 +1,163 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type LimitRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewLimitRepository(db *sql.DB) *LimitRepository {
+	return &LimitRepository{db: db, q: sqlc.New()}
+}
+
+// GetAccountLimits returns the spending limits set on an account, one per transaction type at most
+func (r *LimitRepository) GetAccountLimits(ctx context.Context, accountID int64) ([]core.SpendingLimit, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "LimitRepository.GetAccountLimits")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	limits, err := r.q.GetAccountLimits(ctx, tx, sql.NullInt64{Int64: accountID, Valid: true})
+	if err != nil {
+		return nil, errorQuery(err, "failed to get account limits")
+	}
+	return fromDBSpendingLimits(limits), nil
+}
+
+// GetCardLimits returns the spending limits set on a card, one per transaction type at most
+func (r *LimitRepository) GetCardLimits(ctx context.Context, cardNumber string) ([]core.SpendingLimit, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "LimitRepository.GetCardLimits")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	limits, err := r.q.GetCardLimits(ctx, tx, toNullString(cardNumber))
+	if err != nil {
+		return nil, errorQuery(err, "failed to get card limits")
+	}
+	return fromDBSpendingLimits(limits), nil
+}
+
+// GetTransactionLimits returns the spending limits of a transaction type set on an account or on the card used
+func (r *LimitRepository) GetTransactionLimits(ctx context.Context, t core.TransactionType, accountID int64, cardNumber string) ([]core.SpendingLimit, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "LimitRepository.GetTransactionLimits")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	limits, err := r.q.GetTransactionLimits(ctx, tx, sqlc.GetTransactionLimitsParams{
+		TransactionType: sqlc.TransactionType(t),
+		AccountID:       sql.NullInt64{Int64: accountID, Valid: true},
+		CardNumber:      toNullString(cardNumber),
+	})
+	if err != nil {
+		return nil, errorQuery(err, "failed to get transaction limits")
+	}
+	return fromDBSpendingLimits(limits), nil
+}
+
+// SetLimit creates or replaces the limit of the account or card for the limit's transaction type
+func (r *LimitRepository) SetLimit(ctx context.Context, limit core.SpendingLimit) error {
+	ctx, span := tracer.Tracer().Start(ctx, "LimitRepository.SetLimit")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	if limit.CardNumber != "" {
+		err = r.q.SetCardLimit(ctx, tx, sqlc.SetCardLimitParams{
+			CardNumber:      toNullString(limit.CardNumber),
+			TransactionType: sqlc.TransactionType(limit.Type),
+			PerTransaction:  toNullBound(limit.PerTransaction),
+			Daily:           toNullBound(limit.Daily),
+			Monthly:         toNullBound(limit.Monthly),
+		})
+	} else {
+		err = r.q.SetAccountLimit(ctx, tx, sqlc.SetAccountLimitParams{
+			AccountID:       sql.NullInt64{Int64: limit.AccountID, Valid: true},
+			TransactionType: sqlc.TransactionType(limit.Type),
+			PerTransaction:  toNullBound(limit.PerTransaction),
+			Daily:           toNullBound(limit.Daily),
+			Monthly:         toNullBound(limit.Monthly),
+		})
+	}
+	if err != nil {
+		return errorQuery(err, "failed to set limit")
+	}
+	return nil
+}
+
+// GetSpending returns the amount moved by the limit's account or card with the limit's transaction type
+// since the start of the day & of the month of `now`
+func (r *LimitRepository) GetSpending(ctx context.Context, limit core.SpendingLimit, now time.Time) (core.Spending, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "LimitRepository.GetSpending")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Spending{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	var spending core.Spending
+	for _, period := range []struct {
+		since time.Time
+		total *int64
+	}{
+		{since: core.StartOfDay(now), total: &spending.Day},
+		{since: core.StartOfMonth(now), total: &spending.Month},
+	} {
+		if limit.CardNumber != "" {
+			*period.total, err = r.q.GetCardSpending(ctx, tx, sqlc.GetCardSpendingParams{
+				TransactionType: sqlc.TransactionType(limit.Type),
+				CardNumber:      toNullString(limit.CardNumber),
+				Since:           period.since,
+			})
+		} else {
+			*period.total, err = r.q.GetAccountSpending(ctx, tx, sqlc.GetAccountSpendingParams{
+				TransactionType: sqlc.TransactionType(limit.Type),
+				AccountID:       limit.AccountID,
+				Since:           period.since,
+			})
+		}
+		if err != nil {
+			return core.Spending{}, errorQuery(err, "failed to get spending")
+		}
+	}
+	return spending, nil
+}
+
+func fromDBSpendingLimits(limits []sqlc.SpendingLimit) []core.SpendingLimit {
+	result := make([]core.SpendingLimit, len(limits))
+	for i, l := range limits {
+		result[i] = core.SpendingLimit{
+			AccountID:      l.AccountID.Int64,
+			CardNumber:     l.CardNumber.String,
+			Type:           core.TransactionType(l.TransactionType),
+			PerTransaction: l.PerTransaction.Int64,
+			Daily:          l.Daily.Int64,
+			Monthly:        l.Monthly.Int64,
+			UpdatedAt:      l.UpdatedAt,
+		}
+	}
+	return result
+}
+
+// toNullBound stores unlimited bounds as NULL
+func toNullBound(bound int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: bound, Valid: bound > 0}
+}
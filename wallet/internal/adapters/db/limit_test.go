@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimitRepository(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	// Create an account with a card
+	ar := NewAccountRepository(conn)
+	err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+	require.NoError(t, err)
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	accountID := accounts[0].ID
+	cardNumber := gofakeit.CreditCardNumber(nil)
+	err = NewCardRepository(conn).CreateCard(ctx, core.CreateCardParams{AccountID: accountID, Number: cardNumber})
+	require.NoError(t, err)
+
+	// Set an account limit & a card limit, setting a limit again replaces it
+	lr := NewLimitRepository(conn)
+	err = lr.SetLimit(ctx, core.SpendingLimit{AccountID: accountID, Type: core.TransactionTypeWithdrawal, Daily: 500})
+	require.NoError(t, err)
+	err = lr.SetLimit(ctx, core.SpendingLimit{AccountID: accountID, Type: core.TransactionTypeWithdrawal, Daily: 300, Monthly: 1000})
+	require.NoError(t, err)
+	err = lr.SetLimit(ctx, core.SpendingLimit{CardNumber: cardNumber, Type: core.TransactionTypeWithdrawal, PerTransaction: 100})
+	require.NoError(t, err)
+	limits, err := lr.GetAccountLimits(ctx, accountID)
+	require.NoError(t, err)
+	require.Len(t, limits, 1)
+	require.Equal(t, int64(300), limits[0].Daily)
+	require.Equal(t, int64(1000), limits[0].Monthly)
+	require.Zero(t, limits[0].PerTransaction)
+	limits, err = lr.GetCardLimits(ctx, cardNumber)
+	require.NoError(t, err)
+	require.Len(t, limits, 1)
+	require.Equal(t, int64(100), limits[0].PerTransaction)
+
+	// Both limits apply to withdrawals with the card, none to deposits
+	limits, err = lr.GetTransactionLimits(ctx, core.TransactionTypeWithdrawal, accountID, cardNumber)
+	require.NoError(t, err)
+	require.Len(t, limits, 2)
+	limits, err = lr.GetTransactionLimits(ctx, core.TransactionTypeDeposit, accountID, cardNumber)
+	require.NoError(t, err)
+	require.Empty(t, limits)
+
+	// Spending counts the transactions of the limit's type, by account or by card
+	tr := NewTransactionRepository(conn)
+	_, err = tr.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: accountID, Card: cardNumber})
+	require.NoError(t, err)
+	_, err = tr.Withdraw(ctx, core.CreateTransactionParams{Amount: 100, FromAccountID: accountID, Card: cardNumber})
+	require.NoError(t, err)
+	_, err = tr.Withdraw(ctx, core.CreateTransactionParams{Amount: 50, FromAccountID: accountID})
+	require.NoError(t, err)
+	spending, err := lr.GetSpending(ctx, core.SpendingLimit{AccountID: accountID, Type: core.TransactionTypeWithdrawal}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, core.Spending{Day: 150, Month: 150}, spending)
+	spending, err = lr.GetSpending(ctx, core.SpendingLimit{CardNumber: cardNumber, Type: core.TransactionTypeWithdrawal}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, core.Spending{Day: 100, Month: 100}, spending)
+
+	// Authorized holds count as transfers until they're captured, then the captured transfer counts instead
+	err = ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+	require.NoError(t, err)
+	accounts, err = ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	hr := NewHoldRepository(conn)
+	hold, err := hr.CreateHold(ctx, core.CreateHoldParams{
+		AccountID:          accountID,
+		RecipientAccountID: accounts[1].ID,
+		Amount:             200,
+		ExpiresAt:          time.Now().UTC().Add(time.Hour),
+		Card:               cardNumber,
+	})
+	require.NoError(t, err)
+	spending, err = lr.GetSpending(ctx, core.SpendingLimit{AccountID: accountID, Type: core.TransactionTypeTransfer}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, core.Spending{Day: 200, Month: 200}, spending)
+	spending, err = lr.GetSpending(ctx, core.SpendingLimit{CardNumber: cardNumber, Type: core.TransactionTypeWithdrawal}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, core.Spending{Day: 100, Month: 100}, spending)
+	_, err = hr.CaptureHold(ctx, hold.ID, 150)
+	require.NoError(t, err)
+	spending, err = lr.GetSpending(ctx, core.SpendingLimit{CardNumber: cardNumber, Type: core.TransactionTypeTransfer}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, core.Spending{Day: 150, Month: 150}, spending)
+}
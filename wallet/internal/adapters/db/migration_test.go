@@ -0,0 +1,58 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/escalopa/fingo/utils/testcontainer"
+	"github.com/google/uuid"
+	"github.com/mattes/migrate"
+	"github.com/mattes/migrate/database/postgres"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationMoneyMinorUnits(t *testing.T) {
+	ctx := context.Background()
+
+	// Run the migrations on their own database, the shared one is already migrated
+	dbConn, terminate, err := testcontainer.NewPostgresContainer(ctx)
+	require.NoError(t, err)
+	defer func() { _ = terminate() }()
+	driver, err := postgres.WithInstance(dbConn, &postgres.Config{})
+	require.NoError(t, err)
+	m, err := migrate.NewWithDatabaseInstance("file://./sql/migrations", "postgres", driver)
+	require.NoError(t, err)
+
+	// Store major units amounts as before the migration, one of the transactions lost its accounts
+	require.NoError(t, m.Migrate(5))
+	var userID, accountID int64
+	err = dbConn.QueryRowContext(ctx, `INSERT INTO users (external_id) VALUES ($1) RETURNING id`, uuid.New()).Scan(&userID)
+	require.NoError(t, err)
+	err = dbConn.QueryRowContext(ctx, `INSERT INTO accounts (user_id, name, balance) VALUES ($1, 'main', 12.34) RETURNING id`, userID).Scan(&accountID)
+	require.NoError(t, err)
+	var transactionID, orphanID uuid.UUID
+	err = dbConn.QueryRowContext(ctx, `INSERT INTO transactions (type, amount, destination_account_id) VALUES ('deposit', 10.5, $1) RETURNING id`, accountID).Scan(&transactionID)
+	require.NoError(t, err)
+	err = dbConn.QueryRowContext(ctx, `INSERT INTO transactions (type, amount) VALUES ('deposit', 3.21) RETURNING id`).Scan(&orphanID)
+	require.NoError(t, err)
+
+	// Up converts the balances & amounts to minor units
+	require.NoError(t, m.Migrate(6))
+	var balance, amount, orphanAmount int64
+	require.NoError(t, dbConn.QueryRowContext(ctx, `SELECT balance FROM accounts WHERE id = $1`, accountID).Scan(&balance))
+	require.NoError(t, dbConn.QueryRowContext(ctx, `SELECT amount FROM transactions WHERE id = $1`, transactionID).Scan(&amount))
+	require.NoError(t, dbConn.QueryRowContext(ctx, `SELECT amount FROM transactions WHERE id = $1`, orphanID).Scan(&orphanAmount))
+	require.Equal(t, int64(1234), balance)
+	require.Equal(t, int64(1050), amount)
+	require.Equal(t, int64(321), orphanAmount)
+
+	// Down converts them back to major units
+	require.NoError(t, m.Migrate(5))
+	var majorBalance, majorAmount, majorOrphanAmount float64
+	require.NoError(t, dbConn.QueryRowContext(ctx, `SELECT balance FROM accounts WHERE id = $1`, accountID).Scan(&majorBalance))
+	require.NoError(t, dbConn.QueryRowContext(ctx, `SELECT amount FROM transactions WHERE id = $1`, transactionID).Scan(&majorAmount))
+	require.NoError(t, dbConn.QueryRowContext(ctx, `SELECT amount FROM transactions WHERE id = $1`, orphanID).Scan(&majorOrphanAmount))
+	require.InDelta(t, 12.34, majorBalance, 1e-9)
+	require.InDelta(t, 10.5, majorAmount, 1e-9)
+	require.InDelta(t, 3.21, majorOrphanAmount, 1e-9)
+}
@@ -0,0 +1,220 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+)
+
+type PaymentRequestRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewPaymentRequestRepository(db *sql.DB) *PaymentRequestRepository {
+	return &PaymentRequestRepository{db: db, q: sqlc.New()}
+}
+
+// CreatePaymentRequest stores a pending payment request expiring at `params.ExpiresAt`
+func (r *PaymentRequestRepository) CreatePaymentRequest(ctx context.Context, params core.CreatePaymentRequestParams) (core.PaymentRequest, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PaymentRequestRepository.CreatePaymentRequest")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.PaymentRequest{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	// Get currency id
+	currency, err := r.q.GetCurrencyByCode(ctx, tx, params.Currency.String())
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.PaymentRequest{}, errorNotFound(err, "currency not found")
+		} else {
+			return core.PaymentRequest{}, errorQuery(err, "failed to get currency")
+		}
+	}
+	requestID, err := r.q.CreatePaymentRequest(ctx, tx, sqlc.CreatePaymentRequestParams{
+		RequesterID: params.RequesterID,
+		PayerID:     params.PayerID,
+		ToAccountID: params.ToAccountID,
+		Amount:      params.Amount,
+		CurrencyID:  currency.ID,
+		Note:        params.Note,
+		ExpiresAt:   params.ExpiresAt,
+	})
+	if err != nil {
+		return core.PaymentRequest{}, errorQuery(err, "failed to create payment request")
+	}
+	return getPaymentRequest(ctx, r.q, tx, requestID)
+}
+
+// GetPaymentRequest returns a payment request by its ID
+func (r *PaymentRequestRepository) GetPaymentRequest(ctx context.Context, requestID uuid.UUID) (core.PaymentRequest, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PaymentRequestRepository.GetPaymentRequest")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.PaymentRequest{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	return getPaymentRequest(ctx, r.q, tx, requestID)
+}
+
+// GetIncomingPaymentRequests returns the payment requests sent to a payer, newest first
+func (r *PaymentRequestRepository) GetIncomingPaymentRequests(ctx context.Context, payerID int64) ([]core.PaymentRequest, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PaymentRequestRepository.GetIncomingPaymentRequests")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	requests, err := r.q.GetIncomingPaymentRequests(ctx, tx, payerID)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get incoming payment requests")
+	}
+	result := make([]core.PaymentRequest, len(requests))
+	for i, pr := range requests {
+		result[i] = fromDBPaymentRequestRowToPaymentRequest(sqlc.GetPaymentRequestRow(pr))
+	}
+	return result, nil
+}
+
+// GetOutgoingPaymentRequests returns the payment requests sent by a requester, newest first
+func (r *PaymentRequestRepository) GetOutgoingPaymentRequests(ctx context.Context, requesterID int64) ([]core.PaymentRequest, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PaymentRequestRepository.GetOutgoingPaymentRequests")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	requests, err := r.q.GetOutgoingPaymentRequests(ctx, tx, requesterID)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get outgoing payment requests")
+	}
+	result := make([]core.PaymentRequest, len(requests))
+	for i, pr := range requests {
+		result[i] = fromDBPaymentRequestRowToPaymentRequest(sqlc.GetPaymentRequestRow(pr))
+	}
+	return result, nil
+}
+
+// PayPaymentRequest transfers the requested amount from `fromAccountID` with its card `fromCard` to the requester's account
+// & marks the request as paid, the caller must check the available balance & the spending limits beforehand
+func (r *PaymentRequestRepository) PayPaymentRequest(ctx context.Context, requestID uuid.UUID, fromAccountID int64, fromCard string) (core.Transaction, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PaymentRequestRepository.PayPaymentRequest")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Transaction{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	// Mark the request as paid, fails if it was paid, declined, cancelled or expired meanwhile
+	_, err = r.q.PayPaymentRequest(ctx, tx, requestID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Transaction{}, errorPaymentRequestClosed
+		} else {
+			return core.Transaction{}, errorQuery(err, "failed to pay payment request")
+		}
+	}
+	request, err := getPaymentRequest(ctx, r.q, tx, requestID)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	transaction, err := transfer(ctx, r.q, tx, core.CreateTransactionParams{
+		Amount:        request.Amount,
+		FromAccountID: fromAccountID,
+		ToAccountID:   request.ToAccountID,
+		Card:          fromCard,
+	})
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	err = r.q.SetPaymentRequestTransaction(ctx, tx, sqlc.SetPaymentRequestTransactionParams{
+		ID:            requestID,
+		TransactionID: uuid.NullUUID{UUID: transaction.ID, Valid: true},
+	})
+	if err != nil {
+		return core.Transaction{}, errorQuery(err, "failed to set payment request transaction")
+	}
+	return transaction, nil
+}
+
+// ClosePaymentRequest moves a pending payment request to `status` without moving money, i.e. declined or cancelled
+func (r *PaymentRequestRepository) ClosePaymentRequest(ctx context.Context, requestID uuid.UUID, status core.PaymentRequestStatus) error {
+	ctx, span := tracer.Tracer().Start(ctx, "PaymentRequestRepository.ClosePaymentRequest")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	_, err = r.q.ClosePaymentRequest(ctx, tx, sqlc.ClosePaymentRequestParams{
+		ID:     requestID,
+		Status: string(status),
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return errorPaymentRequestClosed
+		} else {
+			return errorQuery(err, "failed to close payment request")
+		}
+	}
+	return nil
+}
+
+// ExpirePaymentRequests marks the pending payment requests past their expiry as expired and returns how many were expired
+// Expired requests can't be paid as soon as they expire, this only settles their status
+func (r *PaymentRequestRepository) ExpirePaymentRequests(ctx context.Context) (int64, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PaymentRequestRepository.ExpirePaymentRequests")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	expired, err := r.q.ExpirePaymentRequests(ctx, tx)
+	if err != nil {
+		return 0, errorQuery(err, "failed to expire payment requests")
+	}
+	return expired, nil
+}
+
+func getPaymentRequest(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, requestID uuid.UUID) (core.PaymentRequest, error) {
+	request, err := q.GetPaymentRequest(ctx, tx, requestID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.PaymentRequest{}, errorNotFound(err, "payment request not found")
+		} else {
+			return core.PaymentRequest{}, errorQuery(err, "failed to get payment request")
+		}
+	}
+	return fromDBPaymentRequestRowToPaymentRequest(request), nil
+}
+
+// fromDBPaymentRequestRowToPaymentRequest converts a sqlc.GetPaymentRequestRow to a core.PaymentRequest
+func fromDBPaymentRequestRowToPaymentRequest(pr sqlc.GetPaymentRequestRow) core.PaymentRequest {
+	return core.PaymentRequest{
+		ID:                  pr.ID,
+		RequesterID:         pr.RequesterID,
+		PayerID:             pr.PayerID,
+		RequesterExternalID: pr.RequesterExternalID,
+		PayerExternalID:     pr.PayerExternalID,
+		ToAccountID:         pr.ToAccountID,
+		Amount:              pr.Amount,
+		Currency:            core.Currency(pr.Currency),
+		Note:                pr.Note,
+		Status:              core.PaymentRequestStatus(pr.Status),
+		TransactionID:       pr.TransactionID.UUID,
+		SplitID:             pr.SplitID.UUID,
+		ExpiresAt:           pr.ExpiresAt,
+		CreatedAt:           pr.CreatedAt,
+		UpdatedAt:           pr.UpdatedAt,
+	}
+}
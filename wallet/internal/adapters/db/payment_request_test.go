@@ -0,0 +1,90 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentRequestRepository(t *testing.T) {
+	ctx := context.Background()
+	requesterID, payerID := generateRandomUser(t), generateRandomUser(t)
+
+	// Create an account for each user & fund the payer's one
+	ar := NewAccountRepository(conn)
+	for _, userID := range []int64{requesterID, payerID} {
+		err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+		require.NoError(t, err)
+	}
+	requesterAccounts, err := ar.GetAccounts(ctx, requesterID)
+	require.NoError(t, err)
+	payerAccounts, err := ar.GetAccounts(ctx, payerID)
+	require.NoError(t, err)
+	to, from := requesterAccounts[0].ID, payerAccounts[0].ID
+	_, err = NewTransactionRepository(conn).Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from})
+	require.NoError(t, err)
+
+	pr := NewPaymentRequestRepository(conn)
+	create := func(expiresAt time.Time) core.PaymentRequest {
+		request, err := pr.CreatePaymentRequest(ctx, core.CreatePaymentRequestParams{
+			RequesterID: requesterID,
+			PayerID:     payerID,
+			ToAccountID: to,
+			Amount:      300,
+			Currency:    core.CurrencyUSD,
+			Note:        gofakeit.Sentence(5),
+			ExpiresAt:   expiresAt,
+		})
+		require.NoError(t, err)
+		return request
+	}
+
+	// Created requests are pending & listed on both sides
+	request := create(time.Now().UTC().Add(time.Hour))
+	require.Equal(t, core.PaymentRequestStatusPending, request.Status)
+	require.Equal(t, core.CurrencyUSD, request.Currency)
+	incoming, err := pr.GetIncomingPaymentRequests(ctx, payerID)
+	require.NoError(t, err)
+	require.Len(t, incoming, 1)
+	require.Equal(t, request.ID, incoming[0].ID)
+	outgoing, err := pr.GetOutgoingPaymentRequests(ctx, requesterID)
+	require.NoError(t, err)
+	require.Len(t, outgoing, 1)
+	require.Equal(t, request.ID, outgoing[0].ID)
+
+	// Paying moves the amount to the requester's account, only once
+	transaction, err := pr.PayPaymentRequest(ctx, request.ID, from, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(300), transaction.Amount)
+	request, err = pr.GetPaymentRequest(ctx, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, core.PaymentRequestStatusPaid, request.Status)
+	require.Equal(t, transaction.ID, request.TransactionID)
+	account, err := ar.GetAccount(ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), account.Balance)
+	_, err = pr.PayPaymentRequest(ctx, request.ID, from, "")
+	require.Error(t, err)
+
+	// Declined requests can't be paid
+	request = create(time.Now().UTC().Add(time.Hour))
+	require.NoError(t, pr.ClosePaymentRequest(ctx, request.ID, core.PaymentRequestStatusDeclined))
+	require.Error(t, pr.ClosePaymentRequest(ctx, request.ID, core.PaymentRequestStatusCancelled))
+	_, err = pr.PayPaymentRequest(ctx, request.ID, from, "")
+	require.Error(t, err)
+
+	// Expired requests can't be paid
+	request = create(time.Now().UTC().Add(-time.Minute))
+	_, err = pr.PayPaymentRequest(ctx, request.ID, from, "")
+	require.Error(t, err)
+	expired, err := pr.ExpirePaymentRequests(ctx)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, expired, int64(1))
+	request, err = pr.GetPaymentRequest(ctx, request.ID)
+	require.NoError(t, err)
+	require.Equal(t, core.PaymentRequestStatusExpired, request.Status)
+}
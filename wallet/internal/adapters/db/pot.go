@@ -0,0 +1,249 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+)
+
+type PotRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewPotRepository(db *sql.DB) *PotRepository {
+	return &PotRepository{db: db, q: sqlc.New()}
+}
+
+// CreatePot creates an empty pot in the account, pot names are unique within an account
+func (r *PotRepository) CreatePot(ctx context.Context, params core.CreatePotParams) (core.Pot, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PotRepository.CreatePot")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Pot{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	pot, err := r.q.CreatePot(ctx, tx, sqlc.CreatePotParams{
+		AccountID:    params.AccountID,
+		Name:         params.Name,
+		TargetAmount: toNullBound(params.TargetAmount),
+		TargetDate:   toNullTime(params.TargetDate),
+	})
+	if err != nil {
+		if IsUniqueViolationError(err) {
+			return core.Pot{}, errorUniqueViolation(err, "pot with this name already exists in the account")
+		} else {
+			return core.Pot{}, errorQuery(err, "failed to create pot")
+		}
+	}
+	return fromDBPotToPot(pot), nil
+}
+
+// GetPot returns a pot by its ID, deleted pots aren't found
+func (r *PotRepository) GetPot(ctx context.Context, potID uuid.UUID) (core.Pot, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PotRepository.GetPot")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Pot{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	pot, err := r.q.GetPot(ctx, tx, potID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Pot{}, errorNotFound(err, "pot not found")
+		} else {
+			return core.Pot{}, errorQuery(err, "failed to get pot")
+		}
+	}
+	return fromDBPotToPot(pot), nil
+}
+
+// GetAccountPots returns the pots of an account, oldest first
+func (r *PotRepository) GetAccountPots(ctx context.Context, accountID int64) ([]core.Pot, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PotRepository.GetAccountPots")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	pots, err := r.q.GetAccountPots(ctx, tx, accountID)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get account pots")
+	}
+	return fromDBPots(pots), nil
+}
+
+// GetUserPots returns the pots of all the user's accounts, grouped by account & oldest first
+func (r *PotRepository) GetUserPots(ctx context.Context, userID int64) ([]core.Pot, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PotRepository.GetUserPots")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	pots, err := r.q.GetUserPots(ctx, tx, userID)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get user pots")
+	}
+	return fromDBPots(pots), nil
+}
+
+// UpdatePot replaces the name & target of a pot
+func (r *PotRepository) UpdatePot(ctx context.Context, params core.UpdatePotParams) (core.Pot, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PotRepository.UpdatePot")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Pot{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	pot, err := r.q.UpdatePot(ctx, tx, sqlc.UpdatePotParams{
+		ID:           params.ID,
+		Name:         params.Name,
+		TargetAmount: toNullBound(params.TargetAmount),
+		TargetDate:   toNullTime(params.TargetDate),
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Pot{}, errorNotFound(err, "pot not found")
+		} else if IsUniqueViolationError(err) {
+			return core.Pot{}, errorUniqueViolation(err, "pot with this name already exists in the account")
+		} else {
+			return core.Pot{}, errorQuery(err, "failed to update pot")
+		}
+	}
+	return fromDBPotToPot(pot), nil
+}
+
+// DeletePot deletes an empty pot, its moves are kept in the account's history
+func (r *PotRepository) DeletePot(ctx context.Context, potID uuid.UUID) error {
+	ctx, span := tracer.Tracer().Start(ctx, "PotRepository.DeletePot")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	deleted, err := r.q.DeletePot(ctx, tx, potID)
+	if err != nil {
+		return errorQuery(err, "failed to delete pot")
+	}
+	if deleted == 0 {
+		// Tell apart the missing pots from the non-empty ones
+		if _, err = r.q.GetPot(ctx, tx, potID); err != nil {
+			if IsNotFoundError(err) {
+				return errorPotNotFound
+			}
+			return errorQuery(err, "failed to get pot")
+		}
+		return errorPotNotEmpty
+	}
+	return nil
+}
+
+// MovePotMoney moves money between the account's balance & one of its pots and creates a pot transaction
+// The account is the transaction's source when money moves into the pot & its destination when it moves back
+func (r *PotRepository) MovePotMoney(ctx context.Context, params core.MovePotMoneyParams) (core.Transaction, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "PotRepository.MovePotMoney")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Transaction{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	arg := sqlc.CreatePotTransactionParams{
+		Amount: params.Amount,
+		PotID:  uuid.NullUUID{UUID: params.PotID, Valid: true},
+	}
+	accountAmount := params.Amount // posted to the account
+	var balance int64
+	if params.Move == core.PotMoveDeposit {
+		accountAmount = -params.Amount
+		balance, err = r.q.SubAccountBalance(ctx, tx, sqlc.SubAccountBalanceParams{ID: params.AccountID, Balance: params.Amount})
+		if err != nil {
+			if IsNotFoundError(err) {
+				return core.Transaction{}, errorNoSufficientFunds
+			} else {
+				return core.Transaction{}, errorQuery(err, "failed to subtract money from account")
+			}
+		}
+		if _, err = r.q.AddPotBalance(ctx, tx, sqlc.AddPotBalanceParams{ID: params.PotID, Balance: params.Amount}); err != nil {
+			if IsNotFoundError(err) {
+				return core.Transaction{}, errorNotFound(err, "pot not found")
+			} else {
+				return core.Transaction{}, errorQuery(err, "failed to add money to pot")
+			}
+		}
+		arg.SourceAccountID = sql.NullInt64{Int64: params.AccountID, Valid: true}
+		arg.SourceBalanceAfter = sql.NullInt64{Int64: balance, Valid: true}
+	} else {
+		if _, err = r.q.SubPotBalance(ctx, tx, sqlc.SubPotBalanceParams{ID: params.PotID, Balance: params.Amount}); err != nil {
+			if IsNotFoundError(err) {
+				return core.Transaction{}, errorNotFound(err, "pot not found")
+			} else {
+				return core.Transaction{}, errorQuery(err, "failed to subtract money from pot")
+			}
+		}
+		balance, err = r.q.AddAccountBalance(ctx, tx, sqlc.AddAccountBalanceParams{ID: params.AccountID, Balance: params.Amount})
+		if err != nil {
+			if IsNotFoundError(err) {
+				return core.Transaction{}, errorNotFound(err, "account not found")
+			} else {
+				return core.Transaction{}, errorQuery(err, "failed to add money to account")
+			}
+		}
+		arg.DestinationAccountID = sql.NullInt64{Int64: params.AccountID, Valid: true}
+		arg.DestinationBalanceAfter = sql.NullInt64{Int64: balance, Valid: true}
+	}
+	id, err := r.q.CreatePotTransaction(ctx, tx, arg)
+	if err != nil {
+		return core.Transaction{}, errorQuery(err, "failed to create transaction")
+	}
+	// Record the movement in the ledger, the pots system account holds the money set aside
+	potsID, err := getSystemAccountID(ctx, r.q, tx, systemAccountPots, params.AccountID)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	err = createJournalEntry(ctx, r.q, tx, id, string(sqlc.TransactionTypePot),
+		posting{accountID: params.AccountID, amount: accountAmount},
+		posting{systemAccountID: potsID, amount: -accountAmount},
+	)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	// Read back the created transaction
+	transaction, err := getTransaction(ctx, r.q, tx, id)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	return transaction, nil
+}
+
+func fromDBPots(pots []sqlc.Pot) []core.Pot {
+	res := make([]core.Pot, len(pots))
+	for i, p := range pots {
+		res[i] = fromDBPotToPot(p)
+	}
+	return res
+}
+
+func fromDBPotToPot(p sqlc.Pot) core.Pot {
+	return core.Pot{
+		ID:           p.ID,
+		AccountID:    p.AccountID,
+		Name:         p.Name,
+		Balance:      p.Balance,
+		TargetAmount: p.TargetAmount.Int64,
+		TargetDate:   p.TargetDate.Time,
+		CreatedAt:    p.CreatedAt,
+		UpdatedAt:    p.UpdatedAt,
+	}
+}
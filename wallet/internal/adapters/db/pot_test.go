@@ -0,0 +1,75 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPotRepository(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+	ar := NewAccountRepository(conn)
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: "wallet", Currency: core.CurrencyUSD}))
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	accountID := accounts[0].ID
+	_, err = NewTransactionRepository(conn).Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: accountID})
+	require.NoError(t, err)
+
+	// Pot names are unique within an account
+	pr := NewPotRepository(conn)
+	targetDate := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	pot, err := pr.CreatePot(ctx, core.CreatePotParams{AccountID: accountID, Name: "holidays", TargetAmount: 5000, TargetDate: targetDate})
+	require.NoError(t, err)
+	require.Zero(t, pot.Balance)
+	require.Equal(t, int64(5000), pot.TargetAmount)
+	require.True(t, pot.TargetDate.Equal(targetDate))
+	_, err = pr.CreatePot(ctx, core.CreatePotParams{AccountID: accountID, Name: "holidays"})
+	require.Error(t, err)
+
+	// Money moved into the pot leaves the account's balance
+	transaction, err := pr.MovePotMoney(ctx, core.MovePotMoneyParams{PotID: pot.ID, AccountID: accountID, Amount: 300, Move: core.PotMoveDeposit})
+	require.NoError(t, err)
+	require.Equal(t, core.TransactionTypePot, transaction.Type)
+	require.Equal(t, pot.ID, transaction.PotID)
+	require.Equal(t, accountID, transaction.FromAccountID)
+	require.Equal(t, "holidays", transaction.ToAccountName)
+	require.Equal(t, int64(700), transaction.FromAccountBalance)
+	transaction, err = pr.MovePotMoney(ctx, core.MovePotMoneyParams{PotID: pot.ID, AccountID: accountID, Amount: 100, Move: core.PotMoveWithdraw})
+	require.NoError(t, err)
+	require.Equal(t, accountID, transaction.ToAccountID)
+	require.Equal(t, int64(800), transaction.ToAccountBalance)
+	pots, err := pr.GetUserPots(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, pots, 1)
+	require.Equal(t, int64(200), pots[0].Balance)
+
+	// A pot can't be emptied below zero
+	_, err = pr.MovePotMoney(ctx, core.MovePotMoneyParams{PotID: pot.ID, AccountID: accountID, Amount: 300, Move: core.PotMoveWithdraw})
+	require.Error(t, err)
+
+	// Updating a pot replaces its name & target
+	pot, err = pr.UpdatePot(ctx, core.UpdatePotParams{ID: pot.ID, Name: "travel"})
+	require.NoError(t, err)
+	require.Equal(t, "travel", pot.Name)
+	require.Zero(t, pot.TargetAmount)
+	require.True(t, pot.TargetDate.IsZero())
+
+	// Only empty pots are deleted
+	require.Error(t, pr.DeletePot(ctx, pot.ID))
+	_, err = pr.MovePotMoney(ctx, core.MovePotMoneyParams{PotID: pot.ID, AccountID: accountID, Amount: 200, Move: core.PotMoveWithdraw})
+	require.NoError(t, err)
+	require.NoError(t, pr.DeletePot(ctx, pot.ID))
+	_, err = pr.GetPot(ctx, pot.ID)
+	require.Error(t, err)
+	pots, err = pr.GetAccountPots(ctx, accountID)
+	require.NoError(t, err)
+	require.Empty(t, pots)
+	account, err := ar.GetAccount(ctx, accountID)
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), account.Balance)
+}
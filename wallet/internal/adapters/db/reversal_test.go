@@ -0,0 +1,86 @@
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionRepository_ReverseTransactionPartially(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	// Create two accounts & fund the first one
+	ar := NewAccountRepository(conn)
+	for i := 0; i < 2; i++ {
+		err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+		require.NoError(t, err)
+	}
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	from, to := accounts[0].ID, accounts[1].ID
+
+	tr := NewTransactionRepository(conn)
+	deposit, err := tr.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from})
+	require.NoError(t, err)
+	transfer, err := tr.Transfer(ctx, core.CreateTransactionParams{Amount: 400, FromAccountID: from, ToAccountID: to})
+	require.NoError(t, err)
+
+	requireBalance := func(accountID, balance int64) {
+		account, err := ar.GetAccount(ctx, accountID)
+		require.NoError(t, err)
+		require.Equal(t, balance, account.Balance)
+	}
+
+	// Refund part of the transfer
+	refund, err := tr.ReverseTransaction(ctx, core.ReverseTransactionParams{TransactionID: transfer.ID, Amount: 150, Reason: "damaged item"})
+	require.NoError(t, err)
+	require.Equal(t, core.TransactionTypeReversal, refund.Type)
+	require.Equal(t, transfer.ID, refund.ReversedTransactionID)
+	require.Equal(t, "damaged item", refund.Reason)
+	require.Equal(t, to, refund.FromAccountID)
+	require.Equal(t, from, refund.ToAccountID)
+	require.Equal(t, int64(150), refund.Amount)
+	requireBalance(from, 750)
+	requireBalance(to, 250)
+
+	got, err := tr.GetTransaction(ctx, transfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(150), got.ReversedAmount)
+	require.Equal(t, int64(250), got.RefundableAmount())
+	require.False(t, got.IsRolledBack)
+
+	// Refunds can't exceed what is left of the transfer
+	_, err = tr.ReverseTransaction(ctx, core.ReverseTransactionParams{TransactionID: transfer.ID, Amount: 251})
+	require.Error(t, err)
+
+	// Refund the rest of the transfer
+	_, err = tr.ReverseTransaction(ctx, core.ReverseTransactionParams{TransactionID: transfer.ID, Amount: 250})
+	require.NoError(t, err)
+	requireBalance(from, 1000)
+	requireBalance(to, 0)
+	got, err = tr.GetTransaction(ctx, transfer.ID)
+	require.NoError(t, err)
+	require.True(t, got.IsRolledBack)
+	require.Zero(t, got.RefundableAmount())
+
+	// Reversals can't be reversed
+	_, err = tr.ReverseTransaction(ctx, core.ReverseTransactionParams{TransactionID: refund.ID, Amount: 1})
+	require.Error(t, err)
+
+	// Deposits are taken back to the ATM
+	_, err = tr.ReverseTransaction(ctx, core.ReverseTransactionParams{TransactionID: deposit.ID, Amount: 100, Reason: "chargeback"})
+	require.NoError(t, err)
+	requireBalance(from, 900)
+
+	// Every refund is kept in the ledger
+	mismatches, err := NewLedgerRepository(conn).Reconcile(ctx)
+	require.NoError(t, err)
+	for _, m := range mismatches {
+		require.NotContains(t, []int64{from, to}, m.AccountID)
+	}
+}
@@ -0,0 +1,133 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+)
+
+type SplitRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewSplitRepository(db *sql.DB) *SplitRepository {
+	return &SplitRepository{db: db, q: sqlc.New()}
+}
+
+// CreateSplit stores a split & a pending payment request for each of its shares, all or none of them are created
+func (r *SplitRepository) CreateSplit(ctx context.Context, params core.CreateSplitParams) (core.Split, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "SplitRepository.CreateSplit")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Split{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	// Get currency id
+	currency, err := r.q.GetCurrencyByCode(ctx, tx, params.Currency.String())
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Split{}, errorNotFound(err, "currency not found")
+		} else {
+			return core.Split{}, errorQuery(err, "failed to get currency")
+		}
+	}
+	splitID, err := r.q.CreateSplit(ctx, tx, sqlc.CreateSplitParams{
+		RequesterID: params.RequesterID,
+		ToAccountID: params.ToAccountID,
+		Amount:      params.Amount,
+		CurrencyID:  currency.ID,
+		Method:      string(params.Method),
+		Note:        params.Note,
+	})
+	if err != nil {
+		return core.Split{}, errorQuery(err, "failed to create split")
+	}
+	for _, share := range params.Shares {
+		_, err = r.q.CreatePaymentRequest(ctx, tx, sqlc.CreatePaymentRequestParams{
+			RequesterID: params.RequesterID,
+			PayerID:     share.PayerID,
+			ToAccountID: params.ToAccountID,
+			Amount:      share.Amount,
+			CurrencyID:  currency.ID,
+			Note:        params.Note,
+			ExpiresAt:   params.ExpiresAt,
+			SplitID:     uuid.NullUUID{UUID: splitID, Valid: true},
+		})
+		if err != nil {
+			return core.Split{}, errorQuery(err, "failed to create split share")
+		}
+	}
+	return getSplit(ctx, r.q, tx, splitID)
+}
+
+// GetSplit returns a split by its ID with its shares
+func (r *SplitRepository) GetSplit(ctx context.Context, splitID uuid.UUID) (core.Split, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "SplitRepository.GetSplit")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Split{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	return getSplit(ctx, r.q, tx, splitID)
+}
+
+// GetSplits returns the splits created by a requester with their shares, newest first
+func (r *SplitRepository) GetSplits(ctx context.Context, requesterID int64) ([]core.Split, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "SplitRepository.GetSplits")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	splitIDs, err := r.q.GetUserSplits(ctx, tx, requesterID)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get splits")
+	}
+	result := make([]core.Split, len(splitIDs))
+	for i, splitID := range splitIDs {
+		result[i], err = getSplit(ctx, r.q, tx, splitID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func getSplit(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, splitID uuid.UUID) (core.Split, error) {
+	split, err := q.GetSplit(ctx, tx, splitID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Split{}, errorNotFound(err, "split not found")
+		} else {
+			return core.Split{}, errorQuery(err, "failed to get split")
+		}
+	}
+	shares, err := q.GetSplitPaymentRequests(ctx, tx, uuid.NullUUID{UUID: splitID, Valid: true})
+	if err != nil {
+		return core.Split{}, errorQuery(err, "failed to get split shares")
+	}
+	result := core.Split{
+		ID:                  split.ID,
+		RequesterID:         split.RequesterID,
+		RequesterExternalID: split.RequesterExternalID,
+		ToAccountID:         split.ToAccountID,
+		Amount:              split.Amount,
+		Currency:            core.Currency(split.Currency),
+		Method:              core.SplitMethod(split.Method),
+		Note:                split.Note,
+		Shares:              make([]core.PaymentRequest, len(shares)),
+		CreatedAt:           split.CreatedAt,
+	}
+	for i, pr := range shares {
+		result.Shares[i] = fromDBPaymentRequestRowToPaymentRequest(sqlc.GetPaymentRequestRow(pr))
+	}
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitRepository(t *testing.T) {
+	ctx := context.Background()
+	requesterID := generateRandomUser(t)
+	payerIDs := []int64{generateRandomUser(t), generateRandomUser(t)}
+
+	// Create an account for each user & fund the payers' ones
+	ar := NewAccountRepository(conn)
+	accounts := make(map[int64]int64)
+	for _, userID := range append([]int64{requesterID}, payerIDs...) {
+		err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+		require.NoError(t, err)
+		userAccounts, err := ar.GetAccounts(ctx, userID)
+		require.NoError(t, err)
+		accounts[userID] = userAccounts[0].ID
+		if userID != requesterID {
+			_, err = NewTransactionRepository(conn).Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: accounts[userID]})
+			require.NoError(t, err)
+		}
+	}
+
+	// Creating a split creates a pending payment request per share
+	sr := NewSplitRepository(conn)
+	split, err := sr.CreateSplit(ctx, core.CreateSplitParams{
+		RequesterID: requesterID,
+		ToAccountID: accounts[requesterID],
+		Amount:      900,
+		Currency:    core.CurrencyUSD,
+		Method:      core.SplitMethodEqual,
+		Note:        gofakeit.Sentence(5),
+		Shares:      []core.SplitShare{{PayerID: payerIDs[0], Amount: 300}, {PayerID: payerIDs[1], Amount: 300}},
+		ExpiresAt:   time.Now().UTC().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, core.SplitMethodEqual, split.Method)
+	require.Equal(t, core.CurrencyUSD, split.Currency)
+	require.Len(t, split.Shares, 2)
+	require.Equal(t, int64(300), split.RequesterShare())
+	require.Equal(t, int64(600), split.Outstanding(time.Now().UTC()))
+	for _, share := range split.Shares {
+		require.Equal(t, split.ID, share.SplitID)
+		require.Equal(t, core.PaymentRequestStatusPending, share.Status)
+	}
+
+	// Settling a share is paying its payment request
+	share, ok := split.ShareOf(payerIDs[0])
+	require.True(t, ok)
+	_, err = NewPaymentRequestRepository(conn).PayPaymentRequest(ctx, share.ID, accounts[payerIDs[0]], "")
+	require.NoError(t, err)
+	split, err = sr.GetSplit(ctx, split.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), split.Outstanding(time.Now().UTC()))
+	require.Equal(t, int64(300), split.Settled())
+
+	// Splits are listed for their requester only
+	splits, err := sr.GetSplits(ctx, requesterID)
+	require.NoError(t, err)
+	require.Len(t, splits, 1)
+	require.Equal(t, split.ID, splits[0].ID)
+	splits, err = sr.GetSplits(ctx, payerIDs[0])
+	require.NoError(t, err)
+	require.Empty(t, splits)
+
+	// A share to an unknown user fails the whole split
+	_, err = sr.CreateSplit(ctx, core.CreateSplitParams{
+		RequesterID: requesterID,
+		ToAccountID: accounts[requesterID],
+		Amount:      600,
+		Currency:    core.CurrencyUSD,
+		Method:      core.SplitMethodAmount,
+		Shares:      []core.SplitShare{{PayerID: payerIDs[0], Amount: 300}, {PayerID: -1, Amount: 300}},
+		ExpiresAt:   time.Now().UTC().Add(time.Hour),
+	})
+	require.Error(t, err)
+	splits, err = sr.GetSplits(ctx, requesterID)
+	require.NoError(t, err)
+	require.Len(t, splits, 1)
+}
@@ -0,0 +1,35 @@
+ALTER TABLE transactions
+  ADD COLUMN amount_major DOUBLE PRECISION;
+
+UPDATE transactions t
+SET amount_major = t.amount / POWER(10, c.minor_units)
+FROM accounts a
+       JOIN currency c ON c.id = a.currency_id
+WHERE a.id = COALESCE(t.source_account_id, t.destination_account_id);
+
+UPDATE transactions
+SET amount_major = amount / 100.0
+WHERE amount_major IS NULL;
+
+ALTER TABLE transactions
+  DROP COLUMN amount;
+ALTER TABLE transactions
+  RENAME COLUMN amount_major TO amount;
+ALTER TABLE transactions
+  ALTER COLUMN amount SET NOT NULL;
+
+ALTER TABLE accounts
+  ADD COLUMN balance_major DOUBLE PRECISION NOT NULL DEFAULT 0.0;
+
+UPDATE accounts a
+SET balance_major = a.balance / POWER(10, c.minor_units)
+FROM currency c
+WHERE c.id = a.currency_id;
+
+ALTER TABLE accounts
+  DROP COLUMN balance;
+ALTER TABLE accounts
+  RENAME COLUMN balance_major TO balance;
+
+ALTER TABLE currency
+  DROP COLUMN minor_units;
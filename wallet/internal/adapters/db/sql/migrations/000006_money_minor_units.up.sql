@@ -0,0 +1,39 @@
+-- Number of digits after the decimal separator for each currency (ISO 4217 exponent)
+ALTER TABLE currency
+  ADD COLUMN minor_units SMALLINT NOT NULL DEFAULT 2;
+
+-- Convert balances to integer minor units of the account's currency
+ALTER TABLE accounts
+  ADD COLUMN balance_minor BIGINT NOT NULL DEFAULT 0;
+
+UPDATE accounts a
+SET balance_minor = ROUND(a.balance * POWER(10, c.minor_units))::BIGINT
+FROM currency c
+WHERE c.id = a.currency_id;
+
+ALTER TABLE accounts
+  DROP COLUMN balance;
+ALTER TABLE accounts
+  RENAME COLUMN balance_minor TO balance;
+
+-- Convert transaction amounts to integer minor units of the accounts' currency
+ALTER TABLE transactions
+  ADD COLUMN amount_minor BIGINT;
+
+UPDATE transactions t
+SET amount_minor = ROUND(t.amount * POWER(10, c.minor_units))::BIGINT
+FROM accounts a
+       JOIN currency c ON c.id = a.currency_id
+WHERE a.id = COALESCE(t.source_account_id, t.destination_account_id);
+
+-- Transactions whose accounts were deleted fall back to the default exponent
+UPDATE transactions
+SET amount_minor = ROUND(amount * 100)::BIGINT
+WHERE amount_minor IS NULL;
+
+ALTER TABLE transactions
+  DROP COLUMN amount;
+ALTER TABLE transactions
+  RENAME COLUMN amount_minor TO amount;
+ALTER TABLE transactions
+  ALTER COLUMN amount SET NOT NULL;
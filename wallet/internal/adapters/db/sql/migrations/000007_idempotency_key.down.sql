@@ -0,0 +1,6 @@
+DROP INDEX transactions_idempotency_key_idx;
+
+ALTER TABLE transactions
+  DROP COLUMN idempotency_key,
+  DROP COLUMN rollback_idempotency_key,
+  DROP COLUMN rolled_back_at;
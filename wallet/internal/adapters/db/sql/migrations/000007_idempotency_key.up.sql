@@ -0,0 +1,9 @@
+-- Client supplied keys used to detect retried requests
+ALTER TABLE transactions
+  ADD COLUMN idempotency_key          VARCHAR(64),
+  ADD COLUMN rollback_idempotency_key VARCHAR(64),
+  ADD COLUMN rolled_back_at           TIMESTAMP;
+
+CREATE UNIQUE INDEX transactions_idempotency_key_idx
+  ON transactions (idempotency_key)
+  WHERE idempotency_key IS NOT NULL;
@@ -0,0 +1,3 @@
+ALTER TABLE transactions
+  DROP COLUMN source_balance_after,
+  DROP COLUMN destination_balance_after;
@@ -0,0 +1,4 @@
+-- Balances of the involved accounts right after the transaction was applied
+ALTER TABLE transactions
+  ADD COLUMN source_balance_after      BIGINT,
+  ADD COLUMN destination_balance_after BIGINT;
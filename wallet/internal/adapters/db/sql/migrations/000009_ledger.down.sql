@@ -0,0 +1,5 @@
+DROP TABLE ledger_mismatches;
+DROP TABLE postings;
+DROP FUNCTION check_journal_entry_balanced;
+DROP TABLE journal_entries;
+DROP TABLE system_accounts;
@@ -0,0 +1,86 @@
+-- Accounts owned by the system, one of each kind per currency
+-- atm: cash entering & leaving the wallet through deposits & withdrawals
+-- opening_balance: balances that existed before the ledger was introduced
+CREATE TABLE system_accounts
+(
+  id          BIGSERIAL PRIMARY KEY NOT NULL,
+  name        VARCHAR(32)           NOT NULL,
+  currency_id BIGINT                NOT NULL REFERENCES currency (id),
+  UNIQUE (name, currency_id)
+);
+
+INSERT INTO system_accounts (name, currency_id)
+SELECT n.name, c.id
+FROM currency c
+       CROSS JOIN (VALUES ('atm'), ('opening_balance')) n(name);
+
+-- Every money movement is recorded as a journal entry with balanced postings
+CREATE TABLE journal_entries
+(
+  id             uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+  transaction_id uuid REFERENCES transactions (id) ON DELETE CASCADE,
+  description    VARCHAR(32) NOT NULL,
+  created_at     TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+-- A posting credits (positive amount) or debits (negative amount) exactly one account
+-- account_id has no foreign key so history survives account deletion
+CREATE TABLE postings
+(
+  id                BIGSERIAL PRIMARY KEY NOT NULL,
+  journal_entry_id  uuid                  NOT NULL REFERENCES journal_entries (id) ON DELETE CASCADE,
+  account_id        BIGINT,
+  system_account_id BIGINT REFERENCES system_accounts (id),
+  amount            BIGINT                NOT NULL,
+  CHECK ((account_id IS NULL) <> (system_account_id IS NULL))
+);
+
+CREATE INDEX postings_account_id_idx ON postings (account_id);
+CREATE INDEX postings_journal_entry_id_idx ON postings (journal_entry_id);
+
+-- Postings of a journal entry must sum up to zero once the db transaction commits
+CREATE FUNCTION check_journal_entry_balanced() RETURNS TRIGGER AS
+$$
+BEGIN
+  IF (SELECT COALESCE(SUM(amount), 0) FROM postings WHERE journal_entry_id = NEW.journal_entry_id) <> 0 THEN
+    RAISE EXCEPTION 'journal entry % is not balanced', NEW.journal_entry_id;
+  END IF;
+  RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE CONSTRAINT TRIGGER postings_balanced
+  AFTER INSERT OR UPDATE
+  ON postings
+  DEFERRABLE INITIALLY DEFERRED
+  FOR EACH ROW
+EXECUTE PROCEDURE check_journal_entry_balanced();
+
+-- Open the ledger with the current balances
+DO
+$$
+  DECLARE
+    a        RECORD;
+    entry_id uuid;
+  BEGIN
+    FOR a IN SELECT id, balance, currency_id FROM accounts WHERE balance <> 0
+      LOOP
+        INSERT INTO journal_entries (description) VALUES ('opening_balance') RETURNING id INTO entry_id;
+        INSERT INTO postings (journal_entry_id, account_id, amount) VALUES (entry_id, a.id, a.balance);
+        INSERT INTO postings (journal_entry_id, system_account_id, amount)
+        SELECT entry_id, sa.id, -a.balance
+        FROM system_accounts sa
+        WHERE sa.name = 'opening_balance'
+          AND sa.currency_id = a.currency_id;
+      END LOOP;
+  END
+$$;
+
+-- Accounts whose stored balance disagrees with their ledger, maintained by the reconciliation job
+CREATE TABLE ledger_mismatches
+(
+  account_id     BIGINT PRIMARY KEY NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  stored_balance BIGINT             NOT NULL,
+  ledger_balance BIGINT             NOT NULL,
+  detected_at    TIMESTAMP          NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,13 @@
+DELETE
+FROM postings
+WHERE system_account_id IN (SELECT id FROM system_accounts WHERE name = 'fx');
+DELETE
+FROM system_accounts
+WHERE name = 'fx';
+
+ALTER TABLE transactions
+  DROP COLUMN destination_amount,
+  DROP COLUMN exchange_rate,
+  DROP COLUMN quote_id;
+
+DROP TABLE exchange_quotes;
@@ -0,0 +1,25 @@
+-- Exchange rates locked for a transfer between two accounts of different currencies
+CREATE TABLE exchange_quotes
+(
+  id                     uuid PRIMARY KEY         DEFAULT uuid_generate_v4(),
+  user_id                BIGINT          NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  source_account_id      BIGINT          NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  destination_account_id BIGINT          NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  rate                   NUMERIC(24, 12) NOT NULL, -- destination currency units per one source currency unit
+  source_amount          BIGINT          NOT NULL,
+  destination_amount     BIGINT          NOT NULL,
+  expires_at             TIMESTAMP       NOT NULL,
+  used_at                TIMESTAMP,
+  created_at             TIMESTAMP       NOT NULL DEFAULT NOW()
+);
+
+-- Cross-currency transfers credit the converted amount to the destination account
+ALTER TABLE transactions
+  ADD COLUMN destination_amount BIGINT,
+  ADD COLUMN exchange_rate      NUMERIC(24, 12),
+  ADD COLUMN quote_id           uuid REFERENCES exchange_quotes (id) ON DELETE SET NULL;
+
+-- System accounts balancing the currency exchange postings
+INSERT INTO system_accounts (name, currency_id)
+SELECT 'fx', id
+FROM currency;
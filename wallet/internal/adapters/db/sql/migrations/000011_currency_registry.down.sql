@@ -0,0 +1,17 @@
+DROP TRIGGER currency_system_accounts ON currency;
+DROP FUNCTION create_currency_system_accounts();
+
+DELETE
+FROM system_accounts
+WHERE currency_id IN (SELECT id FROM currency WHERE code NOT IN ('USD', 'EGP', 'EUR', 'GBP', 'RUB'));
+DELETE
+FROM currency
+WHERE code NOT IN ('USD', 'EGP', 'EUR', 'GBP', 'RUB');
+
+ALTER TABLE currency
+  DROP COLUMN iso_number,
+  DROP COLUMN enabled,
+  DROP CONSTRAINT currency_code_key;
+
+ALTER TABLE currency
+  RENAME COLUMN code TO name;
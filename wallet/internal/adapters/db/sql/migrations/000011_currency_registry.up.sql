@@ -0,0 +1,45 @@
+-- The currency table is the registry of supported currencies
+-- code: ISO 4217 alphabetic code, iso_number: ISO 4217 numeric code
+-- enabled: whether new accounts can be opened in the currency
+ALTER TABLE currency
+  RENAME COLUMN name TO code;
+
+ALTER TABLE currency
+  ADD COLUMN iso_number SMALLINT,
+  ADD COLUMN enabled    BOOLEAN NOT NULL DEFAULT TRUE;
+
+UPDATE currency
+SET iso_number = CASE code
+                   WHEN 'USD' THEN 840
+                   WHEN 'EGP' THEN 818
+                   WHEN 'EUR' THEN 978
+                   WHEN 'GBP' THEN 826
+                   WHEN 'RUB' THEN 643
+  END;
+
+ALTER TABLE currency
+  ALTER COLUMN iso_number SET NOT NULL,
+  ADD UNIQUE (code),
+  ADD UNIQUE (iso_number);
+
+-- Every currency gets its system accounts as soon as it is registered
+CREATE FUNCTION create_currency_system_accounts() RETURNS TRIGGER AS
+$$
+BEGIN
+  INSERT INTO system_accounts (name, currency_id)
+  SELECT n.name, NEW.id
+  FROM (VALUES ('atm'), ('opening_balance'), ('fx')) n(name);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER currency_system_accounts
+  AFTER INSERT
+  ON currency
+  FOR EACH ROW
+EXECUTE FUNCTION create_currency_system_accounts();
+
+-- Registered but disabled until ops enable them
+INSERT INTO currency (code, iso_number, minor_units, enabled)
+VALUES ('CHF', 756, 2, FALSE),
+       ('JPY', 392, 0, FALSE);
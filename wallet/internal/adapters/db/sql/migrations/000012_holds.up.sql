@@ -0,0 +1,19 @@
+-- Funds reserved on an account for a later transfer to the recipient account
+-- authorized: reduces the available balance, captured: converted into a transfer
+-- voided & expired: released without moving money
+CREATE TABLE holds
+(
+  id                   uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+  account_id           BIGINT      NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  recipient_account_id BIGINT      NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  amount               BIGINT      NOT NULL CHECK (amount > 0),
+  captured_amount      BIGINT CHECK (captured_amount > 0 AND captured_amount <= amount),
+  status               VARCHAR(16) NOT NULL DEFAULT 'authorized'
+    CHECK (status IN ('authorized', 'captured', 'voided', 'expired')),
+  transaction_id       uuid REFERENCES transactions (id) ON DELETE SET NULL,
+  expires_at           TIMESTAMP   NOT NULL,
+  created_at           TIMESTAMP   NOT NULL DEFAULT NOW(),
+  updated_at           TIMESTAMP   NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX holds_authorized_account_id_idx ON holds (account_id) WHERE status = 'authorized';
@@ -0,0 +1,8 @@
+-- The 'reversal' value can't be removed from the transaction_type enum, the reversals are deleted
+DELETE
+FROM transactions
+WHERE type = 'reversal';
+
+ALTER TABLE transactions
+  DROP COLUMN reversed_transaction_id,
+  DROP COLUMN reason;
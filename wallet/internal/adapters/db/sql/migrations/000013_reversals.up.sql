@@ -0,0 +1,11 @@
+-- Reversals move money back for another transaction, possibly partially & multiple times
+-- The reversed transaction is left untouched, the reversed amount is the sum of its reversals
+ALTER TYPE transaction_type ADD VALUE 'reversal';
+
+ALTER TABLE transactions
+  ADD COLUMN reversed_transaction_id uuid REFERENCES transactions (id) ON DELETE SET NULL,
+  ADD COLUMN reason                  VARCHAR(256);
+
+CREATE INDEX transactions_reversed_transaction_id_idx
+  ON transactions (reversed_transaction_id)
+  WHERE reversed_transaction_id IS NOT NULL;
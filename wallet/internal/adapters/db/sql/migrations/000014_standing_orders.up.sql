@@ -0,0 +1,38 @@
+-- Transfers repeated on a schedule between two cards on behalf of the order's owner
+-- The schedule is either a cron expression or a day of the month, evaluated in UTC
+-- active: runs at next_run_at, completed: no run left before end_date, cancelled: deleted by its owner
+CREATE TABLE standing_orders
+(
+  id           uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+  user_id      BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  from_card    VARCHAR(64) NOT NULL,
+  to_card      VARCHAR(64) NOT NULL,
+  amount       BIGINT      NOT NULL CHECK (amount > 0),
+  currency_id  BIGINT      NOT NULL REFERENCES currency (id),
+  cron         VARCHAR(128),
+  day_of_month SMALLINT CHECK (day_of_month BETWEEN 1 AND 31),
+  end_date     TIMESTAMP,
+  next_run_at  TIMESTAMP,
+  status       VARCHAR(16) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'completed', 'cancelled')),
+  created_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+  updated_at   TIMESTAMP   NOT NULL DEFAULT NOW(),
+  CHECK ((cron IS NULL) <> (day_of_month IS NULL)),
+  CHECK (status <> 'active' OR next_run_at IS NOT NULL)
+);
+
+CREATE INDEX standing_orders_user_id_idx ON standing_orders (user_id);
+CREATE INDEX standing_orders_due_idx ON standing_orders (next_run_at) WHERE status = 'active';
+
+-- Outcome of every run of a standing order, failed runs keep the reason, e.g. insufficient funds
+CREATE TABLE standing_order_executions
+(
+  id                uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+  standing_order_id uuid        NOT NULL REFERENCES standing_orders (id) ON DELETE CASCADE,
+  scheduled_at      TIMESTAMP   NOT NULL,
+  status            VARCHAR(16) NOT NULL CHECK (status IN ('succeeded', 'failed')),
+  transaction_id    uuid REFERENCES transactions (id) ON DELETE SET NULL,
+  failure_reason    VARCHAR(256),
+  executed_at       TIMESTAMP   NOT NULL DEFAULT NOW(),
+  UNIQUE (standing_order_id, scheduled_at)
+);
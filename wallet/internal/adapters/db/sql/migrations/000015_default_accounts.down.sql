@@ -0,0 +1,4 @@
+DROP INDEX accounts_default_idx;
+
+ALTER TABLE accounts
+  DROP COLUMN is_default;
@@ -0,0 +1,5 @@
+-- The account receiving the payments addressed to its owner in its currency, see GetReceivingAccount
+ALTER TABLE accounts
+  ADD COLUMN is_default BOOLEAN NOT NULL DEFAULT false;
+
+CREATE UNIQUE INDEX accounts_default_idx ON accounts (user_id, currency_id) WHERE is_default;
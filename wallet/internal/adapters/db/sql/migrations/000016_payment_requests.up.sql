@@ -0,0 +1,24 @@
+-- Money asked by a requester to a payer, paying it transfers the amount to the requester's account
+-- pending: waiting for the payer, paid: transferred, declined by the payer, cancelled by the requester
+-- expired: not paid before expires_at
+CREATE TABLE payment_requests
+(
+  id             uuid PRIMARY KEY      DEFAULT uuid_generate_v4(),
+  requester_id   BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  payer_id       BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  to_account_id  BIGINT       NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  amount         BIGINT       NOT NULL CHECK (amount > 0),
+  currency_id    BIGINT       NOT NULL REFERENCES currency (id),
+  note           VARCHAR(256) NOT NULL DEFAULT '',
+  status         VARCHAR(16)  NOT NULL DEFAULT 'pending'
+    CHECK (status IN ('pending', 'paid', 'declined', 'cancelled', 'expired')),
+  transaction_id uuid REFERENCES transactions (id) ON DELETE SET NULL,
+  expires_at     TIMESTAMP    NOT NULL,
+  created_at     TIMESTAMP    NOT NULL DEFAULT NOW(),
+  updated_at     TIMESTAMP    NOT NULL DEFAULT NOW(),
+  CHECK (requester_id <> payer_id)
+);
+
+CREATE INDEX payment_requests_requester_id_idx ON payment_requests (requester_id);
+CREATE INDEX payment_requests_payer_id_idx ON payment_requests (payer_id);
+CREATE INDEX payment_requests_pending_expires_at_idx ON payment_requests (expires_at) WHERE status = 'pending';
@@ -0,0 +1,4 @@
+ALTER TABLE payment_requests
+  DROP COLUMN split_id;
+
+DROP TABLE splits;
@@ -0,0 +1,21 @@
+-- A bill paid by a requester & split between other users, each participant's share is a payment request of the split
+-- method: how the shares were computed, equal, by percentage or by explicit amounts
+-- amount is the whole bill, the requester's own share is the part of it not requested to any participant
+CREATE TABLE splits
+(
+  id            uuid PRIMARY KEY      DEFAULT uuid_generate_v4(),
+  requester_id  BIGINT       NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  to_account_id BIGINT       NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  amount        BIGINT       NOT NULL CHECK (amount > 0),
+  currency_id   BIGINT       NOT NULL REFERENCES currency (id),
+  method        VARCHAR(16)  NOT NULL CHECK (method IN ('equal', 'percentage', 'amount')),
+  note          VARCHAR(256) NOT NULL DEFAULT '',
+  created_at    TIMESTAMP    NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX splits_requester_id_idx ON splits (requester_id);
+
+ALTER TABLE payment_requests
+  ADD COLUMN split_id uuid REFERENCES splits (id) ON DELETE CASCADE;
+
+CREATE INDEX payment_requests_split_id_idx ON payment_requests (split_id) WHERE split_id IS NOT NULL;
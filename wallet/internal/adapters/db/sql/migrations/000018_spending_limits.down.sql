@@ -0,0 +1,7 @@
+DROP TABLE spending_limits;
+
+DROP INDEX transactions_spending_account_id_created_at_idx;
+DROP INDEX transactions_card_number_created_at_idx;
+
+ALTER TABLE transactions
+  DROP COLUMN card_number;
@@ -0,0 +1,26 @@
+-- The card used to create a transaction, a card's spending is the sum of its transactions
+ALTER TABLE transactions
+  ADD COLUMN card_number VARCHAR(64);
+
+CREATE INDEX transactions_card_number_created_at_idx
+  ON transactions (card_number, created_at)
+  WHERE card_number IS NOT NULL;
+CREATE INDEX transactions_spending_account_id_created_at_idx
+  ON transactions (coalesce(source_account_id, destination_account_id), created_at);
+
+-- Limits of the amounts moved by an account or by one of its cards, per transaction type
+-- Deposits count on the destination account, other types on the source account, NULL bounds are unlimited
+CREATE TABLE spending_limits
+(
+  id               BIGSERIAL PRIMARY KEY,
+  account_id       BIGINT REFERENCES accounts (id) ON DELETE CASCADE,
+  card_number      VARCHAR(64) REFERENCES cards (number) ON DELETE CASCADE,
+  transaction_type transaction_type NOT NULL,
+  per_transaction  BIGINT CHECK (per_transaction > 0),
+  daily            BIGINT CHECK (daily > 0),
+  monthly          BIGINT CHECK (monthly > 0),
+  updated_at       TIMESTAMP        NOT NULL DEFAULT NOW(),
+  CHECK ((account_id IS NULL) <> (card_number IS NULL)),
+  UNIQUE (account_id, transaction_type),
+  UNIQUE (card_number, transaction_type)
+);
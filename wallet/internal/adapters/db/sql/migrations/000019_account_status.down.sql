@@ -0,0 +1,4 @@
+ALTER TABLE accounts
+  DROP COLUMN closed_at,
+  DROP COLUMN frozen_by_ops,
+  DROP COLUMN status;
@@ -0,0 +1,8 @@
+-- Accounts are closed instead of deleted so that their transactions keep referencing them
+-- Frozen accounts only receive money, closed accounts neither send nor receive & hold no money
+ALTER TABLE accounts
+  ADD COLUMN status        VARCHAR(16) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'frozen', 'closed')),
+  ADD COLUMN frozen_by_ops BOOLEAN     NOT NULL DEFAULT false,
+  ADD COLUMN closed_at     TIMESTAMP,
+  ADD CHECK (status <> 'closed' OR (balance = 0 AND NOT is_default AND closed_at IS NOT NULL));
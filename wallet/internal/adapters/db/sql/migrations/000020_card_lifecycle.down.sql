@@ -0,0 +1,7 @@
+ALTER TABLE cards
+  DROP COLUMN created_at,
+  DROP COLUMN replaced_by,
+  DROP COLUMN expires_at,
+  DROP COLUMN cvv_hash,
+  DROP COLUMN nickname,
+  DROP COLUMN status;
@@ -0,0 +1,13 @@
+-- Cards are blocked or reported lost instead of deleted, a replaced card points to the card issued in its place
+-- Expired cards are the active ones past `expires_at`, cards issued before get no CVV & expire in 4 years
+ALTER TABLE cards
+  ADD COLUMN status      VARCHAR(16) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'blocked', 'lost')),
+  ADD COLUMN nickname    VARCHAR(64),
+  ADD COLUMN cvv_hash    VARCHAR(255),
+  ADD COLUMN expires_at  TIMESTAMP   NOT NULL DEFAULT date_trunc('month', NOW()) + INTERVAL '4 years 1 month',
+  ADD COLUMN replaced_by VARCHAR(64) REFERENCES cards (number) ON DELETE SET NULL,
+  ADD COLUMN created_at  TIMESTAMP   NOT NULL DEFAULT NOW();
+
+ALTER TABLE cards
+  ALTER COLUMN expires_at DROP DEFAULT;
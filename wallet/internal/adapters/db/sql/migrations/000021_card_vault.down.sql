@@ -0,0 +1,15 @@
+DROP TABLE card_reveals;
+
+ALTER TABLE cards
+  DROP CONSTRAINT cards_replaced_by_fkey,
+  ADD CONSTRAINT cards_replaced_by_fkey
+    FOREIGN KEY (replaced_by) REFERENCES cards (number) ON DELETE SET NULL;
+
+ALTER TABLE spending_limits
+  DROP CONSTRAINT spending_limits_card_number_fkey,
+  ADD CONSTRAINT spending_limits_card_number_fkey
+    FOREIGN KEY (card_number) REFERENCES cards (number) ON DELETE CASCADE;
+
+ALTER TABLE cards
+  DROP COLUMN number_data_key,
+  DROP COLUMN number_ciphertext;
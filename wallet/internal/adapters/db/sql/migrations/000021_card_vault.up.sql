@@ -0,0 +1,24 @@
+-- Cards are referenced by their token, the number is only stored encrypted with its own data key
+-- Cards created before are tokenized & encrypted by the service on startup, tokens cascade to the rows referencing them
+ALTER TABLE cards
+  ADD COLUMN number_ciphertext BYTEA,
+  ADD COLUMN number_data_key   BYTEA;
+
+ALTER TABLE spending_limits
+  DROP CONSTRAINT spending_limits_card_number_fkey,
+  ADD CONSTRAINT spending_limits_card_number_fkey
+    FOREIGN KEY (card_number) REFERENCES cards (number) ON UPDATE CASCADE ON DELETE CASCADE;
+
+ALTER TABLE cards
+  DROP CONSTRAINT cards_replaced_by_fkey,
+  ADD CONSTRAINT cards_replaced_by_fkey
+    FOREIGN KEY (replaced_by) REFERENCES cards (number) ON UPDATE CASCADE ON DELETE SET NULL;
+
+-- Every reveal of a card's full number
+CREATE TABLE card_reveals
+(
+  id          BIGSERIAL PRIMARY KEY,
+  card_number VARCHAR(64) NOT NULL REFERENCES cards (number) ON UPDATE CASCADE ON DELETE CASCADE,
+  user_id     BIGINT      NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+  revealed_at TIMESTAMP   NOT NULL DEFAULT NOW()
+);
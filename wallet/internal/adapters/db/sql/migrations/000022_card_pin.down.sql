@@ -0,0 +1,4 @@
+ALTER TABLE cards
+  DROP COLUMN pin_locked_until,
+  DROP COLUMN pin_failed_attempts,
+  DROP COLUMN pin_hash;
@@ -0,0 +1,5 @@
+-- Cards PINs are only stored hashed, failed attempts in a row lock the PIN until `pin_locked_until`
+ALTER TABLE cards
+  ADD COLUMN pin_hash            VARCHAR(255),
+  ADD COLUMN pin_failed_attempts INT NOT NULL DEFAULT 0 CHECK (pin_failed_attempts >= 0),
+  ADD COLUMN pin_locked_until    TIMESTAMP;
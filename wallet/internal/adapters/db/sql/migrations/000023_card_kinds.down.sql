@@ -0,0 +1,13 @@
+DROP TRIGGER holds_block_single_use_card ON holds;
+DROP TRIGGER transactions_block_single_use_card ON transactions;
+DROP FUNCTION block_single_use_card();
+
+DROP INDEX holds_authorized_card_number_idx;
+
+ALTER TABLE holds
+  DROP COLUMN card_number;
+
+ALTER TABLE cards
+  DROP COLUMN locked_to_account_id,
+  DROP COLUMN spending_cap,
+  DROP COLUMN kind;
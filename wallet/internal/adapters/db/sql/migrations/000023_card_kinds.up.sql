@@ -0,0 +1,39 @@
+-- Virtual cards have no physical counterpart, single-use cards are blocked by their first debit or hold
+-- A card can be capped to a total amount debited over its life & locked to a single recipient account
+ALTER TABLE cards
+  ADD COLUMN kind                 VARCHAR(16) NOT NULL DEFAULT 'physical'
+    CHECK (kind IN ('physical', 'virtual', 'single_use')),
+  ADD COLUMN spending_cap         BIGINT CHECK (spending_cap > 0),
+  ADD COLUMN locked_to_account_id BIGINT REFERENCES accounts (id);
+
+-- The card authorizing a hold, the hold's capture is a debit of the card
+ALTER TABLE holds
+  ADD COLUMN card_number VARCHAR(64) REFERENCES cards (number) ON UPDATE CASCADE ON DELETE SET NULL;
+
+CREATE INDEX holds_authorized_card_number_idx ON holds (card_number) WHERE status = 'authorized';
+
+CREATE FUNCTION block_single_use_card() RETURNS TRIGGER AS
+$$
+BEGIN
+  UPDATE cards
+  SET status = 'blocked'
+  WHERE number = NEW.card_number
+    AND kind = 'single_use'
+    AND status = 'active';
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER transactions_block_single_use_card
+  AFTER INSERT
+  ON transactions
+  FOR EACH ROW
+  WHEN (NEW.card_number IS NOT NULL AND NEW.type IN ('transfer', 'withdrawal'))
+EXECUTE FUNCTION block_single_use_card();
+
+CREATE TRIGGER holds_block_single_use_card
+  AFTER INSERT
+  ON holds
+  FOR EACH ROW
+  WHEN (NEW.card_number IS NOT NULL)
+EXECUTE FUNCTION block_single_use_card();
@@ -0,0 +1,2 @@
+DROP INDEX transactions_destination_account_id_created_at_id_idx;
+DROP INDEX transactions_source_account_id_created_at_id_idx;
@@ -0,0 +1,5 @@
+-- History pages are keyed by (created_at, id) on each side of the viewing account
+CREATE INDEX transactions_source_account_id_created_at_id_idx
+  ON transactions (source_account_id, created_at DESC, id DESC);
+CREATE INDEX transactions_destination_account_id_created_at_id_idx
+  ON transactions (destination_account_id, created_at DESC, id DESC);
@@ -0,0 +1,4 @@
+DROP FUNCTION suggest_transaction_category;
+DROP TABLE category_rules;
+DROP TABLE transaction_tags;
+DROP TABLE transaction_categories;
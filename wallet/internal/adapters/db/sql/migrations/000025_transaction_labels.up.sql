@@ -0,0 +1,68 @@
+-- Categories & tags set by the owner of an account on the transactions of the account, private to the account
+CREATE TABLE transaction_categories
+(
+  transaction_id UUID        NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+  account_id     BIGINT      NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  category       VARCHAR(32) NOT NULL,
+  PRIMARY KEY (transaction_id, account_id)
+);
+
+CREATE TABLE transaction_tags
+(
+  transaction_id UUID        NOT NULL REFERENCES transactions (id) ON DELETE CASCADE,
+  account_id     BIGINT      NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  tag            VARCHAR(32) NOT NULL,
+  PRIMARY KEY (transaction_id, account_id, tag)
+);
+
+CREATE INDEX transaction_tags_account_id_tag_idx ON transaction_tags (account_id, tag);
+
+-- Rules suggesting the category of the transactions without one, the first matching rule by priority wins
+-- A rule matches a transaction type, a counterparty account name pattern (ILIKE) or both
+CREATE TABLE category_rules
+(
+  id                   SERIAL PRIMARY KEY,
+  transaction_type     transaction_type,
+  counterparty_pattern VARCHAR(64),
+  category             VARCHAR(32) NOT NULL,
+  priority             INT         NOT NULL,
+  CHECK (transaction_type IS NOT NULL OR counterparty_pattern IS NOT NULL)
+);
+
+INSERT INTO category_rules (transaction_type, counterparty_pattern, category, priority)
+VALUES ('deposit', NULL, 'income', 10),
+       ('withdrawal', NULL, 'cash', 10),
+       ('reversal', NULL, 'refunds', 10),
+       (NULL, '%salary%', 'income', 20),
+       (NULL, '%payroll%', 'income', 20),
+       (NULL, '%rent%', 'housing', 20),
+       (NULL, '%landlord%', 'housing', 20),
+       (NULL, '%market%', 'groceries', 20),
+       (NULL, '%grocer%', 'groceries', 20),
+       (NULL, '%restaurant%', 'dining', 20),
+       (NULL, '%cafe%', 'dining', 20),
+       (NULL, '%pizza%', 'dining', 20),
+       (NULL, '%taxi%', 'transport', 20),
+       (NULL, '%uber%', 'transport', 20),
+       (NULL, '%metro%', 'transport', 20),
+       (NULL, '%fuel%', 'transport', 20),
+       (NULL, '%electric%', 'bills', 20),
+       (NULL, '%internet%', 'bills', 20),
+       (NULL, '%phone%', 'bills', 20),
+       (NULL, '%pharma%', 'health', 20),
+       (NULL, '%clinic%', 'health', 20),
+       (NULL, '%cinema%', 'entertainment', 20),
+       (NULL, '%shop%', 'shopping', 20),
+       (NULL, '%store%', 'shopping', 20),
+       (NULL, '%saving%', 'savings', 20),
+       ('transfer', NULL, 'transfers', 30);
+
+CREATE FUNCTION suggest_transaction_category(t transaction_type, counterparty VARCHAR) RETURNS VARCHAR AS
+$$
+SELECT coalesce((SELECT r.category
+                 FROM category_rules r
+                 WHERE (r.transaction_type IS NULL OR r.transaction_type = t)
+                   AND (r.counterparty_pattern IS NULL OR counterparty ILIKE r.counterparty_pattern)
+                 ORDER BY r.priority, r.id
+                 LIMIT 1), 'other');
+$$ LANGUAGE sql STABLE;
@@ -0,0 +1,14 @@
+-- Monthly budgets of the accounts, for the whole spending of the account (empty category) or for one category
+-- alerted_month & alerted_threshold track the highest threshold (percent of the amount) the owner was alerted about
+-- within a month, so that each threshold is notified once per month
+CREATE TABLE budgets
+(
+  account_id        BIGINT      NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  category          VARCHAR(32) NOT NULL DEFAULT '',
+  amount            BIGINT      NOT NULL CHECK (amount > 0),
+  alerted_month     TIMESTAMP,
+  alerted_threshold INT         NOT NULL DEFAULT 0,
+  created_at        TIMESTAMP   NOT NULL DEFAULT NOW(),
+  updated_at        TIMESTAMP   NOT NULL DEFAULT NOW(),
+  PRIMARY KEY (account_id, category)
+);
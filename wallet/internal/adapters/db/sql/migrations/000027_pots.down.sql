@@ -0,0 +1,32 @@
+-- The money in pots goes back to their accounts
+UPDATE accounts a
+SET balance = a.balance + p.balance
+FROM (SELECT account_id, sum(balance) as balance FROM pots GROUP BY account_id) p
+WHERE a.id = p.account_id;
+
+-- The 'pot' value can't be removed from the transaction_type enum, the pot transactions & their postings are deleted
+DELETE
+FROM transactions
+WHERE type = 'pot';
+
+DELETE
+FROM postings
+WHERE system_account_id IN (SELECT id FROM system_accounts WHERE name = 'pots');
+DELETE
+FROM system_accounts
+WHERE name = 'pots';
+
+CREATE OR REPLACE FUNCTION create_currency_system_accounts() RETURNS TRIGGER AS
+$$
+BEGIN
+  INSERT INTO system_accounts (name, currency_id)
+  SELECT n.name, NEW.id
+  FROM (VALUES ('atm'), ('opening_balance'), ('fx')) n(name);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+ALTER TABLE transactions
+  DROP COLUMN pot_id;
+
+DROP TABLE pots;
@@ -0,0 +1,39 @@
+-- Pots set money aside within an account, in the account's currency
+-- The money moved into a pot leaves the account's balance until it's moved back, pots are deleted once empty
+CREATE TABLE pots
+(
+  id            uuid PRIMARY KEY     DEFAULT uuid_generate_v4(),
+  account_id    BIGINT      NOT NULL REFERENCES accounts (id) ON DELETE CASCADE,
+  name          VARCHAR(32) NOT NULL,
+  balance       BIGINT      NOT NULL DEFAULT 0 CHECK (balance >= 0),
+  target_amount BIGINT CHECK (target_amount > 0),
+  target_date   DATE,
+  created_at    TIMESTAMP   NOT NULL DEFAULT NOW(),
+  updated_at    TIMESTAMP   NOT NULL DEFAULT NOW(),
+  deleted_at    TIMESTAMP,
+  CHECK (deleted_at IS NULL OR balance = 0)
+);
+
+CREATE UNIQUE INDEX pots_account_id_name_idx ON pots (account_id, name) WHERE deleted_at IS NULL;
+
+-- Moves between an account & its pots, the account is the source when money moves into the pot
+-- & the destination when it moves back
+ALTER TYPE transaction_type ADD VALUE 'pot';
+
+ALTER TABLE transactions
+  ADD COLUMN pot_id uuid REFERENCES pots (id) ON DELETE SET NULL;
+
+-- System accounts balancing the postings of the money set aside in pots
+INSERT INTO system_accounts (name, currency_id)
+SELECT 'pots', id
+FROM currency;
+
+CREATE OR REPLACE FUNCTION create_currency_system_accounts() RETURNS TRIGGER AS
+$$
+BEGIN
+  INSERT INTO system_accounts (name, currency_id)
+  SELECT n.name, NEW.id
+  FROM (VALUES ('atm'), ('opening_balance'), ('fx'), ('pots')) n(name);
+  RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -4,29 +4,88 @@ VALUES ($1, $2, 0, $3)
 RETURNING id;
 
 -- name: GetAccount :one
-SELECT a.id, a.user_id, a.name, a.balance, a.currency_id, c.name as currency_name
+SELECT a.id, a.user_id, a.name, a.balance, a.currency_id, c.code as currency_name, c.minor_units,
+       (a.balance - coalesce((SELECT sum(h.amount)
+                             FROM holds h
+                             WHERE h.account_id = a.id
+                               AND h.status = 'authorized'
+                               AND h.expires_at > NOW()), 0))::BIGINT as available_balance,
+       a.is_default, a.status, a.frozen_by_ops, a.closed_at
 FROM accounts a
        JOIN currency c on a.currency_id = c.id
 WHERE a.id = $1
 LIMIT 1;
 
+-- name: GetAccountBalanceAt :one
+SELECT (a.balance
+  - (SELECT coalesce(sum(coalesce(t.destination_amount, t.amount)), 0)
+     FROM transactions t
+     WHERE t.destination_account_id = a.id
+       AND t.created_at >= sqlc.arg('at'))
+  + (SELECT coalesce(sum(t.amount), 0)
+     FROM transactions t
+     WHERE t.source_account_id = a.id
+       AND t.created_at >= sqlc.arg('at')))::BIGINT as balance
+FROM accounts a
+WHERE a.id = sqlc.arg('id');
+
 -- name: GetAccounts :many
-SELECT a.id, a.user_id, a.name, a.balance, a.currency_id, c.name as currency_name
+SELECT a.id, a.user_id, a.name, a.balance, a.currency_id, c.code as currency_name, c.minor_units,
+       (a.balance - coalesce((SELECT sum(h.amount)
+                             FROM holds h
+                             WHERE h.account_id = a.id
+                               AND h.status = 'authorized'
+                               AND h.expires_at > NOW()), 0))::BIGINT as available_balance,
+       a.is_default, a.status, a.frozen_by_ops, a.closed_at
 FROM accounts a
        JOIN currency c on a.currency_id = c.id
 WHERE a.user_id = $1;
 
--- name: AddAccountBalance :exec
+-- name: GetReceivingAccount :one
+SELECT a.id
+FROM accounts a
+       JOIN users u on a.user_id = u.id
+       JOIN currency c on a.currency_id = c.id
+WHERE u.external_id = $1
+  AND c.code = $2
+  AND a.status <> 'closed'
+ORDER BY a.is_default DESC, a.id
+LIMIT 1;
+
+-- name: ClearDefaultAccount :exec
 UPDATE accounts
-SET balance = balance + $2
+SET is_default = false
+WHERE user_id = $1
+  AND currency_id = $2
+  AND is_default;
+
+-- name: SetDefaultAccount :exec
+UPDATE accounts
+SET is_default = true
 WHERE id = $1;
 
--- name: SubAccountBalance :exec
+-- name: AddAccountBalance :one
+UPDATE accounts
+SET balance = balance + $2
+WHERE id = $1
+RETURNING balance;
+
+-- name: SubAccountBalance :one
 UPDATE accounts
 SET balance = balance - $2
+WHERE id = $1
+  AND balance >= $2
+RETURNING balance;
+
+-- name: SetAccountStatus :exec
+UPDATE accounts
+SET status        = $2,
+    frozen_by_ops = $3
 WHERE id = $1;
 
--- name: DeleteAccount :exec
-DELETE
-FROM accounts
+-- name: CloseAccount :exec
+UPDATE accounts
+SET status     = 'closed',
+    is_default = false,
+    closed_at  = NOW()
 WHERE id = $1;
@@ -0,0 +1,39 @@
+-- name: SetBudget :one
+INSERT INTO budgets (account_id, category, amount)
+VALUES ($1, $2, $3)
+ON CONFLICT (account_id, category) DO UPDATE
+  SET amount            = excluded.amount,
+      alerted_threshold = CASE WHEN budgets.amount = excluded.amount THEN budgets.alerted_threshold ELSE 0 END,
+      updated_at        = NOW()
+RETURNING *;
+
+-- name: DeleteBudget :execrows
+DELETE
+FROM budgets
+WHERE account_id = $1
+  AND category = $2;
+
+-- name: GetAccountBudgets :many
+SELECT *
+FROM budgets
+WHERE account_id = $1
+ORDER BY category;
+
+-- name: GetBudgets :many
+SELECT b.*
+FROM budgets b
+WHERE b.account_id IN (SELECT a.id
+                       FROM accounts a
+                       WHERE a.id > sqlc.arg('after_account_id')
+                         AND a.status != 'closed'
+                         AND EXISTS (SELECT 1 FROM budgets ab WHERE ab.account_id = a.id)
+                       ORDER BY a.id
+                       LIMIT sqlc.arg('accounts_limit'))
+ORDER BY b.account_id, b.category;
+
+-- name: SetBudgetAlerted :exec
+UPDATE budgets
+SET alerted_month     = $1,
+    alerted_threshold = $2
+WHERE account_id = $3
+  AND category = $4;
@@ -1,6 +1,7 @@
 -- name: CreateCard :exec
-INSERT INTO cards (number, account_id)
-VALUES ($1, $2);
+INSERT INTO cards (number, account_id, nickname, cvv_hash, expires_at, number_ciphertext, number_data_key, kind,
+                   spending_cap, locked_to_account_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10);
 
 -- name: GetCard :one
 SELECT *
@@ -10,10 +11,17 @@ WHERE number = $1;
 -- name: GetAccountCards :many
 SELECT *
 FROM cards
-WHERE account_id = $1;
+WHERE account_id = $1
+ORDER BY created_at;
 
 -- name: GetCardAccount :one
-SELECT a.id as id, a.user_id as owner_id, a.name, a.balance, cc.name as currency
+SELECT a.id as id, a.user_id as owner_id, a.name, a.balance, cc.code as currency, cc.minor_units,
+       (a.balance - coalesce((SELECT sum(h.amount)
+                             FROM holds h
+                             WHERE h.account_id = a.id
+                               AND h.status = 'authorized'
+                               AND h.expires_at > NOW()), 0))::BIGINT as available_balance,
+       a.status, a.frozen_by_ops
 FROM cards c
        JOIN accounts a on a.id = c.account_id
        JOIN currency cc on a.currency_id = cc.id
@@ -33,6 +41,67 @@ WHERE id = (SELECT account_id
             FROM cards
             WHERE number = $1);
 
+-- name: GetCardDebited :one
+SELECT ((SELECT coalesce(sum(t.amount), 0)
+         FROM transactions t
+         WHERE t.card_number = sqlc.arg('card_number')::VARCHAR
+           AND t.type IN ('transfer', 'withdrawal')) +
+        (SELECT coalesce(sum(h.amount), 0)
+         FROM holds h
+         WHERE h.card_number = sqlc.arg('card_number')
+           AND h.status = 'authorized'
+           AND h.expires_at > NOW()))::BIGINT as debited;
+
+-- name: SetCardStatus :exec
+UPDATE cards
+SET status = $2
+WHERE number = $1;
+
+-- name: SetCardNickname :exec
+UPDATE cards
+SET nickname = $2
+WHERE number = $1;
+
+-- name: RetireCard :exec
+UPDATE cards
+SET status      = CASE WHEN status = 'lost' THEN 'lost' ELSE 'blocked' END,
+    replaced_by = $2
+WHERE number = $1;
+
+-- name: SetCardPin :exec
+UPDATE cards
+SET pin_hash            = $2,
+    pin_failed_attempts = 0,
+    pin_locked_until    = NULL
+WHERE number = $1;
+
+-- name: SetCardPinAttempts :exec
+UPDATE cards
+SET pin_failed_attempts = $2,
+    pin_locked_until    = $3
+WHERE number = $1;
+
+-- name: GetUnvaultedCards :many
+SELECT number
+FROM cards
+WHERE number_ciphertext IS NULL;
+
+-- name: GetVaultedCards :many
+SELECT number, number_ciphertext, number_data_key
+FROM cards
+WHERE number_ciphertext IS NOT NULL;
+
+-- name: VaultCard :exec
+UPDATE cards
+SET number            = sqlc.arg('token'),
+    number_ciphertext = sqlc.arg('number_ciphertext'),
+    number_data_key   = sqlc.arg('number_data_key')
+WHERE number = sqlc.arg('number');
+
+-- name: CreateCardReveal :exec
+INSERT INTO card_reveals (card_number, user_id)
+VALUES ($1, $2);
+
 -- name: DeleteCard :exec
 DELETE
 FROM cards
@@ -4,8 +4,13 @@ FROM currency
 WHERE id = $1
 LIMIT 1;
 
--- name: GetCurrencyByName :one
-SELECT id
+-- name: GetCurrencyByCode :one
+SELECT *
 FROM currency
-WHERE name = $1
+WHERE code = $1
 LIMIT 1;
+
+-- name: GetCurrencies :many
+SELECT *
+FROM currency
+ORDER BY code;
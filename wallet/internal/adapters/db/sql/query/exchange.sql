@@ -0,0 +1,17 @@
+-- name: CreateQuote :one
+INSERT INTO exchange_quotes (user_id, source_account_id, destination_account_id, rate, source_amount,
+                             destination_amount, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetQuote :one
+SELECT *
+FROM exchange_quotes
+WHERE id = $1;
+
+-- name: UseQuote :one
+UPDATE exchange_quotes
+SET used_at = NOW()
+WHERE id = $1
+  AND used_at IS NULL
+RETURNING id;
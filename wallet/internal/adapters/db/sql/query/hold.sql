@@ -0,0 +1,39 @@
+-- name: CreateHold :one
+INSERT INTO holds (account_id, recipient_account_id, amount, expires_at, card_number)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetHold :one
+SELECT *
+FROM holds
+WHERE id = $1;
+
+-- name: CaptureHold :one
+UPDATE holds
+SET status          = 'captured',
+    captured_amount = $2,
+    updated_at      = NOW()
+WHERE id = $1
+  AND status = 'authorized'
+  AND expires_at > NOW()
+RETURNING *;
+
+-- name: SetHoldTransaction :exec
+UPDATE holds
+SET transaction_id = $2
+WHERE id = $1;
+
+-- name: VoidHold :one
+UPDATE holds
+SET status     = 'voided',
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'authorized'
+RETURNING id;
+
+-- name: ExpireHolds :execrows
+UPDATE holds
+SET status     = 'expired',
+    updated_at = NOW()
+WHERE status = 'authorized'
+  AND expires_at <= NOW();
@@ -0,0 +1,33 @@
+-- name: CreateJournalEntry :one
+INSERT INTO journal_entries (transaction_id, description)
+VALUES ($1, $2)
+RETURNING id;
+
+-- name: CreatePosting :exec
+INSERT INTO postings (journal_entry_id, account_id, system_account_id, amount)
+VALUES ($1, $2, $3, $4);
+
+-- name: GetAccountSystemAccount :one
+SELECT sa.id
+FROM system_accounts sa
+       JOIN accounts a on a.currency_id = sa.currency_id
+WHERE sa.name = $1
+  AND a.id = $2;
+
+-- name: DeleteResolvedLedgerMismatches :exec
+DELETE
+FROM ledger_mismatches m
+  USING accounts a
+WHERE a.id = m.account_id
+  AND a.balance = (SELECT COALESCE(SUM(p.amount), 0) FROM postings p WHERE p.account_id = a.id);
+
+-- name: FlagLedgerMismatches :many
+INSERT INTO ledger_mismatches (account_id, stored_balance, ledger_balance)
+SELECT a.id, a.balance, COALESCE(SUM(p.amount), 0)::BIGINT
+FROM accounts a
+       LEFT JOIN postings p on p.account_id = a.id
+GROUP BY a.id
+HAVING a.balance <> COALESCE(SUM(p.amount), 0)
+ON CONFLICT (account_id) DO UPDATE SET stored_balance = excluded.stored_balance,
+                                       ledger_balance = excluded.ledger_balance
+RETURNING account_id, stored_balance, ledger_balance, detected_at;
@@ -0,0 +1,69 @@
+-- name: GetAccountLimits :many
+SELECT *
+FROM spending_limits
+WHERE account_id = $1
+ORDER BY transaction_type;
+
+-- name: GetCardLimits :many
+SELECT *
+FROM spending_limits
+WHERE card_number = $1
+ORDER BY transaction_type;
+
+-- name: GetTransactionLimits :many
+SELECT *
+FROM spending_limits
+WHERE transaction_type = $1
+  AND (account_id = $2 OR card_number = $3);
+
+-- name: SetAccountLimit :exec
+INSERT INTO spending_limits (account_id, transaction_type, per_transaction, daily, monthly)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (account_id, transaction_type) DO UPDATE
+  SET per_transaction = excluded.per_transaction,
+      daily           = excluded.daily,
+      monthly         = excluded.monthly,
+      updated_at      = NOW();
+
+-- name: SetCardLimit :exec
+INSERT INTO spending_limits (card_number, transaction_type, per_transaction, daily, monthly)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (card_number, transaction_type) DO UPDATE
+  SET per_transaction = excluded.per_transaction,
+      daily           = excluded.daily,
+      monthly         = excluded.monthly,
+      updated_at      = NOW();
+
+-- name: CopyCardLimits :exec
+INSERT INTO spending_limits (card_number, transaction_type, per_transaction, daily, monthly)
+SELECT sqlc.arg('to_card')::VARCHAR, transaction_type, per_transaction, daily, monthly
+FROM spending_limits
+WHERE card_number = sqlc.arg('from_card');
+
+-- name: GetAccountSpending :one
+SELECT (coalesce((SELECT sum(t.amount)
+                  FROM transactions t
+                  WHERE t.type = sqlc.arg('transaction_type')
+                    AND coalesce(t.source_account_id, t.destination_account_id) = sqlc.arg('account_id')::BIGINT
+                    AND t.created_at >= sqlc.arg('since')), 0) +
+        coalesce((SELECT sum(h.amount)
+                  FROM holds h
+                  WHERE sqlc.arg('transaction_type') = 'transfer'
+                    AND h.account_id = sqlc.arg('account_id')::BIGINT
+                    AND h.status = 'authorized'
+                    AND h.expires_at > NOW()
+                    AND h.created_at >= sqlc.arg('since')), 0))::BIGINT as total;
+
+-- name: GetCardSpending :one
+SELECT (coalesce((SELECT sum(t.amount)
+                  FROM transactions t
+                  WHERE t.type = sqlc.arg('transaction_type')
+                    AND t.card_number = sqlc.arg('card_number')
+                    AND t.created_at >= sqlc.arg('since')), 0) +
+        coalesce((SELECT sum(h.amount)
+                  FROM holds h
+                  WHERE sqlc.arg('transaction_type') = 'transfer'
+                    AND h.card_number = sqlc.arg('card_number')
+                    AND h.status = 'authorized'
+                    AND h.expires_at > NOW()
+                    AND h.created_at >= sqlc.arg('since')), 0))::BIGINT as total;
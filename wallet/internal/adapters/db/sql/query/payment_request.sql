@@ -0,0 +1,128 @@
+-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests (requester_id, payer_id, to_account_id, amount, currency_id, note, expires_at, split_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+RETURNING id;
+
+-- name: GetPaymentRequest :one
+SELECT pr.id,
+       pr.requester_id,
+       pr.payer_id,
+       pr.to_account_id,
+       pr.amount,
+       pr.currency_id,
+       pr.note,
+       pr.status,
+       pr.transaction_id,
+       pr.expires_at,
+       pr.created_at,
+       pr.updated_at,
+       pr.split_id,
+       r.external_id as requester_external_id,
+       p.external_id as payer_external_id,
+       c.code        as currency
+FROM payment_requests pr
+       JOIN users r on r.id = pr.requester_id
+       JOIN users p on p.id = pr.payer_id
+       JOIN currency c on c.id = pr.currency_id
+WHERE pr.id = $1;
+
+-- name: GetIncomingPaymentRequests :many
+SELECT pr.id,
+       pr.requester_id,
+       pr.payer_id,
+       pr.to_account_id,
+       pr.amount,
+       pr.currency_id,
+       pr.note,
+       pr.status,
+       pr.transaction_id,
+       pr.expires_at,
+       pr.created_at,
+       pr.updated_at,
+       pr.split_id,
+       r.external_id as requester_external_id,
+       p.external_id as payer_external_id,
+       c.code        as currency
+FROM payment_requests pr
+       JOIN users r on r.id = pr.requester_id
+       JOIN users p on p.id = pr.payer_id
+       JOIN currency c on c.id = pr.currency_id
+WHERE pr.payer_id = $1
+ORDER BY pr.created_at DESC;
+
+-- name: GetOutgoingPaymentRequests :many
+SELECT pr.id,
+       pr.requester_id,
+       pr.payer_id,
+       pr.to_account_id,
+       pr.amount,
+       pr.currency_id,
+       pr.note,
+       pr.status,
+       pr.transaction_id,
+       pr.expires_at,
+       pr.created_at,
+       pr.updated_at,
+       pr.split_id,
+       r.external_id as requester_external_id,
+       p.external_id as payer_external_id,
+       c.code        as currency
+FROM payment_requests pr
+       JOIN users r on r.id = pr.requester_id
+       JOIN users p on p.id = pr.payer_id
+       JOIN currency c on c.id = pr.currency_id
+WHERE pr.requester_id = $1
+ORDER BY pr.created_at DESC;
+
+-- name: GetSplitPaymentRequests :many
+SELECT pr.id,
+       pr.requester_id,
+       pr.payer_id,
+       pr.to_account_id,
+       pr.amount,
+       pr.currency_id,
+       pr.note,
+       pr.status,
+       pr.transaction_id,
+       pr.expires_at,
+       pr.created_at,
+       pr.updated_at,
+       pr.split_id,
+       r.external_id as requester_external_id,
+       p.external_id as payer_external_id,
+       c.code        as currency
+FROM payment_requests pr
+       JOIN users r on r.id = pr.requester_id
+       JOIN users p on p.id = pr.payer_id
+       JOIN currency c on c.id = pr.currency_id
+WHERE pr.split_id = $1
+ORDER BY pr.created_at, pr.id;
+
+-- name: PayPaymentRequest :one
+UPDATE payment_requests
+SET status     = 'paid',
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'pending'
+  AND expires_at > NOW()
+RETURNING id;
+
+-- name: SetPaymentRequestTransaction :exec
+UPDATE payment_requests
+SET transaction_id = $2
+WHERE id = $1;
+
+-- name: ClosePaymentRequest :one
+UPDATE payment_requests
+SET status     = $2,
+    updated_at = NOW()
+WHERE id = $1
+  AND status = 'pending'
+RETURNING id;
+
+-- name: ExpirePaymentRequests :execrows
+UPDATE payment_requests
+SET status     = 'expired',
+    updated_at = NOW()
+WHERE status = 'pending'
+  AND expires_at <= NOW();
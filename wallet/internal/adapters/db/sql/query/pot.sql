@@ -0,0 +1,57 @@
+-- name: CreatePot :one
+INSERT INTO pots (account_id, name, target_amount, target_date)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetPot :one
+SELECT *
+FROM pots
+WHERE id = $1
+  AND deleted_at IS NULL;
+
+-- name: GetAccountPots :many
+SELECT *
+FROM pots
+WHERE account_id = $1
+  AND deleted_at IS NULL
+ORDER BY created_at, id;
+
+-- name: GetUserPots :many
+SELECT p.*
+FROM pots p
+       JOIN accounts a on a.id = p.account_id
+WHERE a.user_id = $1
+  AND p.deleted_at IS NULL
+ORDER BY p.account_id, p.created_at, p.id;
+
+-- name: UpdatePot :one
+UPDATE pots
+SET name          = $2,
+    target_amount = $3,
+    target_date   = $4,
+    updated_at    = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+RETURNING *;
+
+-- name: DeletePot :execrows
+UPDATE pots
+SET deleted_at = NOW(),
+    updated_at = NOW()
+WHERE id = $1
+  AND deleted_at IS NULL
+  AND balance = 0;
+
+-- name: AddPotBalance :one
+UPDATE pots
+SET balance    = balance + $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING balance;
+
+-- name: SubPotBalance :one
+UPDATE pots
+SET balance    = balance - $2,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING balance;
@@ -0,0 +1,26 @@
+-- name: CreateSplit :one
+INSERT INTO splits (requester_id, to_account_id, amount, currency_id, method, note)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id;
+
+-- name: GetSplit :one
+SELECT s.id,
+       s.requester_id,
+       s.to_account_id,
+       s.amount,
+       s.currency_id,
+       s.method,
+       s.note,
+       s.created_at,
+       u.external_id as requester_external_id,
+       c.code        as currency
+FROM splits s
+       JOIN users u on u.id = s.requester_id
+       JOIN currency c on c.id = s.currency_id
+WHERE s.id = $1;
+
+-- name: GetUserSplits :many
+SELECT id
+FROM splits
+WHERE requester_id = $1
+ORDER BY created_at DESC;
@@ -0,0 +1,124 @@
+-- name: CreateStandingOrder :one
+INSERT INTO standing_orders (user_id, from_card, to_card, amount, currency_id, cron, day_of_month, end_date,
+                             next_run_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+RETURNING id;
+
+-- name: GetStandingOrder :one
+SELECT so.id,
+       so.user_id,
+       so.from_card,
+       so.to_card,
+       so.amount,
+       so.currency_id,
+       so.cron,
+       so.day_of_month,
+       so.end_date,
+       so.next_run_at,
+       so.status,
+       so.created_at,
+       so.updated_at,
+       c.code as currency
+FROM standing_orders so
+       JOIN currency c on c.id = so.currency_id
+WHERE so.id = $1;
+
+-- name: GetUserStandingOrders :many
+SELECT so.id,
+       so.user_id,
+       so.from_card,
+       so.to_card,
+       so.amount,
+       so.currency_id,
+       so.cron,
+       so.day_of_month,
+       so.end_date,
+       so.next_run_at,
+       so.status,
+       so.created_at,
+       so.updated_at,
+       c.code as currency
+FROM standing_orders so
+       JOIN currency c on c.id = so.currency_id
+WHERE so.user_id = $1
+ORDER BY so.created_at;
+
+-- name: UpdateStandingOrder :one
+UPDATE standing_orders
+SET amount       = $2,
+    cron         = $3,
+    day_of_month = $4,
+    end_date     = $5,
+    next_run_at  = $6,
+    updated_at   = NOW()
+WHERE id = $1
+  AND status = 'active'
+RETURNING id;
+
+-- name: CancelStandingOrder :one
+UPDATE standing_orders
+SET status      = 'cancelled',
+    next_run_at = NULL,
+    updated_at  = NOW()
+WHERE id = $1
+  AND status = 'active'
+RETURNING id;
+
+-- name: ReplaceStandingOrdersCard :exec
+UPDATE standing_orders
+SET from_card  = CASE WHEN from_card = sqlc.arg('old_card') THEN sqlc.arg('new_card')::VARCHAR ELSE from_card END,
+    to_card    = CASE WHEN to_card = sqlc.arg('old_card') THEN sqlc.arg('new_card')::VARCHAR ELSE to_card END,
+    updated_at = NOW()
+WHERE (from_card = sqlc.arg('old_card') OR to_card = sqlc.arg('old_card'))
+  AND status = 'active';
+
+-- name: RenameStandingOrdersCard :exec
+UPDATE standing_orders
+SET from_card = CASE WHEN from_card = sqlc.arg('old_card') THEN sqlc.arg('new_card')::VARCHAR ELSE from_card END,
+    to_card   = CASE WHEN to_card = sqlc.arg('old_card') THEN sqlc.arg('new_card')::VARCHAR ELSE to_card END
+WHERE from_card = sqlc.arg('old_card')
+   OR to_card = sqlc.arg('old_card');
+
+-- name: GetDueStandingOrders :many
+SELECT so.id,
+       so.user_id,
+       so.from_card,
+       so.to_card,
+       so.amount,
+       so.currency_id,
+       so.cron,
+       so.day_of_month,
+       so.end_date,
+       so.next_run_at,
+       so.status,
+       so.created_at,
+       so.updated_at,
+       c.code as currency,
+       u.external_id
+FROM standing_orders so
+       JOIN currency c on c.id = so.currency_id
+       JOIN users u on u.id = so.user_id
+WHERE so.status = 'active'
+  AND so.next_run_at <= $1
+ORDER BY so.next_run_at
+LIMIT $2;
+
+-- name: AdvanceStandingOrder :execrows
+UPDATE standing_orders
+SET next_run_at = sqlc.narg('next_run_at'),
+    status      = CASE WHEN sqlc.narg('next_run_at')::TIMESTAMP IS NULL THEN 'completed' ELSE status END,
+    updated_at  = NOW()
+WHERE id = sqlc.arg('id')
+  AND status = 'active'
+  AND next_run_at = sqlc.arg('scheduled_at');
+
+-- name: CreateStandingOrderExecution :one
+INSERT INTO standing_order_executions (standing_order_id, scheduled_at, status, transaction_id, failure_reason)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetStandingOrderExecutions :many
+SELECT *
+FROM standing_order_executions
+WHERE standing_order_id = $1
+ORDER BY scheduled_at DESC;
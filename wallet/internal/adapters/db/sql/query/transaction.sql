@@ -1,50 +1,220 @@
--- name: CreateTransferTransaction :exec
-INSERT INTO transactions (type, amount, source_account_id, destination_account_id)
-VALUES ('transfer', $1, $2, $3);
+-- name: CreateTransferTransaction :one
+INSERT INTO transactions (type, amount, source_account_id, destination_account_id, idempotency_key,
+                          source_balance_after, destination_balance_after, destination_amount, exchange_rate,
+                          quote_id, reason, card_number)
+VALUES ('transfer', $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id;
 
--- name: CreateDepositTransaction :exec
-INSERT INTO transactions(type, amount, destination_account_id)
-VALUES ('deposit', $1, $2);
+-- name: CreateReversalTransaction :one
+INSERT INTO transactions (type, amount, source_account_id, destination_account_id, idempotency_key,
+                          source_balance_after, destination_balance_after, destination_amount, exchange_rate,
+                          reversed_transaction_id, reason)
+VALUES ('reversal', $1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id;
 
--- name: CreateWithdrawTransaction :exec
-INSERT INTO transactions(type, amount, source_account_id)
-VALUES ('withdrawal', $1, $2);
+-- name: CreateDepositTransaction :one
+INSERT INTO transactions(type, amount, destination_account_id, idempotency_key, destination_balance_after, card_number)
+VALUES ('deposit', $1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: CreateWithdrawTransaction :one
+INSERT INTO transactions(type, amount, source_account_id, idempotency_key, source_balance_after, card_number)
+VALUES ('withdrawal', $1, $2, $3, $4, $5)
+RETURNING id;
+
+-- name: CreatePotTransaction :one
+INSERT INTO transactions(type, amount, source_account_id, destination_account_id, source_balance_after,
+                         destination_balance_after, pot_id)
+VALUES ('pot', $1, $2, $3, $4, $5, $6)
+RETURNING id;
 
 -- name: GetTransaction :one
 SELECT t.id,
        t.type,
        t.amount,
        source.id        as from_account_id,
-       source.name      as from_account_name,
+       coalesce(source.name, p.name) as from_account_name,
        destination.id   as to_account_id,
-       destination.name as to_account_name,
+       coalesce(destination.name, p.name) as to_account_name,
        t.created_at,
-       t.is_rolled_back
+       t.is_rolled_back,
+       cur.code         as currency_name,
+       t.idempotency_key,
+       t.source_balance_after,
+       t.destination_balance_after,
+       t.destination_amount,
+       t.exchange_rate,
+       dcur.code        as destination_currency_name,
+       t.reversed_transaction_id,
+       t.reason,
+       t.pot_id,
+       (SELECT coalesce(sum(coalesce(r.destination_amount, r.amount)), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_amount,
+       (SELECT coalesce(sum(r.amount), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_to_amount
 FROM transactions t
        LEFT JOIN accounts destination on destination.id = t.destination_account_id
        LEFT JOIN accounts source on source.id = t.source_account_id
+       LEFT JOIN pots p on p.id = t.pot_id
+       LEFT JOIN currency cur on cur.id = coalesce(source.currency_id, destination.currency_id)
+       LEFT JOIN currency dcur on dcur.id = destination.currency_id
 WHERE t.id = $1;
 
+-- name: GetTransactionByIdempotencyKey :one
+SELECT t.id,
+       t.type,
+       t.amount,
+       source.id        as from_account_id,
+       coalesce(source.name, p.name) as from_account_name,
+       destination.id   as to_account_id,
+       coalesce(destination.name, p.name) as to_account_name,
+       t.created_at,
+       t.is_rolled_back,
+       cur.code         as currency_name,
+       t.idempotency_key,
+       t.source_balance_after,
+       t.destination_balance_after,
+       t.destination_amount,
+       t.exchange_rate,
+       dcur.code        as destination_currency_name,
+       t.reversed_transaction_id,
+       t.reason,
+       t.pot_id,
+       (SELECT coalesce(sum(coalesce(r.destination_amount, r.amount)), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_amount,
+       (SELECT coalesce(sum(r.amount), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_to_amount
+FROM transactions t
+       LEFT JOIN accounts destination on destination.id = t.destination_account_id
+       LEFT JOIN accounts source on source.id = t.source_account_id
+       LEFT JOIN pots p on p.id = t.pot_id
+       LEFT JOIN currency cur on cur.id = coalesce(source.currency_id, destination.currency_id)
+       LEFT JOIN currency dcur on dcur.id = destination.currency_id
+WHERE t.idempotency_key = $1;
+
 -- name: GetTransactions :many
 SELECT t.id,
        t.amount,
        t.type,
-       source.name      as from_account_name,
-       destination.name as to_account_name,
+       source.id        as from_account_id,
+       coalesce(source.name, p.name) as from_account_name,
+       destination.id   as to_account_id,
+       coalesce(destination.name, p.name) as to_account_name,
        t.created_at,
-       t.is_rolled_back
+       t.is_rolled_back,
+       cur.code         as currency_name,
+       t.source_balance_after,
+       t.destination_balance_after,
+       t.destination_amount,
+       t.exchange_rate,
+       dcur.code        as destination_currency_name,
+       t.reversed_transaction_id,
+       t.reason,
+       t.pot_id,
+       (SELECT coalesce(sum(coalesce(r.destination_amount, r.amount)), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_amount,
+       (SELECT coalesce(sum(r.amount), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_to_amount,
+       tc.category,
+       suggest_transaction_category(t.type, CASE
+                                              WHEN source.id = sqlc.arg('account_id') THEN coalesce(destination.name, p.name)
+                                              ELSE coalesce(source.name, p.name) END)::VARCHAR as category_suggested,
+       (SELECT coalesce(array_agg(tt.tag ORDER BY tt.tag), '{}')
+        FROM transaction_tags tt
+        WHERE tt.transaction_id = t.id
+          AND tt.account_id = sqlc.arg('account_id'))::VARCHAR[] as tags
 FROM transactions t
        LEFT JOIN accounts destination on destination.id = t.destination_account_id
        LEFT JOIN accounts source on source.id = t.source_account_id
+       LEFT JOIN pots p on p.id = t.pot_id
+       LEFT JOIN currency cur on cur.id = coalesce(source.currency_id, destination.currency_id)
+       LEFT JOIN currency dcur on dcur.id = destination.currency_id
+       LEFT JOIN transaction_categories tc on tc.transaction_id = t.id AND tc.account_id = sqlc.arg('account_id')
 WHERE (source.id = sqlc.arg('account_id')
   OR destination.id = sqlc.arg('account_id'))
---   AND coalesce(sqlc.narg('transaction_type') IS NULL, t.type) = t.type
+  AND (sqlc.narg('transaction_type')::transaction_type IS NULL OR t.type = sqlc.narg('transaction_type'))
   AND coalesce(sqlc.narg('min_amount'), t.amount) <= t.amount
   AND coalesce(sqlc.narg('max_amount'), t.amount) >= t.amount
-ORDER BY created_at DESC
-LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+  AND (sqlc.narg('from_time')::TIMESTAMP IS NULL OR t.created_at >= sqlc.narg('from_time'))
+  AND (sqlc.narg('to_time')::TIMESTAMP IS NULL OR t.created_at < sqlc.narg('to_time'))
+  AND (sqlc.narg('direction')::VARCHAR IS NULL
+  OR (sqlc.narg('direction') = 'incoming' AND destination.id = sqlc.arg('account_id'))
+  OR (sqlc.narg('direction') = 'outgoing' AND source.id = sqlc.arg('account_id')))
+  AND (sqlc.narg('counterparty')::VARCHAR IS NULL
+  OR strpos(lower(CASE
+                    WHEN source.id = sqlc.arg('account_id') THEN coalesce(destination.name, p.name, 'ATM')
+                    ELSE coalesce(source.name, p.name, 'ATM') END), lower(sqlc.narg('counterparty'))) > 0)
+  AND (sqlc.narg('category')::VARCHAR IS NULL
+  OR coalesce(tc.category, suggest_transaction_category(t.type, CASE
+                                                                  WHEN source.id = sqlc.arg('account_id')
+                                                                    THEN coalesce(destination.name, p.name)
+                                                                  ELSE coalesce(source.name, p.name) END)) = sqlc.narg('category'))
+  AND (sqlc.narg('tag')::VARCHAR IS NULL
+  OR EXISTS(SELECT 1
+            FROM transaction_tags tt
+            WHERE tt.transaction_id = t.id
+              AND tt.account_id = sqlc.arg('account_id')
+              AND tt.tag = sqlc.narg('tag')))
+  AND (sqlc.narg('cursor_created_at')::TIMESTAMP IS NULL
+  OR (t.created_at, t.id) < (sqlc.narg('cursor_created_at'), sqlc.narg('cursor_id')::UUID))
+ORDER BY t.created_at DESC, t.id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: RenameTransactionsCard :exec
+UPDATE transactions
+SET card_number = sqlc.arg('new_card')::VARCHAR
+WHERE card_number = sqlc.arg('old_card');
+
+-- name: SetTransactionCategory :exec
+INSERT INTO transaction_categories (transaction_id, account_id, category)
+VALUES ($1, $2, $3)
+ON CONFLICT (transaction_id, account_id) DO UPDATE SET category = excluded.category;
+
+-- name: DeleteTransactionCategory :exec
+DELETE
+FROM transaction_categories
+WHERE transaction_id = $1
+  AND account_id = $2;
+
+-- name: CreateTransactionTag :exec
+INSERT INTO transaction_tags (transaction_id, account_id, tag)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING;
+
+-- name: DeleteTransactionTags :exec
+DELETE
+FROM transaction_tags
+WHERE transaction_id = $1
+  AND account_id = $2;
+
+-- name: GetSpendingByCategory :many
+SELECT s.category::VARCHAR  as category,
+       count(*)::BIGINT      as count,
+       sum(s.amount)::BIGINT as amount
+FROM (SELECT coalesce(tc.category, suggest_transaction_category(t.type, destination.name)) as category,
+             t.amount - (SELECT coalesce(sum(coalesce(r.destination_amount, r.amount)), 0)
+                         FROM transactions r
+                         WHERE r.reversed_transaction_id = t.id)                      as amount
+      FROM transactions t
+             LEFT JOIN accounts destination on destination.id = t.destination_account_id
+             LEFT JOIN transaction_categories tc on tc.transaction_id = t.id AND tc.account_id = t.source_account_id
+      WHERE t.source_account_id = sqlc.arg('account_id')
+        AND t.type IN ('transfer', 'withdrawal')
+        AND NOT t.is_rolled_back
+        AND t.created_at >= sqlc.arg('from_time')::TIMESTAMP
+        AND t.created_at < sqlc.arg('to_time')::TIMESTAMP) s
+WHERE s.amount > 0
+GROUP BY s.category
+ORDER BY amount DESC, s.category;
 
--- name: SetTransactionRolledBack :exec
+-- name: PurgeIdempotencyKeys :execrows
 UPDATE transactions
-SET is_rolled_back = true
-WHERE id = $1;
+SET idempotency_key = NULL
+WHERE idempotency_key IS NOT NULL
+  AND created_at < sqlc.arg('before')::TIMESTAMP;
@@ -7,3 +7,8 @@ RETURNING id;
 SELECT id
 FROM users
 WHERE external_id = $1;
+
+-- name: GetUserExternalID :one
+SELECT external_id
+FROM users
+WHERE id = $1;
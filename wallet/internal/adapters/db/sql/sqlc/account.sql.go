@@ -7,21 +7,59 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
 )
 
-const addAccountBalance = `-- name: AddAccountBalance :exec
+const addAccountBalance = `-- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + $2
 WHERE id = $1
+RETURNING balance
 `
 
 type AddAccountBalanceParams struct {
-	ID      int64   `db:"id" json:"id"`
-	Balance float64 `db:"balance" json:"balance"`
+	ID      int64 `db:"id" json:"id"`
+	Balance int64 `db:"balance" json:"balance"`
+}
+
+func (q *Queries) AddAccountBalance(ctx context.Context, db DBTX, arg AddAccountBalanceParams) (int64, error) {
+	row := db.QueryRowContext(ctx, addAccountBalance, arg.ID, arg.Balance)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
+const clearDefaultAccount = `-- name: ClearDefaultAccount :exec
+UPDATE accounts
+SET is_default = false
+WHERE user_id = $1
+  AND currency_id = $2
+  AND is_default
+`
+
+type ClearDefaultAccountParams struct {
+	UserID     int64 `db:"user_id" json:"user_id"`
+	CurrencyID int64 `db:"currency_id" json:"currency_id"`
 }
 
-func (q *Queries) AddAccountBalance(ctx context.Context, db DBTX, arg AddAccountBalanceParams) error {
-	_, err := db.ExecContext(ctx, addAccountBalance, arg.ID, arg.Balance)
+func (q *Queries) ClearDefaultAccount(ctx context.Context, db DBTX, arg ClearDefaultAccountParams) error {
+	_, err := db.ExecContext(ctx, clearDefaultAccount, arg.UserID, arg.CurrencyID)
+	return err
+}
+
+const closeAccount = `-- name: CloseAccount :exec
+UPDATE accounts
+SET status     = 'closed',
+    is_default = false,
+    closed_at  = NOW()
+WHERE id = $1
+`
+
+func (q *Queries) CloseAccount(ctx context.Context, db DBTX, id int64) error {
+	_, err := db.ExecContext(ctx, closeAccount, id)
 	return err
 }
 
@@ -42,19 +80,14 @@ func (q *Queries) CreateAccount(ctx context.Context, db DBTX, arg CreateAccountP
 	return err
 }
 
-const deleteAccount = `-- name: DeleteAccount :exec
-DELETE
-FROM accounts
-WHERE id = $1
-`
-
-func (q *Queries) DeleteAccount(ctx context.Context, db DBTX, id int64) error {
-	_, err := db.ExecContext(ctx, deleteAccount, id)
-	return err
-}
-
 const getAccount = `-- name: GetAccount :one
-SELECT a.id, a.user_id, a.name, a.balance, a.currency_id, c.name as currency_name
+SELECT a.id, a.user_id, a.name, a.balance, a.currency_id, c.code as currency_name, c.minor_units,
+       (a.balance - coalesce((SELECT sum(h.amount)
+                             FROM holds h
+                             WHERE h.account_id = a.id
+                               AND h.status = 'authorized'
+                               AND h.expires_at > NOW()), 0))::BIGINT as available_balance,
+       a.is_default, a.status, a.frozen_by_ops, a.closed_at
 FROM accounts a
        JOIN currency c on a.currency_id = c.id
 WHERE a.id = $1
@@ -62,12 +95,18 @@ LIMIT 1
 `
 
 type GetAccountRow struct {
-	ID           int64   `db:"id" json:"id"`
-	UserID       int64   `db:"user_id" json:"user_id"`
-	Name         string  `db:"name" json:"name"`
-	Balance      float64 `db:"balance" json:"balance"`
-	CurrencyID   int64   `db:"currency_id" json:"currency_id"`
-	CurrencyName string  `db:"currency_name" json:"currency_name"`
+	ID               int64        `db:"id" json:"id"`
+	UserID           int64        `db:"user_id" json:"user_id"`
+	Name             string       `db:"name" json:"name"`
+	Balance          int64        `db:"balance" json:"balance"`
+	CurrencyID       int64        `db:"currency_id" json:"currency_id"`
+	CurrencyName     string       `db:"currency_name" json:"currency_name"`
+	MinorUnits       int16        `db:"minor_units" json:"minor_units"`
+	AvailableBalance int64        `db:"available_balance" json:"available_balance"`
+	IsDefault        bool         `db:"is_default" json:"is_default"`
+	Status           string       `db:"status" json:"status"`
+	FrozenByOps      bool         `db:"frozen_by_ops" json:"frozen_by_ops"`
+	ClosedAt         sql.NullTime `db:"closed_at" json:"closed_at"`
 }
 
 func (q *Queries) GetAccount(ctx context.Context, db DBTX, id int64) (GetAccountRow, error) {
@@ -80,24 +119,68 @@ func (q *Queries) GetAccount(ctx context.Context, db DBTX, id int64) (GetAccount
 		&i.Balance,
 		&i.CurrencyID,
 		&i.CurrencyName,
+		&i.MinorUnits,
+		&i.AvailableBalance,
+		&i.IsDefault,
+		&i.Status,
+		&i.FrozenByOps,
+		&i.ClosedAt,
 	)
 	return i, err
 }
 
+const getAccountBalanceAt = `-- name: GetAccountBalanceAt :one
+SELECT (a.balance
+  - (SELECT coalesce(sum(coalesce(t.destination_amount, t.amount)), 0)
+     FROM transactions t
+     WHERE t.destination_account_id = a.id
+       AND t.created_at >= $1)
+  + (SELECT coalesce(sum(t.amount), 0)
+     FROM transactions t
+     WHERE t.source_account_id = a.id
+       AND t.created_at >= $1))::BIGINT as balance
+FROM accounts a
+WHERE a.id = $2
+`
+
+type GetAccountBalanceAtParams struct {
+	At time.Time `db:"at" json:"at"`
+	ID int64     `db:"id" json:"id"`
+}
+
+func (q *Queries) GetAccountBalanceAt(ctx context.Context, db DBTX, arg GetAccountBalanceAtParams) (int64, error) {
+	row := db.QueryRowContext(ctx, getAccountBalanceAt, arg.At, arg.ID)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
+}
+
 const getAccounts = `-- name: GetAccounts :many
-SELECT a.id, a.user_id, a.name, a.balance, a.currency_id, c.name as currency_name
+SELECT a.id, a.user_id, a.name, a.balance, a.currency_id, c.code as currency_name, c.minor_units,
+       (a.balance - coalesce((SELECT sum(h.amount)
+                             FROM holds h
+                             WHERE h.account_id = a.id
+                               AND h.status = 'authorized'
+                               AND h.expires_at > NOW()), 0))::BIGINT as available_balance,
+       a.is_default, a.status, a.frozen_by_ops, a.closed_at
 FROM accounts a
        JOIN currency c on a.currency_id = c.id
 WHERE a.user_id = $1
 `
 
 type GetAccountsRow struct {
-	ID           int64   `db:"id" json:"id"`
-	UserID       int64   `db:"user_id" json:"user_id"`
-	Name         string  `db:"name" json:"name"`
-	Balance      float64 `db:"balance" json:"balance"`
-	CurrencyID   int64   `db:"currency_id" json:"currency_id"`
-	CurrencyName string  `db:"currency_name" json:"currency_name"`
+	ID               int64        `db:"id" json:"id"`
+	UserID           int64        `db:"user_id" json:"user_id"`
+	Name             string       `db:"name" json:"name"`
+	Balance          int64        `db:"balance" json:"balance"`
+	CurrencyID       int64        `db:"currency_id" json:"currency_id"`
+	CurrencyName     string       `db:"currency_name" json:"currency_name"`
+	MinorUnits       int16        `db:"minor_units" json:"minor_units"`
+	AvailableBalance int64        `db:"available_balance" json:"available_balance"`
+	IsDefault        bool         `db:"is_default" json:"is_default"`
+	Status           string       `db:"status" json:"status"`
+	FrozenByOps      bool         `db:"frozen_by_ops" json:"frozen_by_ops"`
+	ClosedAt         sql.NullTime `db:"closed_at" json:"closed_at"`
 }
 
 func (q *Queries) GetAccounts(ctx context.Context, db DBTX, userID int64) ([]GetAccountsRow, error) {
@@ -116,6 +199,12 @@ func (q *Queries) GetAccounts(ctx context.Context, db DBTX, userID int64) ([]Get
 			&i.Balance,
 			&i.CurrencyID,
 			&i.CurrencyName,
+			&i.MinorUnits,
+			&i.AvailableBalance,
+			&i.IsDefault,
+			&i.Status,
+			&i.FrozenByOps,
+			&i.ClosedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -130,18 +219,75 @@ func (q *Queries) GetAccounts(ctx context.Context, db DBTX, userID int64) ([]Get
 	return items, nil
 }
 
-const subAccountBalance = `-- name: SubAccountBalance :exec
+const getReceivingAccount = `-- name: GetReceivingAccount :one
+SELECT a.id
+FROM accounts a
+       JOIN users u on a.user_id = u.id
+       JOIN currency c on a.currency_id = c.id
+WHERE u.external_id = $1
+  AND c.code = $2
+  AND a.status <> 'closed'
+ORDER BY a.is_default DESC, a.id
+LIMIT 1
+`
+
+type GetReceivingAccountParams struct {
+	ExternalID uuid.UUID `db:"external_id" json:"external_id"`
+	Code       string    `db:"code" json:"code"`
+}
+
+func (q *Queries) GetReceivingAccount(ctx context.Context, db DBTX, arg GetReceivingAccountParams) (int64, error) {
+	row := db.QueryRowContext(ctx, getReceivingAccount, arg.ExternalID, arg.Code)
+	var id int64
+	err := row.Scan(&id)
+	return id, err
+}
+
+const setAccountStatus = `-- name: SetAccountStatus :exec
+UPDATE accounts
+SET status        = $2,
+    frozen_by_ops = $3
+WHERE id = $1
+`
+
+type SetAccountStatusParams struct {
+	ID          int64  `db:"id" json:"id"`
+	Status      string `db:"status" json:"status"`
+	FrozenByOps bool   `db:"frozen_by_ops" json:"frozen_by_ops"`
+}
+
+func (q *Queries) SetAccountStatus(ctx context.Context, db DBTX, arg SetAccountStatusParams) error {
+	_, err := db.ExecContext(ctx, setAccountStatus, arg.ID, arg.Status, arg.FrozenByOps)
+	return err
+}
+
+const setDefaultAccount = `-- name: SetDefaultAccount :exec
+UPDATE accounts
+SET is_default = true
+WHERE id = $1
+`
+
+func (q *Queries) SetDefaultAccount(ctx context.Context, db DBTX, id int64) error {
+	_, err := db.ExecContext(ctx, setDefaultAccount, id)
+	return err
+}
+
+const subAccountBalance = `-- name: SubAccountBalance :one
 UPDATE accounts
 SET balance = balance - $2
 WHERE id = $1
+  AND balance >= $2
+RETURNING balance
 `
 
 type SubAccountBalanceParams struct {
-	ID      int64   `db:"id" json:"id"`
-	Balance float64 `db:"balance" json:"balance"`
+	ID      int64 `db:"id" json:"id"`
+	Balance int64 `db:"balance" json:"balance"`
 }
 
-func (q *Queries) SubAccountBalance(ctx context.Context, db DBTX, arg SubAccountBalanceParams) error {
-	_, err := db.ExecContext(ctx, subAccountBalance, arg.ID, arg.Balance)
-	return err
+func (q *Queries) SubAccountBalance(ctx context.Context, db DBTX, arg SubAccountBalanceParams) (int64, error) {
+	row := db.QueryRowContext(ctx, subAccountBalance, arg.ID, arg.Balance)
+	var balance int64
+	err := row.Scan(&balance)
+	return balance, err
 }
@@ -7,20 +7,57 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
+	"time"
 )
 
 const createCard = `-- name: CreateCard :exec
-INSERT INTO cards (number, account_id)
-VALUES ($1, $2)
+INSERT INTO cards (number, account_id, nickname, cvv_hash, expires_at, number_ciphertext, number_data_key, kind,
+                   spending_cap, locked_to_account_id)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
 `
 
 type CreateCardParams struct {
-	Number    string `db:"number" json:"number"`
-	AccountID int64  `db:"account_id" json:"account_id"`
+	Number            string         `db:"number" json:"number"`
+	AccountID         int64          `db:"account_id" json:"account_id"`
+	Nickname          sql.NullString `db:"nickname" json:"nickname"`
+	CvvHash           sql.NullString `db:"cvv_hash" json:"cvv_hash"`
+	ExpiresAt         time.Time      `db:"expires_at" json:"expires_at"`
+	NumberCiphertext  []byte         `db:"number_ciphertext" json:"number_ciphertext"`
+	NumberDataKey     []byte         `db:"number_data_key" json:"number_data_key"`
+	Kind              string         `db:"kind" json:"kind"`
+	SpendingCap       sql.NullInt64  `db:"spending_cap" json:"spending_cap"`
+	LockedToAccountID sql.NullInt64  `db:"locked_to_account_id" json:"locked_to_account_id"`
 }
 
 func (q *Queries) CreateCard(ctx context.Context, db DBTX, arg CreateCardParams) error {
-	_, err := db.ExecContext(ctx, createCard, arg.Number, arg.AccountID)
+	_, err := db.ExecContext(ctx, createCard,
+		arg.Number,
+		arg.AccountID,
+		arg.Nickname,
+		arg.CvvHash,
+		arg.ExpiresAt,
+		arg.NumberCiphertext,
+		arg.NumberDataKey,
+		arg.Kind,
+		arg.SpendingCap,
+		arg.LockedToAccountID,
+	)
+	return err
+}
+
+const createCardReveal = `-- name: CreateCardReveal :exec
+INSERT INTO card_reveals (card_number, user_id)
+VALUES ($1, $2)
+`
+
+type CreateCardRevealParams struct {
+	CardNumber string `db:"card_number" json:"card_number"`
+	UserID     int64  `db:"user_id" json:"user_id"`
+}
+
+func (q *Queries) CreateCardReveal(ctx context.Context, db DBTX, arg CreateCardRevealParams) error {
+	_, err := db.ExecContext(ctx, createCardReveal, arg.CardNumber, arg.UserID)
 	return err
 }
 
@@ -47,9 +84,10 @@ func (q *Queries) DeleteCard(ctx context.Context, db DBTX, number string) error
 }
 
 const getAccountCards = `-- name: GetAccountCards :many
-SELECT number, account_id
+SELECT number, account_id, status, nickname, cvv_hash, expires_at, replaced_by, created_at, number_ciphertext, number_data_key, pin_hash, pin_failed_attempts, pin_locked_until, kind, spending_cap, locked_to_account_id
 FROM cards
 WHERE account_id = $1
+ORDER BY created_at
 `
 
 func (q *Queries) GetAccountCards(ctx context.Context, db DBTX, accountID int64) ([]Card, error) {
@@ -61,7 +99,24 @@ func (q *Queries) GetAccountCards(ctx context.Context, db DBTX, accountID int64)
 	items := []Card{}
 	for rows.Next() {
 		var i Card
-		if err := rows.Scan(&i.Number, &i.AccountID); err != nil {
+		if err := rows.Scan(
+			&i.Number,
+			&i.AccountID,
+			&i.Status,
+			&i.Nickname,
+			&i.CvvHash,
+			&i.ExpiresAt,
+			&i.ReplacedBy,
+			&i.CreatedAt,
+			&i.NumberCiphertext,
+			&i.NumberDataKey,
+			&i.PinHash,
+			&i.PinFailedAttempts,
+			&i.PinLockedUntil,
+			&i.Kind,
+			&i.SpendingCap,
+			&i.LockedToAccountID,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -76,7 +131,7 @@ func (q *Queries) GetAccountCards(ctx context.Context, db DBTX, accountID int64)
 }
 
 const getCard = `-- name: GetCard :one
-SELECT number, account_id
+SELECT number, account_id, status, nickname, cvv_hash, expires_at, replaced_by, created_at, number_ciphertext, number_data_key, pin_hash, pin_failed_attempts, pin_locked_until, kind, spending_cap, locked_to_account_id
 FROM cards
 WHERE number = $1
 `
@@ -84,12 +139,35 @@ WHERE number = $1
 func (q *Queries) GetCard(ctx context.Context, db DBTX, number string) (Card, error) {
 	row := db.QueryRowContext(ctx, getCard, number)
 	var i Card
-	err := row.Scan(&i.Number, &i.AccountID)
+	err := row.Scan(
+		&i.Number,
+		&i.AccountID,
+		&i.Status,
+		&i.Nickname,
+		&i.CvvHash,
+		&i.ExpiresAt,
+		&i.ReplacedBy,
+		&i.CreatedAt,
+		&i.NumberCiphertext,
+		&i.NumberDataKey,
+		&i.PinHash,
+		&i.PinFailedAttempts,
+		&i.PinLockedUntil,
+		&i.Kind,
+		&i.SpendingCap,
+		&i.LockedToAccountID,
+	)
 	return i, err
 }
 
 const getCardAccount = `-- name: GetCardAccount :one
-SELECT a.id as id, a.user_id as owner_id, a.name, a.balance, cc.name as currency
+SELECT a.id as id, a.user_id as owner_id, a.name, a.balance, cc.code as currency, cc.minor_units,
+       (a.balance - coalesce((SELECT sum(h.amount)
+                             FROM holds h
+                             WHERE h.account_id = a.id
+                               AND h.status = 'authorized'
+                               AND h.expires_at > NOW()), 0))::BIGINT as available_balance,
+       a.status, a.frozen_by_ops
 FROM cards c
        JOIN accounts a on a.id = c.account_id
        JOIN currency cc on a.currency_id = cc.id
@@ -98,11 +176,15 @@ LIMIT 1
 `
 
 type GetCardAccountRow struct {
-	ID       int64   `db:"id" json:"id"`
-	OwnerID  int64   `db:"owner_id" json:"owner_id"`
-	Name     string  `db:"name" json:"name"`
-	Balance  float64 `db:"balance" json:"balance"`
-	Currency string  `db:"currency" json:"currency"`
+	ID               int64  `db:"id" json:"id"`
+	OwnerID          int64  `db:"owner_id" json:"owner_id"`
+	Name             string `db:"name" json:"name"`
+	Balance          int64  `db:"balance" json:"balance"`
+	Currency         string `db:"currency" json:"currency"`
+	MinorUnits       int16  `db:"minor_units" json:"minor_units"`
+	AvailableBalance int64  `db:"available_balance" json:"available_balance"`
+	Status           string `db:"status" json:"status"`
+	FrozenByOps      bool   `db:"frozen_by_ops" json:"frozen_by_ops"`
 }
 
 func (q *Queries) GetCardAccount(ctx context.Context, db DBTX, number string) (GetCardAccountRow, error) {
@@ -114,6 +196,10 @@ func (q *Queries) GetCardAccount(ctx context.Context, db DBTX, number string) (G
 		&i.Name,
 		&i.Balance,
 		&i.Currency,
+		&i.MinorUnits,
+		&i.AvailableBalance,
+		&i.Status,
+		&i.FrozenByOps,
 	)
 	return i, err
 }
@@ -126,24 +212,86 @@ WHERE id = (SELECT account_id
             WHERE number = $1)
 `
 
-func (q *Queries) GetCardBalance(ctx context.Context, db DBTX, number string) (float64, error) {
+func (q *Queries) GetCardBalance(ctx context.Context, db DBTX, number string) (int64, error) {
 	row := db.QueryRowContext(ctx, getCardBalance, number)
-	var balance float64
+	var balance int64
 	err := row.Scan(&balance)
 	return balance, err
 }
 
+const getCardDebited = `-- name: GetCardDebited :one
+SELECT ((SELECT coalesce(sum(t.amount), 0)
+         FROM transactions t
+         WHERE t.card_number = $1::VARCHAR
+           AND t.type IN ('transfer', 'withdrawal')) +
+        (SELECT coalesce(sum(h.amount), 0)
+         FROM holds h
+         WHERE h.card_number = $1
+           AND h.status = 'authorized'
+           AND h.expires_at > NOW()))::BIGINT as debited
+`
+
+func (q *Queries) GetCardDebited(ctx context.Context, db DBTX, cardNumber string) (int64, error) {
+	row := db.QueryRowContext(ctx, getCardDebited, cardNumber)
+	var debited int64
+	err := row.Scan(&debited)
+	return debited, err
+}
+
+const getUnvaultedCards = `-- name: GetUnvaultedCards :many
+SELECT number
+FROM cards
+WHERE number_ciphertext IS NULL
+`
+
+func (q *Queries) GetUnvaultedCards(ctx context.Context, db DBTX) ([]string, error) {
+	rows, err := db.QueryContext(ctx, getUnvaultedCards)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var number string
+		if err := rows.Scan(&number); err != nil {
+			return nil, err
+		}
+		items = append(items, number)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserCards = `-- name: GetUserCards :many
-SELECT cards.number, cards.account_id, accounts.currency_id
+SELECT cards.number, cards.account_id, cards.status, cards.nickname, cards.cvv_hash, cards.expires_at, cards.replaced_by, cards.created_at, cards.number_ciphertext, cards.number_data_key, cards.pin_hash, cards.pin_failed_attempts, cards.pin_locked_until, cards.kind, cards.spending_cap, cards.locked_to_account_id, accounts.currency_id
 FROM cards
        INNER JOIN accounts ON accounts.id = cards.account_id
 WHERE accounts.user_id = $1
 `
 
 type GetUserCardsRow struct {
-	Number     string `db:"number" json:"number"`
-	AccountID  int64  `db:"account_id" json:"account_id"`
-	CurrencyID int64  `db:"currency_id" json:"currency_id"`
+	Number            string         `db:"number" json:"number"`
+	AccountID         int64          `db:"account_id" json:"account_id"`
+	Status            string         `db:"status" json:"status"`
+	Nickname          sql.NullString `db:"nickname" json:"nickname"`
+	CvvHash           sql.NullString `db:"cvv_hash" json:"cvv_hash"`
+	ExpiresAt         time.Time      `db:"expires_at" json:"expires_at"`
+	ReplacedBy        sql.NullString `db:"replaced_by" json:"replaced_by"`
+	CreatedAt         time.Time      `db:"created_at" json:"created_at"`
+	NumberCiphertext  []byte         `db:"number_ciphertext" json:"number_ciphertext"`
+	NumberDataKey     []byte         `db:"number_data_key" json:"number_data_key"`
+	PinHash           sql.NullString `db:"pin_hash" json:"pin_hash"`
+	PinFailedAttempts int32          `db:"pin_failed_attempts" json:"pin_failed_attempts"`
+	PinLockedUntil    sql.NullTime   `db:"pin_locked_until" json:"pin_locked_until"`
+	Kind              string         `db:"kind" json:"kind"`
+	SpendingCap       sql.NullInt64  `db:"spending_cap" json:"spending_cap"`
+	LockedToAccountID sql.NullInt64  `db:"locked_to_account_id" json:"locked_to_account_id"`
+	CurrencyID        int64          `db:"currency_id" json:"currency_id"`
 }
 
 func (q *Queries) GetUserCards(ctx context.Context, db DBTX, userID int64) ([]GetUserCardsRow, error) {
@@ -155,7 +303,25 @@ func (q *Queries) GetUserCards(ctx context.Context, db DBTX, userID int64) ([]Ge
 	items := []GetUserCardsRow{}
 	for rows.Next() {
 		var i GetUserCardsRow
-		if err := rows.Scan(&i.Number, &i.AccountID, &i.CurrencyID); err != nil {
+		if err := rows.Scan(
+			&i.Number,
+			&i.AccountID,
+			&i.Status,
+			&i.Nickname,
+			&i.CvvHash,
+			&i.ExpiresAt,
+			&i.ReplacedBy,
+			&i.CreatedAt,
+			&i.NumberCiphertext,
+			&i.NumberDataKey,
+			&i.PinHash,
+			&i.PinFailedAttempts,
+			&i.PinLockedUntil,
+			&i.Kind,
+			&i.SpendingCap,
+			&i.LockedToAccountID,
+			&i.CurrencyID,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -168,3 +334,148 @@ func (q *Queries) GetUserCards(ctx context.Context, db DBTX, userID int64) ([]Ge
 	}
 	return items, nil
 }
+
+const getVaultedCards = `-- name: GetVaultedCards :many
+SELECT number, number_ciphertext, number_data_key
+FROM cards
+WHERE number_ciphertext IS NOT NULL
+`
+
+type GetVaultedCardsRow struct {
+	Number           string `db:"number" json:"number"`
+	NumberCiphertext []byte `db:"number_ciphertext" json:"number_ciphertext"`
+	NumberDataKey    []byte `db:"number_data_key" json:"number_data_key"`
+}
+
+func (q *Queries) GetVaultedCards(ctx context.Context, db DBTX) ([]GetVaultedCardsRow, error) {
+	rows, err := db.QueryContext(ctx, getVaultedCards)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetVaultedCardsRow{}
+	for rows.Next() {
+		var i GetVaultedCardsRow
+		if err := rows.Scan(&i.Number, &i.NumberCiphertext, &i.NumberDataKey); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const retireCard = `-- name: RetireCard :exec
+UPDATE cards
+SET status      = CASE WHEN status = 'lost' THEN 'lost' ELSE 'blocked' END,
+    replaced_by = $2
+WHERE number = $1
+`
+
+type RetireCardParams struct {
+	Number     string         `db:"number" json:"number"`
+	ReplacedBy sql.NullString `db:"replaced_by" json:"replaced_by"`
+}
+
+func (q *Queries) RetireCard(ctx context.Context, db DBTX, arg RetireCardParams) error {
+	_, err := db.ExecContext(ctx, retireCard, arg.Number, arg.ReplacedBy)
+	return err
+}
+
+const setCardNickname = `-- name: SetCardNickname :exec
+UPDATE cards
+SET nickname = $2
+WHERE number = $1
+`
+
+type SetCardNicknameParams struct {
+	Number   string         `db:"number" json:"number"`
+	Nickname sql.NullString `db:"nickname" json:"nickname"`
+}
+
+func (q *Queries) SetCardNickname(ctx context.Context, db DBTX, arg SetCardNicknameParams) error {
+	_, err := db.ExecContext(ctx, setCardNickname, arg.Number, arg.Nickname)
+	return err
+}
+
+const setCardPin = `-- name: SetCardPin :exec
+UPDATE cards
+SET pin_hash            = $2,
+    pin_failed_attempts = 0,
+    pin_locked_until    = NULL
+WHERE number = $1
+`
+
+type SetCardPinParams struct {
+	Number  string         `db:"number" json:"number"`
+	PinHash sql.NullString `db:"pin_hash" json:"pin_hash"`
+}
+
+func (q *Queries) SetCardPin(ctx context.Context, db DBTX, arg SetCardPinParams) error {
+	_, err := db.ExecContext(ctx, setCardPin, arg.Number, arg.PinHash)
+	return err
+}
+
+const setCardPinAttempts = `-- name: SetCardPinAttempts :exec
+UPDATE cards
+SET pin_failed_attempts = $2,
+    pin_locked_until    = $3
+WHERE number = $1
+`
+
+type SetCardPinAttemptsParams struct {
+	Number            string       `db:"number" json:"number"`
+	PinFailedAttempts int32        `db:"pin_failed_attempts" json:"pin_failed_attempts"`
+	PinLockedUntil    sql.NullTime `db:"pin_locked_until" json:"pin_locked_until"`
+}
+
+func (q *Queries) SetCardPinAttempts(ctx context.Context, db DBTX, arg SetCardPinAttemptsParams) error {
+	_, err := db.ExecContext(ctx, setCardPinAttempts, arg.Number, arg.PinFailedAttempts, arg.PinLockedUntil)
+	return err
+}
+
+const setCardStatus = `-- name: SetCardStatus :exec
+UPDATE cards
+SET status = $2
+WHERE number = $1
+`
+
+type SetCardStatusParams struct {
+	Number string `db:"number" json:"number"`
+	Status string `db:"status" json:"status"`
+}
+
+func (q *Queries) SetCardStatus(ctx context.Context, db DBTX, arg SetCardStatusParams) error {
+	_, err := db.ExecContext(ctx, setCardStatus, arg.Number, arg.Status)
+	return err
+}
+
+const vaultCard = `-- name: VaultCard :exec
+UPDATE cards
+SET number            = $1,
+    number_ciphertext = $2,
+    number_data_key   = $3
+WHERE number = $4
+`
+
+type VaultCardParams struct {
+	Token            string `db:"token" json:"token"`
+	NumberCiphertext []byte `db:"number_ciphertext" json:"number_ciphertext"`
+	NumberDataKey    []byte `db:"number_data_key" json:"number_data_key"`
+	Number           string `db:"number" json:"number"`
+}
+
+func (q *Queries) VaultCard(ctx context.Context, db DBTX, arg VaultCardParams) error {
+	_, err := db.ExecContext(ctx, vaultCard,
+		arg.Token,
+		arg.NumberCiphertext,
+		arg.NumberDataKey,
+		arg.Number,
+	)
+	return err
+}
@@ -9,30 +9,77 @@ import (
 	"context"
 )
 
-const getCurrencyByID = `-- name: GetCurrencyByID :one
-SELECT id, name
+const getCurrencies = `-- name: GetCurrencies :many
+SELECT id, code, minor_units, iso_number, enabled
 FROM currency
-WHERE id = $1
+ORDER BY code
+`
+
+func (q *Queries) GetCurrencies(ctx context.Context, db DBTX) ([]Currency, error) {
+	rows, err := db.QueryContext(ctx, getCurrencies)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Currency{}
+	for rows.Next() {
+		var i Currency
+		if err := rows.Scan(
+			&i.ID,
+			&i.Code,
+			&i.MinorUnits,
+			&i.IsoNumber,
+			&i.Enabled,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getCurrencyByCode = `-- name: GetCurrencyByCode :one
+SELECT id, code, minor_units, iso_number, enabled
+FROM currency
+WHERE code = $1
 LIMIT 1
 `
 
-func (q *Queries) GetCurrencyByID(ctx context.Context, db DBTX, id int64) (Currency, error) {
-	row := db.QueryRowContext(ctx, getCurrencyByID, id)
+func (q *Queries) GetCurrencyByCode(ctx context.Context, db DBTX, code string) (Currency, error) {
+	row := db.QueryRowContext(ctx, getCurrencyByCode, code)
 	var i Currency
-	err := row.Scan(&i.ID, &i.Name)
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.MinorUnits,
+		&i.IsoNumber,
+		&i.Enabled,
+	)
 	return i, err
 }
 
-const getCurrencyByName = `-- name: GetCurrencyByName :one
-SELECT id
+const getCurrencyByID = `-- name: GetCurrencyByID :one
+SELECT id, code, minor_units, iso_number, enabled
 FROM currency
-WHERE name = $1
+WHERE id = $1
 LIMIT 1
 `
 
-func (q *Queries) GetCurrencyByName(ctx context.Context, db DBTX, name string) (int64, error) {
-	row := db.QueryRowContext(ctx, getCurrencyByName, name)
-	var id int64
-	err := row.Scan(&id)
-	return id, err
+func (q *Queries) GetCurrencyByID(ctx context.Context, db DBTX, id int64) (Currency, error) {
+	row := db.QueryRowContext(ctx, getCurrencyByID, id)
+	var i Currency
+	err := row.Scan(
+		&i.ID,
+		&i.Code,
+		&i.MinorUnits,
+		&i.IsoNumber,
+		&i.Enabled,
+	)
+	return i, err
 }
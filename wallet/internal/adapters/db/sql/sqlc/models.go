@@ -19,6 +19,8 @@ const (
 	TransactionTypeDeposit    TransactionType = "deposit"
 	TransactionTypeWithdrawal TransactionType = "withdrawal"
 	TransactionTypeTransfer   TransactionType = "transfer"
+	TransactionTypeReversal   TransactionType = "reversal"
+	TransactionTypePot        TransactionType = "pot"
 )
 
 func (e *TransactionType) Scan(src interface{}) error {
@@ -60,7 +62,9 @@ func (e TransactionType) Valid() bool {
 	switch e {
 	case TransactionTypeDeposit,
 		TransactionTypeWithdrawal,
-		TransactionTypeTransfer:
+		TransactionTypeTransfer,
+		TransactionTypeReversal,
+		TransactionTypePot:
 		return true
 	}
 	return false
@@ -71,35 +75,238 @@ func AllTransactionTypeValues() []TransactionType {
 		TransactionTypeDeposit,
 		TransactionTypeWithdrawal,
 		TransactionTypeTransfer,
+		TransactionTypeReversal,
+		TransactionTypePot,
 	}
 }
 
 type Account struct {
-	ID         int64   `db:"id" json:"id"`
-	UserID     int64   `db:"user_id" json:"user_id"`
-	Name       string  `db:"name" json:"name"`
-	Balance    float64 `db:"balance" json:"balance"`
-	CurrencyID int64   `db:"currency_id" json:"currency_id"`
+	ID          int64        `db:"id" json:"id"`
+	UserID      int64        `db:"user_id" json:"user_id"`
+	Name        string       `db:"name" json:"name"`
+	CurrencyID  int64        `db:"currency_id" json:"currency_id"`
+	Balance     int64        `db:"balance" json:"balance"`
+	IsDefault   bool         `db:"is_default" json:"is_default"`
+	Status      string       `db:"status" json:"status"`
+	FrozenByOps bool         `db:"frozen_by_ops" json:"frozen_by_ops"`
+	ClosedAt    sql.NullTime `db:"closed_at" json:"closed_at"`
+}
+
+type Budget struct {
+	AccountID        int64        `db:"account_id" json:"account_id"`
+	Category         string       `db:"category" json:"category"`
+	Amount           int64        `db:"amount" json:"amount"`
+	AlertedMonth     sql.NullTime `db:"alerted_month" json:"alerted_month"`
+	AlertedThreshold int32        `db:"alerted_threshold" json:"alerted_threshold"`
+	CreatedAt        time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt        time.Time    `db:"updated_at" json:"updated_at"`
 }
 
 type Card struct {
-	Number    string `db:"number" json:"number"`
-	AccountID int64  `db:"account_id" json:"account_id"`
+	Number            string         `db:"number" json:"number"`
+	AccountID         int64          `db:"account_id" json:"account_id"`
+	Status            string         `db:"status" json:"status"`
+	Nickname          sql.NullString `db:"nickname" json:"nickname"`
+	CvvHash           sql.NullString `db:"cvv_hash" json:"cvv_hash"`
+	ExpiresAt         time.Time      `db:"expires_at" json:"expires_at"`
+	ReplacedBy        sql.NullString `db:"replaced_by" json:"replaced_by"`
+	CreatedAt         time.Time      `db:"created_at" json:"created_at"`
+	NumberCiphertext  []byte         `db:"number_ciphertext" json:"number_ciphertext"`
+	NumberDataKey     []byte         `db:"number_data_key" json:"number_data_key"`
+	PinHash           sql.NullString `db:"pin_hash" json:"pin_hash"`
+	PinFailedAttempts int32          `db:"pin_failed_attempts" json:"pin_failed_attempts"`
+	PinLockedUntil    sql.NullTime   `db:"pin_locked_until" json:"pin_locked_until"`
+	Kind              string         `db:"kind" json:"kind"`
+	SpendingCap       sql.NullInt64  `db:"spending_cap" json:"spending_cap"`
+	LockedToAccountID sql.NullInt64  `db:"locked_to_account_id" json:"locked_to_account_id"`
+}
+
+type CardReveal struct {
+	ID         int64     `db:"id" json:"id"`
+	CardNumber string    `db:"card_number" json:"card_number"`
+	UserID     int64     `db:"user_id" json:"user_id"`
+	RevealedAt time.Time `db:"revealed_at" json:"revealed_at"`
+}
+
+type CategoryRule struct {
+	ID                  int32               `db:"id" json:"id"`
+	TransactionType     NullTransactionType `db:"transaction_type" json:"transaction_type"`
+	CounterpartyPattern sql.NullString      `db:"counterparty_pattern" json:"counterparty_pattern"`
+	Category            string              `db:"category" json:"category"`
+	Priority            int32               `db:"priority" json:"priority"`
 }
 
 type Currency struct {
-	ID   int64  `db:"id" json:"id"`
-	Name string `db:"name" json:"name"`
+	ID         int64  `db:"id" json:"id"`
+	Code       string `db:"code" json:"code"`
+	MinorUnits int16  `db:"minor_units" json:"minor_units"`
+	IsoNumber  int16  `db:"iso_number" json:"iso_number"`
+	Enabled    bool   `db:"enabled" json:"enabled"`
+}
+
+type ExchangeQuote struct {
+	ID                   uuid.UUID    `db:"id" json:"id"`
+	UserID               int64        `db:"user_id" json:"user_id"`
+	SourceAccountID      int64        `db:"source_account_id" json:"source_account_id"`
+	DestinationAccountID int64        `db:"destination_account_id" json:"destination_account_id"`
+	Rate                 string       `db:"rate" json:"rate"`
+	SourceAmount         int64        `db:"source_amount" json:"source_amount"`
+	DestinationAmount    int64        `db:"destination_amount" json:"destination_amount"`
+	ExpiresAt            time.Time    `db:"expires_at" json:"expires_at"`
+	UsedAt               sql.NullTime `db:"used_at" json:"used_at"`
+	CreatedAt            time.Time    `db:"created_at" json:"created_at"`
+}
+
+type Hold struct {
+	ID                 uuid.UUID      `db:"id" json:"id"`
+	AccountID          int64          `db:"account_id" json:"account_id"`
+	RecipientAccountID int64          `db:"recipient_account_id" json:"recipient_account_id"`
+	Amount             int64          `db:"amount" json:"amount"`
+	CapturedAmount     sql.NullInt64  `db:"captured_amount" json:"captured_amount"`
+	Status             string         `db:"status" json:"status"`
+	TransactionID      uuid.NullUUID  `db:"transaction_id" json:"transaction_id"`
+	ExpiresAt          time.Time      `db:"expires_at" json:"expires_at"`
+	CreatedAt          time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt          time.Time      `db:"updated_at" json:"updated_at"`
+	CardNumber         sql.NullString `db:"card_number" json:"card_number"`
+}
+
+type JournalEntry struct {
+	ID            uuid.UUID     `db:"id" json:"id"`
+	TransactionID uuid.NullUUID `db:"transaction_id" json:"transaction_id"`
+	Description   string        `db:"description" json:"description"`
+	CreatedAt     time.Time     `db:"created_at" json:"created_at"`
+}
+
+type LedgerMismatch struct {
+	AccountID     int64     `db:"account_id" json:"account_id"`
+	StoredBalance int64     `db:"stored_balance" json:"stored_balance"`
+	LedgerBalance int64     `db:"ledger_balance" json:"ledger_balance"`
+	DetectedAt    time.Time `db:"detected_at" json:"detected_at"`
+}
+
+type PaymentRequest struct {
+	ID            uuid.UUID     `db:"id" json:"id"`
+	RequesterID   int64         `db:"requester_id" json:"requester_id"`
+	PayerID       int64         `db:"payer_id" json:"payer_id"`
+	ToAccountID   int64         `db:"to_account_id" json:"to_account_id"`
+	Amount        int64         `db:"amount" json:"amount"`
+	CurrencyID    int64         `db:"currency_id" json:"currency_id"`
+	Note          string        `db:"note" json:"note"`
+	Status        string        `db:"status" json:"status"`
+	TransactionID uuid.NullUUID `db:"transaction_id" json:"transaction_id"`
+	ExpiresAt     time.Time     `db:"expires_at" json:"expires_at"`
+	CreatedAt     time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt     time.Time     `db:"updated_at" json:"updated_at"`
+	SplitID       uuid.NullUUID `db:"split_id" json:"split_id"`
+}
+
+type Pot struct {
+	ID           uuid.UUID     `db:"id" json:"id"`
+	AccountID    int64         `db:"account_id" json:"account_id"`
+	Name         string        `db:"name" json:"name"`
+	Balance      int64         `db:"balance" json:"balance"`
+	TargetAmount sql.NullInt64 `db:"target_amount" json:"target_amount"`
+	TargetDate   sql.NullTime  `db:"target_date" json:"target_date"`
+	CreatedAt    time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt    time.Time     `db:"updated_at" json:"updated_at"`
+	DeletedAt    sql.NullTime  `db:"deleted_at" json:"deleted_at"`
+}
+
+type Posting struct {
+	ID              int64         `db:"id" json:"id"`
+	JournalEntryID  uuid.UUID     `db:"journal_entry_id" json:"journal_entry_id"`
+	AccountID       sql.NullInt64 `db:"account_id" json:"account_id"`
+	SystemAccountID sql.NullInt64 `db:"system_account_id" json:"system_account_id"`
+	Amount          int64         `db:"amount" json:"amount"`
+}
+
+type Split struct {
+	ID          uuid.UUID `db:"id" json:"id"`
+	RequesterID int64     `db:"requester_id" json:"requester_id"`
+	ToAccountID int64     `db:"to_account_id" json:"to_account_id"`
+	Amount      int64     `db:"amount" json:"amount"`
+	CurrencyID  int64     `db:"currency_id" json:"currency_id"`
+	Method      string    `db:"method" json:"method"`
+	Note        string    `db:"note" json:"note"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+}
+
+type SpendingLimit struct {
+	ID              int64           `db:"id" json:"id"`
+	AccountID       sql.NullInt64   `db:"account_id" json:"account_id"`
+	CardNumber      sql.NullString  `db:"card_number" json:"card_number"`
+	TransactionType TransactionType `db:"transaction_type" json:"transaction_type"`
+	PerTransaction  sql.NullInt64   `db:"per_transaction" json:"per_transaction"`
+	Daily           sql.NullInt64   `db:"daily" json:"daily"`
+	Monthly         sql.NullInt64   `db:"monthly" json:"monthly"`
+	UpdatedAt       time.Time       `db:"updated_at" json:"updated_at"`
+}
+
+type StandingOrder struct {
+	ID         uuid.UUID      `db:"id" json:"id"`
+	UserID     int64          `db:"user_id" json:"user_id"`
+	FromCard   string         `db:"from_card" json:"from_card"`
+	ToCard     string         `db:"to_card" json:"to_card"`
+	Amount     int64          `db:"amount" json:"amount"`
+	CurrencyID int64          `db:"currency_id" json:"currency_id"`
+	Cron       sql.NullString `db:"cron" json:"cron"`
+	DayOfMonth sql.NullInt16  `db:"day_of_month" json:"day_of_month"`
+	EndDate    sql.NullTime   `db:"end_date" json:"end_date"`
+	NextRunAt  sql.NullTime   `db:"next_run_at" json:"next_run_at"`
+	Status     string         `db:"status" json:"status"`
+	CreatedAt  time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt  time.Time      `db:"updated_at" json:"updated_at"`
+}
+
+type StandingOrderExecution struct {
+	ID              uuid.UUID      `db:"id" json:"id"`
+	StandingOrderID uuid.UUID      `db:"standing_order_id" json:"standing_order_id"`
+	ScheduledAt     time.Time      `db:"scheduled_at" json:"scheduled_at"`
+	Status          string         `db:"status" json:"status"`
+	TransactionID   uuid.NullUUID  `db:"transaction_id" json:"transaction_id"`
+	FailureReason   sql.NullString `db:"failure_reason" json:"failure_reason"`
+	ExecutedAt      time.Time      `db:"executed_at" json:"executed_at"`
+}
+
+type SystemAccount struct {
+	ID         int64  `db:"id" json:"id"`
+	Name       string `db:"name" json:"name"`
+	CurrencyID int64  `db:"currency_id" json:"currency_id"`
 }
 
 type Transaction struct {
-	ID                   uuid.UUID       `db:"id" json:"id"`
-	Type                 TransactionType `db:"type" json:"type"`
-	Amount               float64         `db:"amount" json:"amount"`
-	SourceAccountID      sql.NullInt64   `db:"source_account_id" json:"source_account_id"`
-	DestinationAccountID sql.NullInt64   `db:"destination_account_id" json:"destination_account_id"`
-	CreatedAt            time.Time       `db:"created_at" json:"created_at"`
-	IsRolledBack         bool            `db:"is_rolled_back" json:"is_rolled_back"`
+	ID                      uuid.UUID       `db:"id" json:"id"`
+	Type                    TransactionType `db:"type" json:"type"`
+	SourceAccountID         sql.NullInt64   `db:"source_account_id" json:"source_account_id"`
+	DestinationAccountID    sql.NullInt64   `db:"destination_account_id" json:"destination_account_id"`
+	CreatedAt               time.Time       `db:"created_at" json:"created_at"`
+	IsRolledBack            bool            `db:"is_rolled_back" json:"is_rolled_back"`
+	Amount                  int64           `db:"amount" json:"amount"`
+	IdempotencyKey          sql.NullString  `db:"idempotency_key" json:"idempotency_key"`
+	RollbackIdempotencyKey  sql.NullString  `db:"rollback_idempotency_key" json:"rollback_idempotency_key"`
+	RolledBackAt            sql.NullTime    `db:"rolled_back_at" json:"rolled_back_at"`
+	SourceBalanceAfter      sql.NullInt64   `db:"source_balance_after" json:"source_balance_after"`
+	DestinationBalanceAfter sql.NullInt64   `db:"destination_balance_after" json:"destination_balance_after"`
+	DestinationAmount       sql.NullInt64   `db:"destination_amount" json:"destination_amount"`
+	ExchangeRate            sql.NullString  `db:"exchange_rate" json:"exchange_rate"`
+	QuoteID                 uuid.NullUUID   `db:"quote_id" json:"quote_id"`
+	ReversedTransactionID   uuid.NullUUID   `db:"reversed_transaction_id" json:"reversed_transaction_id"`
+	Reason                  sql.NullString  `db:"reason" json:"reason"`
+	CardNumber              sql.NullString  `db:"card_number" json:"card_number"`
+	PotID                   uuid.NullUUID   `db:"pot_id" json:"pot_id"`
+}
+
+type TransactionCategory struct {
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id"`
+	AccountID     int64     `db:"account_id" json:"account_id"`
+	Category      string    `db:"category" json:"category"`
+}
+
+type TransactionTag struct {
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id"`
+	AccountID     int64     `db:"account_id" json:"account_id"`
+	Tag           string    `db:"tag" json:"tag"`
 }
 
 type User struct {
@@ -6,36 +6,122 @@ package sqlc
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
-	AddAccountBalance(ctx context.Context, db DBTX, arg AddAccountBalanceParams) error
+	//   AND coalesce(sqlc.narg('transaction_type') IS NULL, t.type) = t.type
+	AddAccountBalance(ctx context.Context, db DBTX, arg AddAccountBalanceParams) (int64, error)
+	AddPotBalance(ctx context.Context, db DBTX, arg AddPotBalanceParams) (int64, error)
+	AdvanceStandingOrder(ctx context.Context, db DBTX, arg AdvanceStandingOrderParams) (int64, error)
+	CancelStandingOrder(ctx context.Context, db DBTX, id uuid.UUID) (uuid.UUID, error)
+	CaptureHold(ctx context.Context, db DBTX, arg CaptureHoldParams) (Hold, error)
+	ClearDefaultAccount(ctx context.Context, db DBTX, arg ClearDefaultAccountParams) error
+	CloseAccount(ctx context.Context, db DBTX, id int64) error
+	ClosePaymentRequest(ctx context.Context, db DBTX, arg ClosePaymentRequestParams) (uuid.UUID, error)
+	CopyCardLimits(ctx context.Context, db DBTX, arg CopyCardLimitsParams) error
 	CreateAccount(ctx context.Context, db DBTX, arg CreateAccountParams) error
 	CreateCard(ctx context.Context, db DBTX, arg CreateCardParams) error
-	CreateDepositTransaction(ctx context.Context, db DBTX, arg CreateDepositTransactionParams) error
-	CreateTransferTransaction(ctx context.Context, db DBTX, arg CreateTransferTransactionParams) error
+	CreateCardReveal(ctx context.Context, db DBTX, arg CreateCardRevealParams) error
+	CreateDepositTransaction(ctx context.Context, db DBTX, arg CreateDepositTransactionParams) (uuid.UUID, error)
+	CreateHold(ctx context.Context, db DBTX, arg CreateHoldParams) (Hold, error)
+	CreateJournalEntry(ctx context.Context, db DBTX, arg CreateJournalEntryParams) (uuid.UUID, error)
+	CreatePaymentRequest(ctx context.Context, db DBTX, arg CreatePaymentRequestParams) (uuid.UUID, error)
+	CreatePosting(ctx context.Context, db DBTX, arg CreatePostingParams) error
+	CreatePot(ctx context.Context, db DBTX, arg CreatePotParams) (Pot, error)
+	CreatePotTransaction(ctx context.Context, db DBTX, arg CreatePotTransactionParams) (uuid.UUID, error)
+	CreateQuote(ctx context.Context, db DBTX, arg CreateQuoteParams) (ExchangeQuote, error)
+	CreateReversalTransaction(ctx context.Context, db DBTX, arg CreateReversalTransactionParams) (uuid.UUID, error)
+	CreateSplit(ctx context.Context, db DBTX, arg CreateSplitParams) (uuid.UUID, error)
+	CreateStandingOrder(ctx context.Context, db DBTX, arg CreateStandingOrderParams) (uuid.UUID, error)
+	CreateStandingOrderExecution(ctx context.Context, db DBTX, arg CreateStandingOrderExecutionParams) (StandingOrderExecution, error)
+	CreateTransactionTag(ctx context.Context, db DBTX, arg CreateTransactionTagParams) error
+	CreateTransferTransaction(ctx context.Context, db DBTX, arg CreateTransferTransactionParams) (uuid.UUID, error)
 	CreateUser(ctx context.Context, db DBTX, externalID uuid.UUID) error
-	CreateWithdrawTransaction(ctx context.Context, db DBTX, arg CreateWithdrawTransactionParams) error
-	DeleteAccount(ctx context.Context, db DBTX, id int64) error
+	CreateWithdrawTransaction(ctx context.Context, db DBTX, arg CreateWithdrawTransactionParams) (uuid.UUID, error)
 	DeleteAccountCards(ctx context.Context, db DBTX, accountID int64) error
+	DeleteBudget(ctx context.Context, db DBTX, arg DeleteBudgetParams) (int64, error)
 	DeleteCard(ctx context.Context, db DBTX, number string) error
+	DeletePot(ctx context.Context, db DBTX, id uuid.UUID) (int64, error)
+	DeleteResolvedLedgerMismatches(ctx context.Context, db DBTX) error
+	DeleteTransactionCategory(ctx context.Context, db DBTX, arg DeleteTransactionCategoryParams) error
+	DeleteTransactionTags(ctx context.Context, db DBTX, arg DeleteTransactionTagsParams) error
+	ExpireHolds(ctx context.Context, db DBTX) (int64, error)
+	ExpirePaymentRequests(ctx context.Context, db DBTX) (int64, error)
+	FlagLedgerMismatches(ctx context.Context, db DBTX) ([]LedgerMismatch, error)
 	GetAccount(ctx context.Context, db DBTX, id int64) (GetAccountRow, error)
+	GetAccountBalanceAt(ctx context.Context, db DBTX, arg GetAccountBalanceAtParams) (int64, error)
+	GetAccountBudgets(ctx context.Context, db DBTX, accountID int64) ([]Budget, error)
 	GetAccountCards(ctx context.Context, db DBTX, accountID int64) ([]Card, error)
+	GetAccountLimits(ctx context.Context, db DBTX, accountID sql.NullInt64) ([]SpendingLimit, error)
+	GetAccountPots(ctx context.Context, db DBTX, accountID int64) ([]Pot, error)
+	GetAccountSpending(ctx context.Context, db DBTX, arg GetAccountSpendingParams) (int64, error)
+	GetAccountSystemAccount(ctx context.Context, db DBTX, arg GetAccountSystemAccountParams) (int64, error)
 	GetAccounts(ctx context.Context, db DBTX, userID int64) ([]GetAccountsRow, error)
+	GetBudgets(ctx context.Context, db DBTX, arg GetBudgetsParams) ([]Budget, error)
 	GetCard(ctx context.Context, db DBTX, number string) (Card, error)
 	GetCardAccount(ctx context.Context, db DBTX, number string) (GetCardAccountRow, error)
-	GetCardBalance(ctx context.Context, db DBTX, number string) (float64, error)
+	GetCardBalance(ctx context.Context, db DBTX, number string) (int64, error)
+	GetCardDebited(ctx context.Context, db DBTX, cardNumber string) (int64, error)
+	GetCardLimits(ctx context.Context, db DBTX, cardNumber sql.NullString) ([]SpendingLimit, error)
+	GetCardSpending(ctx context.Context, db DBTX, arg GetCardSpendingParams) (int64, error)
+	GetCurrencies(ctx context.Context, db DBTX) ([]Currency, error)
+	GetCurrencyByCode(ctx context.Context, db DBTX, code string) (Currency, error)
 	GetCurrencyByID(ctx context.Context, db DBTX, id int64) (Currency, error)
-	GetCurrencyByName(ctx context.Context, db DBTX, name string) (int64, error)
+	GetDueStandingOrders(ctx context.Context, db DBTX, arg GetDueStandingOrdersParams) ([]GetDueStandingOrdersRow, error)
+	GetHold(ctx context.Context, db DBTX, id uuid.UUID) (Hold, error)
+	GetIncomingPaymentRequests(ctx context.Context, db DBTX, payerID int64) ([]GetIncomingPaymentRequestsRow, error)
+	GetOutgoingPaymentRequests(ctx context.Context, db DBTX, requesterID int64) ([]GetOutgoingPaymentRequestsRow, error)
+	GetPaymentRequest(ctx context.Context, db DBTX, id uuid.UUID) (GetPaymentRequestRow, error)
+	GetPot(ctx context.Context, db DBTX, id uuid.UUID) (Pot, error)
+	GetQuote(ctx context.Context, db DBTX, id uuid.UUID) (ExchangeQuote, error)
+	GetReceivingAccount(ctx context.Context, db DBTX, arg GetReceivingAccountParams) (int64, error)
+	GetSpendingByCategory(ctx context.Context, db DBTX, arg GetSpendingByCategoryParams) ([]GetSpendingByCategoryRow, error)
+	GetSplit(ctx context.Context, db DBTX, id uuid.UUID) (GetSplitRow, error)
+	GetSplitPaymentRequests(ctx context.Context, db DBTX, splitID uuid.NullUUID) ([]GetSplitPaymentRequestsRow, error)
+	GetStandingOrder(ctx context.Context, db DBTX, id uuid.UUID) (GetStandingOrderRow, error)
+	GetStandingOrderExecutions(ctx context.Context, db DBTX, standingOrderID uuid.UUID) ([]StandingOrderExecution, error)
 	GetTransaction(ctx context.Context, db DBTX, id uuid.UUID) (GetTransactionRow, error)
-	//   AND coalesce(sqlc.narg('transaction_type') IS NULL, t.type) = t.type
+	GetTransactionByIdempotencyKey(ctx context.Context, db DBTX, idempotencyKey sql.NullString) (GetTransactionByIdempotencyKeyRow, error)
+	GetTransactionLimits(ctx context.Context, db DBTX, arg GetTransactionLimitsParams) ([]SpendingLimit, error)
 	GetTransactions(ctx context.Context, db DBTX, arg GetTransactionsParams) ([]GetTransactionsRow, error)
+	GetUnvaultedCards(ctx context.Context, db DBTX) ([]string, error)
 	GetUserByExternalID(ctx context.Context, db DBTX, externalID uuid.UUID) (int64, error)
 	GetUserCards(ctx context.Context, db DBTX, userID int64) ([]GetUserCardsRow, error)
-	SetTransactionRolledBack(ctx context.Context, db DBTX, id uuid.UUID) error
-	SubAccountBalance(ctx context.Context, db DBTX, arg SubAccountBalanceParams) error
+	GetUserExternalID(ctx context.Context, db DBTX, id int64) (uuid.UUID, error)
+	GetVaultedCards(ctx context.Context, db DBTX) ([]GetVaultedCardsRow, error)
+	GetUserPots(ctx context.Context, db DBTX, userID int64) ([]Pot, error)
+	GetUserSplits(ctx context.Context, db DBTX, requesterID int64) ([]uuid.UUID, error)
+	GetUserStandingOrders(ctx context.Context, db DBTX, userID int64) ([]GetUserStandingOrdersRow, error)
+	PayPaymentRequest(ctx context.Context, db DBTX, id uuid.UUID) (uuid.UUID, error)
+	PurgeIdempotencyKeys(ctx context.Context, db DBTX, before time.Time) (int64, error)
+	RenameStandingOrdersCard(ctx context.Context, db DBTX, arg RenameStandingOrdersCardParams) error
+	RenameTransactionsCard(ctx context.Context, db DBTX, arg RenameTransactionsCardParams) error
+	ReplaceStandingOrdersCard(ctx context.Context, db DBTX, arg ReplaceStandingOrdersCardParams) error
+	RetireCard(ctx context.Context, db DBTX, arg RetireCardParams) error
+	SetAccountLimit(ctx context.Context, db DBTX, arg SetAccountLimitParams) error
+	SetAccountStatus(ctx context.Context, db DBTX, arg SetAccountStatusParams) error
+	SetBudget(ctx context.Context, db DBTX, arg SetBudgetParams) (Budget, error)
+	SetBudgetAlerted(ctx context.Context, db DBTX, arg SetBudgetAlertedParams) error
+	SetCardLimit(ctx context.Context, db DBTX, arg SetCardLimitParams) error
+	SetCardNickname(ctx context.Context, db DBTX, arg SetCardNicknameParams) error
+	SetCardPin(ctx context.Context, db DBTX, arg SetCardPinParams) error
+	SetCardPinAttempts(ctx context.Context, db DBTX, arg SetCardPinAttemptsParams) error
+	SetCardStatus(ctx context.Context, db DBTX, arg SetCardStatusParams) error
+	SetDefaultAccount(ctx context.Context, db DBTX, id int64) error
+	SetHoldTransaction(ctx context.Context, db DBTX, arg SetHoldTransactionParams) error
+	SetPaymentRequestTransaction(ctx context.Context, db DBTX, arg SetPaymentRequestTransactionParams) error
+	SetTransactionCategory(ctx context.Context, db DBTX, arg SetTransactionCategoryParams) error
+	SubAccountBalance(ctx context.Context, db DBTX, arg SubAccountBalanceParams) (int64, error)
+	SubPotBalance(ctx context.Context, db DBTX, arg SubPotBalanceParams) (int64, error)
+	UpdatePot(ctx context.Context, db DBTX, arg UpdatePotParams) (Pot, error)
+	UpdateStandingOrder(ctx context.Context, db DBTX, arg UpdateStandingOrderParams) (uuid.UUID, error)
+	UseQuote(ctx context.Context, db DBTX, id uuid.UUID) (uuid.UUID, error)
+	VaultCard(ctx context.Context, db DBTX, arg VaultCardParams) error
+	VoidHold(ctx context.Context, db DBTX, id uuid.UUID) (uuid.UUID, error)
 }
 
 var _ Querier = (*Queries)(nil)
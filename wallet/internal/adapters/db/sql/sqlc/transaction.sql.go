@@ -11,80 +11,337 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
-const createDepositTransaction = `-- name: CreateDepositTransaction :exec
-INSERT INTO transactions(type, amount, destination_account_id)
-VALUES ('deposit', $1, $2)
+const createDepositTransaction = `-- name: CreateDepositTransaction :one
+INSERT INTO transactions(type, amount, destination_account_id, idempotency_key, destination_balance_after, card_number)
+VALUES ('deposit', $1, $2, $3, $4, $5)
+RETURNING id
 `
 
 type CreateDepositTransactionParams struct {
-	Amount               float64       `db:"amount" json:"amount"`
-	DestinationAccountID sql.NullInt64 `db:"destination_account_id" json:"destination_account_id"`
+	Amount                  int64          `db:"amount" json:"amount"`
+	DestinationAccountID    sql.NullInt64  `db:"destination_account_id" json:"destination_account_id"`
+	IdempotencyKey          sql.NullString `db:"idempotency_key" json:"idempotency_key"`
+	DestinationBalanceAfter sql.NullInt64  `db:"destination_balance_after" json:"destination_balance_after"`
+	CardNumber              sql.NullString `db:"card_number" json:"card_number"`
 }
 
-func (q *Queries) CreateDepositTransaction(ctx context.Context, db DBTX, arg CreateDepositTransactionParams) error {
-	_, err := db.ExecContext(ctx, createDepositTransaction, arg.Amount, arg.DestinationAccountID)
+func (q *Queries) CreateDepositTransaction(ctx context.Context, db DBTX, arg CreateDepositTransactionParams) (uuid.UUID, error) {
+	row := db.QueryRowContext(ctx, createDepositTransaction,
+		arg.Amount,
+		arg.DestinationAccountID,
+		arg.IdempotencyKey,
+		arg.DestinationBalanceAfter,
+		arg.CardNumber,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createPotTransaction = `-- name: CreatePotTransaction :one
+INSERT INTO transactions(type, amount, source_account_id, destination_account_id, source_balance_after,
+                         destination_balance_after, pot_id)
+VALUES ('pot', $1, $2, $3, $4, $5, $6)
+RETURNING id
+`
+
+type CreatePotTransactionParams struct {
+	Amount                  int64         `db:"amount" json:"amount"`
+	SourceAccountID         sql.NullInt64 `db:"source_account_id" json:"source_account_id"`
+	DestinationAccountID    sql.NullInt64 `db:"destination_account_id" json:"destination_account_id"`
+	SourceBalanceAfter      sql.NullInt64 `db:"source_balance_after" json:"source_balance_after"`
+	DestinationBalanceAfter sql.NullInt64 `db:"destination_balance_after" json:"destination_balance_after"`
+	PotID                   uuid.NullUUID `db:"pot_id" json:"pot_id"`
+}
+
+func (q *Queries) CreatePotTransaction(ctx context.Context, db DBTX, arg CreatePotTransactionParams) (uuid.UUID, error) {
+	row := db.QueryRowContext(ctx, createPotTransaction,
+		arg.Amount,
+		arg.SourceAccountID,
+		arg.DestinationAccountID,
+		arg.SourceBalanceAfter,
+		arg.DestinationBalanceAfter,
+		arg.PotID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createReversalTransaction = `-- name: CreateReversalTransaction :one
+INSERT INTO transactions (type, amount, source_account_id, destination_account_id, idempotency_key,
+                          source_balance_after, destination_balance_after, destination_amount, exchange_rate,
+                          reversed_transaction_id, reason)
+VALUES ('reversal', $1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+RETURNING id
+`
+
+type CreateReversalTransactionParams struct {
+	Amount                  int64          `db:"amount" json:"amount"`
+	SourceAccountID         sql.NullInt64  `db:"source_account_id" json:"source_account_id"`
+	DestinationAccountID    sql.NullInt64  `db:"destination_account_id" json:"destination_account_id"`
+	IdempotencyKey          sql.NullString `db:"idempotency_key" json:"idempotency_key"`
+	SourceBalanceAfter      sql.NullInt64  `db:"source_balance_after" json:"source_balance_after"`
+	DestinationBalanceAfter sql.NullInt64  `db:"destination_balance_after" json:"destination_balance_after"`
+	DestinationAmount       sql.NullInt64  `db:"destination_amount" json:"destination_amount"`
+	ExchangeRate            sql.NullString `db:"exchange_rate" json:"exchange_rate"`
+	ReversedTransactionID   uuid.NullUUID  `db:"reversed_transaction_id" json:"reversed_transaction_id"`
+	Reason                  sql.NullString `db:"reason" json:"reason"`
+}
+
+func (q *Queries) CreateReversalTransaction(ctx context.Context, db DBTX, arg CreateReversalTransactionParams) (uuid.UUID, error) {
+	row := db.QueryRowContext(ctx, createReversalTransaction,
+		arg.Amount,
+		arg.SourceAccountID,
+		arg.DestinationAccountID,
+		arg.IdempotencyKey,
+		arg.SourceBalanceAfter,
+		arg.DestinationBalanceAfter,
+		arg.DestinationAmount,
+		arg.ExchangeRate,
+		arg.ReversedTransactionID,
+		arg.Reason,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTransactionTag = `-- name: CreateTransactionTag :exec
+INSERT INTO transaction_tags (transaction_id, account_id, tag)
+VALUES ($1, $2, $3)
+ON CONFLICT DO NOTHING
+`
+
+type CreateTransactionTagParams struct {
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id"`
+	AccountID     int64     `db:"account_id" json:"account_id"`
+	Tag           string    `db:"tag" json:"tag"`
+}
+
+func (q *Queries) CreateTransactionTag(ctx context.Context, db DBTX, arg CreateTransactionTagParams) error {
+	_, err := db.ExecContext(ctx, createTransactionTag, arg.TransactionID, arg.AccountID, arg.Tag)
 	return err
 }
 
-const createTransferTransaction = `-- name: CreateTransferTransaction :exec
-INSERT INTO transactions (type, amount, source_account_id, destination_account_id)
-VALUES ('transfer', $1, $2, $3)
+const createTransferTransaction = `-- name: CreateTransferTransaction :one
+INSERT INTO transactions (type, amount, source_account_id, destination_account_id, idempotency_key,
+                          source_balance_after, destination_balance_after, destination_amount, exchange_rate,
+                          quote_id, reason, card_number)
+VALUES ('transfer', $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+RETURNING id
 `
 
 type CreateTransferTransactionParams struct {
-	Amount               float64       `db:"amount" json:"amount"`
-	SourceAccountID      sql.NullInt64 `db:"source_account_id" json:"source_account_id"`
-	DestinationAccountID sql.NullInt64 `db:"destination_account_id" json:"destination_account_id"`
+	Amount                  int64          `db:"amount" json:"amount"`
+	SourceAccountID         sql.NullInt64  `db:"source_account_id" json:"source_account_id"`
+	DestinationAccountID    sql.NullInt64  `db:"destination_account_id" json:"destination_account_id"`
+	IdempotencyKey          sql.NullString `db:"idempotency_key" json:"idempotency_key"`
+	SourceBalanceAfter      sql.NullInt64  `db:"source_balance_after" json:"source_balance_after"`
+	DestinationBalanceAfter sql.NullInt64  `db:"destination_balance_after" json:"destination_balance_after"`
+	DestinationAmount       sql.NullInt64  `db:"destination_amount" json:"destination_amount"`
+	ExchangeRate            sql.NullString `db:"exchange_rate" json:"exchange_rate"`
+	QuoteID                 uuid.NullUUID  `db:"quote_id" json:"quote_id"`
+	Reason                  sql.NullString `db:"reason" json:"reason"`
+	CardNumber              sql.NullString `db:"card_number" json:"card_number"`
 }
 
-func (q *Queries) CreateTransferTransaction(ctx context.Context, db DBTX, arg CreateTransferTransactionParams) error {
-	_, err := db.ExecContext(ctx, createTransferTransaction, arg.Amount, arg.SourceAccountID, arg.DestinationAccountID)
-	return err
+func (q *Queries) CreateTransferTransaction(ctx context.Context, db DBTX, arg CreateTransferTransactionParams) (uuid.UUID, error) {
+	row := db.QueryRowContext(ctx, createTransferTransaction,
+		arg.Amount,
+		arg.SourceAccountID,
+		arg.DestinationAccountID,
+		arg.IdempotencyKey,
+		arg.SourceBalanceAfter,
+		arg.DestinationBalanceAfter,
+		arg.DestinationAmount,
+		arg.ExchangeRate,
+		arg.QuoteID,
+		arg.Reason,
+		arg.CardNumber,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
 }
 
-const createWithdrawTransaction = `-- name: CreateWithdrawTransaction :exec
-INSERT INTO transactions(type, amount, source_account_id)
-VALUES ('withdrawal', $1, $2)
+const createWithdrawTransaction = `-- name: CreateWithdrawTransaction :one
+INSERT INTO transactions(type, amount, source_account_id, idempotency_key, source_balance_after, card_number)
+VALUES ('withdrawal', $1, $2, $3, $4, $5)
+RETURNING id
 `
 
 type CreateWithdrawTransactionParams struct {
-	Amount          float64       `db:"amount" json:"amount"`
-	SourceAccountID sql.NullInt64 `db:"source_account_id" json:"source_account_id"`
+	Amount             int64          `db:"amount" json:"amount"`
+	SourceAccountID    sql.NullInt64  `db:"source_account_id" json:"source_account_id"`
+	IdempotencyKey     sql.NullString `db:"idempotency_key" json:"idempotency_key"`
+	SourceBalanceAfter sql.NullInt64  `db:"source_balance_after" json:"source_balance_after"`
+	CardNumber         sql.NullString `db:"card_number" json:"card_number"`
+}
+
+func (q *Queries) CreateWithdrawTransaction(ctx context.Context, db DBTX, arg CreateWithdrawTransactionParams) (uuid.UUID, error) {
+	row := db.QueryRowContext(ctx, createWithdrawTransaction,
+		arg.Amount,
+		arg.SourceAccountID,
+		arg.IdempotencyKey,
+		arg.SourceBalanceAfter,
+		arg.CardNumber,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
 }
 
-func (q *Queries) CreateWithdrawTransaction(ctx context.Context, db DBTX, arg CreateWithdrawTransactionParams) error {
-	_, err := db.ExecContext(ctx, createWithdrawTransaction, arg.Amount, arg.SourceAccountID)
+const deleteTransactionCategory = `-- name: DeleteTransactionCategory :exec
+DELETE
+FROM transaction_categories
+WHERE transaction_id = $1
+  AND account_id = $2
+`
+
+type DeleteTransactionCategoryParams struct {
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id"`
+	AccountID     int64     `db:"account_id" json:"account_id"`
+}
+
+func (q *Queries) DeleteTransactionCategory(ctx context.Context, db DBTX, arg DeleteTransactionCategoryParams) error {
+	_, err := db.ExecContext(ctx, deleteTransactionCategory, arg.TransactionID, arg.AccountID)
 	return err
 }
 
+const deleteTransactionTags = `-- name: DeleteTransactionTags :exec
+DELETE
+FROM transaction_tags
+WHERE transaction_id = $1
+  AND account_id = $2
+`
+
+type DeleteTransactionTagsParams struct {
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id"`
+	AccountID     int64     `db:"account_id" json:"account_id"`
+}
+
+func (q *Queries) DeleteTransactionTags(ctx context.Context, db DBTX, arg DeleteTransactionTagsParams) error {
+	_, err := db.ExecContext(ctx, deleteTransactionTags, arg.TransactionID, arg.AccountID)
+	return err
+}
+
+const getSpendingByCategory = `-- name: GetSpendingByCategory :many
+SELECT s.category::VARCHAR  as category,
+       count(*)::BIGINT      as count,
+       sum(s.amount)::BIGINT as amount
+FROM (SELECT coalesce(tc.category, suggest_transaction_category(t.type, destination.name)) as category,
+             t.amount - (SELECT coalesce(sum(coalesce(r.destination_amount, r.amount)), 0)
+                         FROM transactions r
+                         WHERE r.reversed_transaction_id = t.id)                      as amount
+      FROM transactions t
+             LEFT JOIN accounts destination on destination.id = t.destination_account_id
+             LEFT JOIN transaction_categories tc on tc.transaction_id = t.id AND tc.account_id = t.source_account_id
+      WHERE t.source_account_id = $1
+        AND t.type IN ('transfer', 'withdrawal')
+        AND NOT t.is_rolled_back
+        AND t.created_at >= $2::TIMESTAMP
+        AND t.created_at < $3::TIMESTAMP) s
+WHERE s.amount > 0
+GROUP BY s.category
+ORDER BY amount DESC, s.category
+`
+
+type GetSpendingByCategoryParams struct {
+	AccountID int64     `db:"account_id" json:"account_id"`
+	FromTime  time.Time `db:"from_time" json:"from_time"`
+	ToTime    time.Time `db:"to_time" json:"to_time"`
+}
+
+type GetSpendingByCategoryRow struct {
+	Category string `db:"category" json:"category"`
+	Count    int64  `db:"count" json:"count"`
+	Amount   int64  `db:"amount" json:"amount"`
+}
+
+func (q *Queries) GetSpendingByCategory(ctx context.Context, db DBTX, arg GetSpendingByCategoryParams) ([]GetSpendingByCategoryRow, error) {
+	rows, err := db.QueryContext(ctx, getSpendingByCategory, arg.AccountID, arg.FromTime, arg.ToTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetSpendingByCategoryRow{}
+	for rows.Next() {
+		var i GetSpendingByCategoryRow
+		if err := rows.Scan(&i.Category, &i.Count, &i.Amount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTransaction = `-- name: GetTransaction :one
 SELECT t.id,
        t.type,
        t.amount,
        source.id        as from_account_id,
-       source.name      as from_account_name,
+       coalesce(source.name, p.name) as from_account_name,
        destination.id   as to_account_id,
-       destination.name as to_account_name,
+       coalesce(destination.name, p.name) as to_account_name,
        t.created_at,
-       t.is_rolled_back
+       t.is_rolled_back,
+       cur.code         as currency_name,
+       t.idempotency_key,
+       t.source_balance_after,
+       t.destination_balance_after,
+       t.destination_amount,
+       t.exchange_rate,
+       dcur.code        as destination_currency_name,
+       t.reversed_transaction_id,
+       t.reason,
+       t.pot_id,
+       (SELECT coalesce(sum(coalesce(r.destination_amount, r.amount)), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_amount,
+       (SELECT coalesce(sum(r.amount), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_to_amount
 FROM transactions t
        LEFT JOIN accounts destination on destination.id = t.destination_account_id
        LEFT JOIN accounts source on source.id = t.source_account_id
+       LEFT JOIN pots p on p.id = t.pot_id
+       LEFT JOIN currency cur on cur.id = coalesce(source.currency_id, destination.currency_id)
+       LEFT JOIN currency dcur on dcur.id = destination.currency_id
 WHERE t.id = $1
 `
 
 type GetTransactionRow struct {
-	ID              uuid.UUID       `db:"id" json:"id"`
-	Type            TransactionType `db:"type" json:"type"`
-	Amount          float64         `db:"amount" json:"amount"`
-	FromAccountID   sql.NullInt64   `db:"from_account_id" json:"from_account_id"`
-	FromAccountName sql.NullString  `db:"from_account_name" json:"from_account_name"`
-	ToAccountID     sql.NullInt64   `db:"to_account_id" json:"to_account_id"`
-	ToAccountName   sql.NullString  `db:"to_account_name" json:"to_account_name"`
-	CreatedAt       time.Time       `db:"created_at" json:"created_at"`
-	IsRolledBack    bool            `db:"is_rolled_back" json:"is_rolled_back"`
+	ID                      uuid.UUID       `db:"id" json:"id"`
+	Type                    TransactionType `db:"type" json:"type"`
+	Amount                  int64           `db:"amount" json:"amount"`
+	FromAccountID           sql.NullInt64   `db:"from_account_id" json:"from_account_id"`
+	FromAccountName         sql.NullString  `db:"from_account_name" json:"from_account_name"`
+	ToAccountID             sql.NullInt64   `db:"to_account_id" json:"to_account_id"`
+	ToAccountName           sql.NullString  `db:"to_account_name" json:"to_account_name"`
+	CreatedAt               time.Time       `db:"created_at" json:"created_at"`
+	IsRolledBack            bool            `db:"is_rolled_back" json:"is_rolled_back"`
+	CurrencyName            sql.NullString  `db:"currency_name" json:"currency_name"`
+	IdempotencyKey          sql.NullString  `db:"idempotency_key" json:"idempotency_key"`
+	SourceBalanceAfter      sql.NullInt64   `db:"source_balance_after" json:"source_balance_after"`
+	DestinationBalanceAfter sql.NullInt64   `db:"destination_balance_after" json:"destination_balance_after"`
+	DestinationAmount       sql.NullInt64   `db:"destination_amount" json:"destination_amount"`
+	ExchangeRate            sql.NullString  `db:"exchange_rate" json:"exchange_rate"`
+	DestinationCurrencyName sql.NullString  `db:"destination_currency_name" json:"destination_currency_name"`
+	ReversedTransactionID   uuid.NullUUID   `db:"reversed_transaction_id" json:"reversed_transaction_id"`
+	Reason                  sql.NullString  `db:"reason" json:"reason"`
+	PotID                   uuid.NullUUID   `db:"pot_id" json:"pot_id"`
+	ReversedAmount          int64           `db:"reversed_amount" json:"reversed_amount"`
+	ReversedToAmount        int64           `db:"reversed_to_amount" json:"reversed_to_amount"`
 }
 
 func (q *Queries) GetTransaction(ctx context.Context, db DBTX, id uuid.UUID) (GetTransactionRow, error) {
@@ -100,6 +357,106 @@ func (q *Queries) GetTransaction(ctx context.Context, db DBTX, id uuid.UUID) (Ge
 		&i.ToAccountName,
 		&i.CreatedAt,
 		&i.IsRolledBack,
+		&i.CurrencyName,
+		&i.IdempotencyKey,
+		&i.SourceBalanceAfter,
+		&i.DestinationBalanceAfter,
+		&i.DestinationAmount,
+		&i.ExchangeRate,
+		&i.DestinationCurrencyName,
+		&i.ReversedTransactionID,
+		&i.Reason,
+		&i.PotID,
+		&i.ReversedAmount,
+		&i.ReversedToAmount,
+	)
+	return i, err
+}
+
+const getTransactionByIdempotencyKey = `-- name: GetTransactionByIdempotencyKey :one
+SELECT t.id,
+       t.type,
+       t.amount,
+       source.id        as from_account_id,
+       coalesce(source.name, p.name) as from_account_name,
+       destination.id   as to_account_id,
+       coalesce(destination.name, p.name) as to_account_name,
+       t.created_at,
+       t.is_rolled_back,
+       cur.code         as currency_name,
+       t.idempotency_key,
+       t.source_balance_after,
+       t.destination_balance_after,
+       t.destination_amount,
+       t.exchange_rate,
+       dcur.code        as destination_currency_name,
+       t.reversed_transaction_id,
+       t.reason,
+       t.pot_id,
+       (SELECT coalesce(sum(coalesce(r.destination_amount, r.amount)), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_amount,
+       (SELECT coalesce(sum(r.amount), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_to_amount
+FROM transactions t
+       LEFT JOIN accounts destination on destination.id = t.destination_account_id
+       LEFT JOIN accounts source on source.id = t.source_account_id
+       LEFT JOIN pots p on p.id = t.pot_id
+       LEFT JOIN currency cur on cur.id = coalesce(source.currency_id, destination.currency_id)
+       LEFT JOIN currency dcur on dcur.id = destination.currency_id
+WHERE t.idempotency_key = $1
+`
+
+type GetTransactionByIdempotencyKeyRow struct {
+	ID                      uuid.UUID       `db:"id" json:"id"`
+	Type                    TransactionType `db:"type" json:"type"`
+	Amount                  int64           `db:"amount" json:"amount"`
+	FromAccountID           sql.NullInt64   `db:"from_account_id" json:"from_account_id"`
+	FromAccountName         sql.NullString  `db:"from_account_name" json:"from_account_name"`
+	ToAccountID             sql.NullInt64   `db:"to_account_id" json:"to_account_id"`
+	ToAccountName           sql.NullString  `db:"to_account_name" json:"to_account_name"`
+	CreatedAt               time.Time       `db:"created_at" json:"created_at"`
+	IsRolledBack            bool            `db:"is_rolled_back" json:"is_rolled_back"`
+	CurrencyName            sql.NullString  `db:"currency_name" json:"currency_name"`
+	IdempotencyKey          sql.NullString  `db:"idempotency_key" json:"idempotency_key"`
+	SourceBalanceAfter      sql.NullInt64   `db:"source_balance_after" json:"source_balance_after"`
+	DestinationBalanceAfter sql.NullInt64   `db:"destination_balance_after" json:"destination_balance_after"`
+	DestinationAmount       sql.NullInt64   `db:"destination_amount" json:"destination_amount"`
+	ExchangeRate            sql.NullString  `db:"exchange_rate" json:"exchange_rate"`
+	DestinationCurrencyName sql.NullString  `db:"destination_currency_name" json:"destination_currency_name"`
+	ReversedTransactionID   uuid.NullUUID   `db:"reversed_transaction_id" json:"reversed_transaction_id"`
+	Reason                  sql.NullString  `db:"reason" json:"reason"`
+	PotID                   uuid.NullUUID   `db:"pot_id" json:"pot_id"`
+	ReversedAmount          int64           `db:"reversed_amount" json:"reversed_amount"`
+	ReversedToAmount        int64           `db:"reversed_to_amount" json:"reversed_to_amount"`
+}
+
+func (q *Queries) GetTransactionByIdempotencyKey(ctx context.Context, db DBTX, idempotencyKey sql.NullString) (GetTransactionByIdempotencyKeyRow, error) {
+	row := db.QueryRowContext(ctx, getTransactionByIdempotencyKey, idempotencyKey)
+	var i GetTransactionByIdempotencyKeyRow
+	err := row.Scan(
+		&i.ID,
+		&i.Type,
+		&i.Amount,
+		&i.FromAccountID,
+		&i.FromAccountName,
+		&i.ToAccountID,
+		&i.ToAccountName,
+		&i.CreatedAt,
+		&i.IsRolledBack,
+		&i.CurrencyName,
+		&i.IdempotencyKey,
+		&i.SourceBalanceAfter,
+		&i.DestinationBalanceAfter,
+		&i.DestinationAmount,
+		&i.ExchangeRate,
+		&i.DestinationCurrencyName,
+		&i.ReversedTransactionID,
+		&i.Reason,
+		&i.PotID,
+		&i.ReversedAmount,
+		&i.ReversedToAmount,
 	)
 	return i, err
 }
@@ -108,46 +465,129 @@ const getTransactions = `-- name: GetTransactions :many
 SELECT t.id,
        t.amount,
        t.type,
-       source.name      as from_account_name,
-       destination.name as to_account_name,
+       source.id        as from_account_id,
+       coalesce(source.name, p.name) as from_account_name,
+       destination.id   as to_account_id,
+       coalesce(destination.name, p.name) as to_account_name,
        t.created_at,
-       t.is_rolled_back
+       t.is_rolled_back,
+       cur.code         as currency_name,
+       t.source_balance_after,
+       t.destination_balance_after,
+       t.destination_amount,
+       t.exchange_rate,
+       dcur.code        as destination_currency_name,
+       t.reversed_transaction_id,
+       t.reason,
+       t.pot_id,
+       (SELECT coalesce(sum(coalesce(r.destination_amount, r.amount)), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_amount,
+       (SELECT coalesce(sum(r.amount), 0)
+        FROM transactions r
+        WHERE r.reversed_transaction_id = t.id)::BIGINT as reversed_to_amount,
+       tc.category,
+       suggest_transaction_category(t.type, CASE
+                                              WHEN source.id = $1 THEN coalesce(destination.name, p.name)
+                                              ELSE coalesce(source.name, p.name) END)::VARCHAR as category_suggested,
+       (SELECT coalesce(array_agg(tt.tag ORDER BY tt.tag), '{}')
+        FROM transaction_tags tt
+        WHERE tt.transaction_id = t.id
+          AND tt.account_id = $1)::VARCHAR[] as tags
 FROM transactions t
        LEFT JOIN accounts destination on destination.id = t.destination_account_id
        LEFT JOIN accounts source on source.id = t.source_account_id
+       LEFT JOIN pots p on p.id = t.pot_id
+       LEFT JOIN currency cur on cur.id = coalesce(source.currency_id, destination.currency_id)
+       LEFT JOIN currency dcur on dcur.id = destination.currency_id
+       LEFT JOIN transaction_categories tc on tc.transaction_id = t.id AND tc.account_id = $1
 WHERE (source.id = $1
   OR destination.id = $1)
-  AND coalesce($2, t.amount) <= t.amount
-  AND coalesce($3, t.amount) >= t.amount
-ORDER BY created_at DESC
-LIMIT $5 OFFSET $4
+  AND ($2::transaction_type IS NULL OR t.type = $2)
+  AND coalesce($3, t.amount) <= t.amount
+  AND coalesce($4, t.amount) >= t.amount
+  AND ($5::TIMESTAMP IS NULL OR t.created_at >= $5)
+  AND ($6::TIMESTAMP IS NULL OR t.created_at < $6)
+  AND ($7::VARCHAR IS NULL
+  OR ($7 = 'incoming' AND destination.id = $1)
+  OR ($7 = 'outgoing' AND source.id = $1))
+  AND ($8::VARCHAR IS NULL
+  OR strpos(lower(CASE
+                    WHEN source.id = $1 THEN coalesce(destination.name, p.name, 'ATM')
+                    ELSE coalesce(source.name, p.name, 'ATM') END), lower($8)) > 0)
+  AND ($9::VARCHAR IS NULL
+  OR coalesce(tc.category, suggest_transaction_category(t.type, CASE
+                                                                  WHEN source.id = $1
+                                                                    THEN coalesce(destination.name, p.name)
+                                                                  ELSE coalesce(source.name, p.name) END)) = $9)
+  AND ($10::VARCHAR IS NULL
+  OR EXISTS(SELECT 1
+            FROM transaction_tags tt
+            WHERE tt.transaction_id = t.id
+              AND tt.account_id = $1
+              AND tt.tag = $10))
+  AND ($11::TIMESTAMP IS NULL
+  OR (t.created_at, t.id) < ($11, $12::UUID))
+ORDER BY t.created_at DESC, t.id DESC
+LIMIT $13
 `
 
 type GetTransactionsParams struct {
-	AccountID int64           `db:"account_id" json:"account_id"`
-	MinAmount sql.NullFloat64 `db:"min_amount" json:"min_amount"`
-	MaxAmount sql.NullFloat64 `db:"max_amount" json:"max_amount"`
-	Offset    int32           `db:"offset" json:"offset"`
-	Limit     int32           `db:"limit" json:"limit"`
+	AccountID       int64               `db:"account_id" json:"account_id"`
+	TransactionType NullTransactionType `db:"transaction_type" json:"transaction_type"`
+	MinAmount       sql.NullInt64       `db:"min_amount" json:"min_amount"`
+	MaxAmount       sql.NullInt64       `db:"max_amount" json:"max_amount"`
+	FromTime        sql.NullTime        `db:"from_time" json:"from_time"`
+	ToTime          sql.NullTime        `db:"to_time" json:"to_time"`
+	Direction       sql.NullString      `db:"direction" json:"direction"`
+	Counterparty    sql.NullString      `db:"counterparty" json:"counterparty"`
+	Category        sql.NullString      `db:"category" json:"category"`
+	Tag             sql.NullString      `db:"tag" json:"tag"`
+	CursorCreatedAt sql.NullTime        `db:"cursor_created_at" json:"cursor_created_at"`
+	CursorID        uuid.NullUUID       `db:"cursor_id" json:"cursor_id"`
+	Limit           int32               `db:"limit" json:"limit"`
 }
 
 type GetTransactionsRow struct {
-	ID              uuid.UUID       `db:"id" json:"id"`
-	Amount          float64         `db:"amount" json:"amount"`
-	Type            TransactionType `db:"type" json:"type"`
-	FromAccountName sql.NullString  `db:"from_account_name" json:"from_account_name"`
-	ToAccountName   sql.NullString  `db:"to_account_name" json:"to_account_name"`
-	CreatedAt       time.Time       `db:"created_at" json:"created_at"`
-	IsRolledBack    bool            `db:"is_rolled_back" json:"is_rolled_back"`
+	ID                      uuid.UUID       `db:"id" json:"id"`
+	Amount                  int64           `db:"amount" json:"amount"`
+	Type                    TransactionType `db:"type" json:"type"`
+	FromAccountID           sql.NullInt64   `db:"from_account_id" json:"from_account_id"`
+	FromAccountName         sql.NullString  `db:"from_account_name" json:"from_account_name"`
+	ToAccountID             sql.NullInt64   `db:"to_account_id" json:"to_account_id"`
+	ToAccountName           sql.NullString  `db:"to_account_name" json:"to_account_name"`
+	CreatedAt               time.Time       `db:"created_at" json:"created_at"`
+	IsRolledBack            bool            `db:"is_rolled_back" json:"is_rolled_back"`
+	CurrencyName            sql.NullString  `db:"currency_name" json:"currency_name"`
+	SourceBalanceAfter      sql.NullInt64   `db:"source_balance_after" json:"source_balance_after"`
+	DestinationBalanceAfter sql.NullInt64   `db:"destination_balance_after" json:"destination_balance_after"`
+	DestinationAmount       sql.NullInt64   `db:"destination_amount" json:"destination_amount"`
+	ExchangeRate            sql.NullString  `db:"exchange_rate" json:"exchange_rate"`
+	DestinationCurrencyName sql.NullString  `db:"destination_currency_name" json:"destination_currency_name"`
+	ReversedTransactionID   uuid.NullUUID   `db:"reversed_transaction_id" json:"reversed_transaction_id"`
+	Reason                  sql.NullString  `db:"reason" json:"reason"`
+	PotID                   uuid.NullUUID   `db:"pot_id" json:"pot_id"`
+	ReversedAmount          int64           `db:"reversed_amount" json:"reversed_amount"`
+	ReversedToAmount        int64           `db:"reversed_to_amount" json:"reversed_to_amount"`
+	Category                sql.NullString  `db:"category" json:"category"`
+	CategorySuggested       string          `db:"category_suggested" json:"category_suggested"`
+	Tags                    []string        `db:"tags" json:"tags"`
 }
 
-// AND coalesce(sqlc.narg('transaction_type') IS NULL, t.type) = t.type
 func (q *Queries) GetTransactions(ctx context.Context, db DBTX, arg GetTransactionsParams) ([]GetTransactionsRow, error) {
 	rows, err := db.QueryContext(ctx, getTransactions,
 		arg.AccountID,
+		arg.TransactionType,
 		arg.MinAmount,
 		arg.MaxAmount,
-		arg.Offset,
+		arg.FromTime,
+		arg.ToTime,
+		arg.Direction,
+		arg.Counterparty,
+		arg.Category,
+		arg.Tag,
+		arg.CursorCreatedAt,
+		arg.CursorID,
 		arg.Limit,
 	)
 	if err != nil {
@@ -161,10 +601,26 @@ func (q *Queries) GetTransactions(ctx context.Context, db DBTX, arg GetTransacti
 			&i.ID,
 			&i.Amount,
 			&i.Type,
+			&i.FromAccountID,
 			&i.FromAccountName,
+			&i.ToAccountID,
 			&i.ToAccountName,
 			&i.CreatedAt,
 			&i.IsRolledBack,
+			&i.CurrencyName,
+			&i.SourceBalanceAfter,
+			&i.DestinationBalanceAfter,
+			&i.DestinationAmount,
+			&i.ExchangeRate,
+			&i.DestinationCurrencyName,
+			&i.ReversedTransactionID,
+			&i.Reason,
+			&i.PotID,
+			&i.ReversedAmount,
+			&i.ReversedToAmount,
+			&i.Category,
+			&i.CategorySuggested,
+			pq.Array(&i.Tags),
 		); err != nil {
 			return nil, err
 		}
@@ -179,13 +635,50 @@ func (q *Queries) GetTransactions(ctx context.Context, db DBTX, arg GetTransacti
 	return items, nil
 }
 
-const setTransactionRolledBack = `-- name: SetTransactionRolledBack :exec
+const purgeIdempotencyKeys = `-- name: PurgeIdempotencyKeys :execrows
+UPDATE transactions
+SET idempotency_key = NULL
+WHERE idempotency_key IS NOT NULL
+  AND created_at < $1::TIMESTAMP
+`
+
+func (q *Queries) PurgeIdempotencyKeys(ctx context.Context, db DBTX, before time.Time) (int64, error) {
+	result, err := db.ExecContext(ctx, purgeIdempotencyKeys, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const renameTransactionsCard = `-- name: RenameTransactionsCard :exec
 UPDATE transactions
-SET is_rolled_back = true
-WHERE id = $1
+SET card_number = $1::VARCHAR
+WHERE card_number = $2
 `
 
-func (q *Queries) SetTransactionRolledBack(ctx context.Context, db DBTX, id uuid.UUID) error {
-	_, err := db.ExecContext(ctx, setTransactionRolledBack, id)
+type RenameTransactionsCardParams struct {
+	NewCard string         `db:"new_card" json:"new_card"`
+	OldCard sql.NullString `db:"old_card" json:"old_card"`
+}
+
+func (q *Queries) RenameTransactionsCard(ctx context.Context, db DBTX, arg RenameTransactionsCardParams) error {
+	_, err := db.ExecContext(ctx, renameTransactionsCard, arg.NewCard, arg.OldCard)
+	return err
+}
+
+const setTransactionCategory = `-- name: SetTransactionCategory :exec
+INSERT INTO transaction_categories (transaction_id, account_id, category)
+VALUES ($1, $2, $3)
+ON CONFLICT (transaction_id, account_id) DO UPDATE SET category = excluded.category
+`
+
+type SetTransactionCategoryParams struct {
+	TransactionID uuid.UUID `db:"transaction_id" json:"transaction_id"`
+	AccountID     int64     `db:"account_id" json:"account_id"`
+	Category      string    `db:"category" json:"category"`
+}
+
+func (q *Queries) SetTransactionCategory(ctx context.Context, db DBTX, arg SetTransactionCategoryParams) error {
+	_, err := db.ExecContext(ctx, setTransactionCategory, arg.TransactionID, arg.AccountID, arg.Category)
 	return err
 }
@@ -34,3 +34,16 @@ func (q *Queries) GetUserByExternalID(ctx context.Context, db DBTX, externalID u
 	err := row.Scan(&id)
 	return id, err
 }
+
+const getUserExternalID = `-- name: GetUserExternalID :one
+SELECT external_id
+FROM users
+WHERE id = $1
+`
+
+func (q *Queries) GetUserExternalID(ctx context.Context, db DBTX, id int64) (uuid.UUID, error) {
+	row := db.QueryRowContext(ctx, getUserExternalID, id)
+	var external_id uuid.UUID
+	err := row.Scan(&external_id)
+	return external_id, err
+}
@@ -0,0 +1,293 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+)
+
+type StandingOrderRepository struct {
+	q  *sqlc.Queries
+	db *sql.DB
+}
+
+func NewStandingOrderRepository(db *sql.DB) *StandingOrderRepository {
+	return &StandingOrderRepository{db: db, q: sqlc.New()}
+}
+
+// CreateStandingOrder stores an active standing order due at `params.NextRunAt`
+func (r *StandingOrderRepository) CreateStandingOrder(ctx context.Context, params core.CreateStandingOrderParams) (core.StandingOrder, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "StandingOrderRepository.CreateStandingOrder")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.StandingOrder{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	// Get currency id
+	currency, err := r.q.GetCurrencyByCode(ctx, tx, params.Currency.String())
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.StandingOrder{}, errorNotFound(err, "currency not found")
+		} else {
+			return core.StandingOrder{}, errorQuery(err, "failed to get currency")
+		}
+	}
+	cron, dayOfMonth := toDBSchedule(params.Schedule)
+	orderID, err := r.q.CreateStandingOrder(ctx, tx, sqlc.CreateStandingOrderParams{
+		UserID:     params.UserID,
+		FromCard:   params.FromCard,
+		ToCard:     params.ToCard,
+		Amount:     params.Amount,
+		CurrencyID: currency.ID,
+		Cron:       cron,
+		DayOfMonth: dayOfMonth,
+		EndDate:    toNullTime(params.EndDate),
+		NextRunAt:  toNullTime(params.NextRunAt),
+	})
+	if err != nil {
+		return core.StandingOrder{}, errorQuery(err, "failed to create standing order")
+	}
+	return getStandingOrder(ctx, r.q, tx, orderID)
+}
+
+// GetStandingOrder returns a standing order by its ID
+func (r *StandingOrderRepository) GetStandingOrder(ctx context.Context, orderID uuid.UUID) (core.StandingOrder, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "StandingOrderRepository.GetStandingOrder")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.StandingOrder{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	return getStandingOrder(ctx, r.q, tx, orderID)
+}
+
+// GetStandingOrders returns all the standing orders of a user, cancelled & completed ones included
+func (r *StandingOrderRepository) GetStandingOrders(ctx context.Context, userID int64) ([]core.StandingOrder, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "StandingOrderRepository.GetStandingOrders")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	orders, err := r.q.GetUserStandingOrders(ctx, tx, userID)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get standing orders")
+	}
+	result := make([]core.StandingOrder, len(orders))
+	for i, o := range orders {
+		result[i] = fromDBStandingOrderRowToStandingOrder(sqlc.GetStandingOrderRow(o))
+	}
+	return result, nil
+}
+
+// UpdateStandingOrder replaces the amount, schedule & end date of an active standing order
+func (r *StandingOrderRepository) UpdateStandingOrder(ctx context.Context, params core.UpdateStandingOrderParams) (core.StandingOrder, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "StandingOrderRepository.UpdateStandingOrder")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.StandingOrder{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	cron, dayOfMonth := toDBSchedule(params.Schedule)
+	orderID, err := r.q.UpdateStandingOrder(ctx, tx, sqlc.UpdateStandingOrderParams{
+		ID:         params.ID,
+		Amount:     params.Amount,
+		Cron:       cron,
+		DayOfMonth: dayOfMonth,
+		EndDate:    toNullTime(params.EndDate),
+		NextRunAt:  toNullTime(params.NextRunAt),
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.StandingOrder{}, errorStandingOrderEnded
+		} else {
+			return core.StandingOrder{}, errorQuery(err, "failed to update standing order")
+		}
+	}
+	return getStandingOrder(ctx, r.q, tx, orderID)
+}
+
+// CancelStandingOrder stops an active standing order, its executions are kept
+func (r *StandingOrderRepository) CancelStandingOrder(ctx context.Context, orderID uuid.UUID) error {
+	ctx, span := tracer.Tracer().Start(ctx, "StandingOrderRepository.CancelStandingOrder")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	_, err = r.q.CancelStandingOrder(ctx, tx, orderID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return errorStandingOrderEnded
+		} else {
+			return errorQuery(err, "failed to cancel standing order")
+		}
+	}
+	return nil
+}
+
+// GetDueStandingOrders returns up to `limit` active standing orders due at `now`, the most overdue first
+func (r *StandingOrderRepository) GetDueStandingOrders(ctx context.Context, now time.Time, limit int32) ([]core.StandingOrder, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "StandingOrderRepository.GetDueStandingOrders")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	orders, err := r.q.GetDueStandingOrders(ctx, tx, sqlc.GetDueStandingOrdersParams{
+		NextRunAt: toNullTime(now),
+		Limit:     limit,
+	})
+	if err != nil {
+		return nil, errorQuery(err, "failed to get due standing orders")
+	}
+	result := make([]core.StandingOrder, len(orders))
+	for i, o := range orders {
+		result[i] = fromDBStandingOrderRowToStandingOrder(sqlc.GetStandingOrderRow{
+			ID:         o.ID,
+			UserID:     o.UserID,
+			FromCard:   o.FromCard,
+			ToCard:     o.ToCard,
+			Amount:     o.Amount,
+			CurrencyID: o.CurrencyID,
+			Cron:       o.Cron,
+			DayOfMonth: o.DayOfMonth,
+			EndDate:    o.EndDate,
+			NextRunAt:  o.NextRunAt,
+			Status:     o.Status,
+			CreatedAt:  o.CreatedAt,
+			UpdatedAt:  o.UpdatedAt,
+			Currency:   o.Currency,
+		})
+		result[i].UserExternalID = o.ExternalID
+	}
+	return result, nil
+}
+
+// RecordExecution stores the outcome of a run & moves the order to its next run, or completes it
+// It fails if the run was already recorded or the order was rescheduled, completed or cancelled meanwhile
+func (r *StandingOrderRepository) RecordExecution(ctx context.Context, params core.RecordStandingOrderExecutionParams) (core.StandingOrderExecution, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "StandingOrderRepository.RecordExecution")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.StandingOrderExecution{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	advanced, err := r.q.AdvanceStandingOrder(ctx, tx, sqlc.AdvanceStandingOrderParams{
+		NextRunAt:   toNullTime(params.NextRunAt),
+		ID:          params.StandingOrderID,
+		ScheduledAt: toNullTime(params.ScheduledAt),
+	})
+	if err != nil {
+		return core.StandingOrderExecution{}, errorQuery(err, "failed to advance standing order")
+	}
+	if advanced == 0 {
+		err = errorStandingOrderNotDue
+		return core.StandingOrderExecution{}, err
+	}
+	execution, err := r.q.CreateStandingOrderExecution(ctx, tx, sqlc.CreateStandingOrderExecutionParams{
+		StandingOrderID: params.StandingOrderID,
+		ScheduledAt:     params.ScheduledAt,
+		Status:          string(params.Status),
+		TransactionID:   uuid.NullUUID{UUID: params.TransactionID, Valid: params.TransactionID != uuid.Nil},
+		FailureReason:   toNullString(params.FailureReason),
+	})
+	if err != nil {
+		if IsUniqueViolationError(err) {
+			return core.StandingOrderExecution{}, errorUniqueViolation(err, "standing order run already executed")
+		} else {
+			return core.StandingOrderExecution{}, errorQuery(err, "failed to create standing order execution")
+		}
+	}
+	return fromDBStandingOrderExecutionToStandingOrderExecution(execution), nil
+}
+
+// GetExecutions returns the executions of a standing order, the latest run first
+func (r *StandingOrderRepository) GetExecutions(ctx context.Context, orderID uuid.UUID) ([]core.StandingOrderExecution, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "StandingOrderRepository.GetExecutions")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	executions, err := r.q.GetStandingOrderExecutions(ctx, tx, orderID)
+	if err != nil {
+		return nil, errorQuery(err, "failed to get standing order executions")
+	}
+	result := make([]core.StandingOrderExecution, len(executions))
+	for i, e := range executions {
+		result[i] = fromDBStandingOrderExecutionToStandingOrderExecution(e)
+	}
+	return result, nil
+}
+
+func getStandingOrder(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, orderID uuid.UUID) (core.StandingOrder, error) {
+	order, err := q.GetStandingOrder(ctx, tx, orderID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.StandingOrder{}, errorNotFound(err, "standing order not found")
+		} else {
+			return core.StandingOrder{}, errorQuery(err, "failed to get standing order")
+		}
+	}
+	return fromDBStandingOrderRowToStandingOrder(order), nil
+}
+
+// fromDBStandingOrderRowToStandingOrder converts a sqlc.GetStandingOrderRow to a core.StandingOrder
+func fromDBStandingOrderRowToStandingOrder(o sqlc.GetStandingOrderRow) core.StandingOrder {
+	return core.StandingOrder{
+		ID:       o.ID,
+		UserID:   o.UserID,
+		FromCard: o.FromCard,
+		ToCard:   o.ToCard,
+		Amount:   o.Amount,
+		Currency: core.Currency(o.Currency),
+		Schedule: core.Schedule{
+			Cron:       o.Cron.String,
+			DayOfMonth: int(o.DayOfMonth.Int16),
+		},
+		EndDate:   o.EndDate.Time,
+		NextRunAt: o.NextRunAt.Time,
+		Status:    core.StandingOrderStatus(o.Status),
+		CreatedAt: o.CreatedAt,
+		UpdatedAt: o.UpdatedAt,
+	}
+}
+
+// fromDBStandingOrderExecutionToStandingOrderExecution converts a sqlc.StandingOrderExecution to a core.StandingOrderExecution
+func fromDBStandingOrderExecutionToStandingOrderExecution(e sqlc.StandingOrderExecution) core.StandingOrderExecution {
+	return core.StandingOrderExecution{
+		ID:              e.ID,
+		StandingOrderID: e.StandingOrderID,
+		ScheduledAt:     e.ScheduledAt,
+		Status:          core.StandingOrderExecutionStatus(e.Status),
+		TransactionID:   e.TransactionID.UUID,
+		FailureReason:   e.FailureReason.String,
+		ExecutedAt:      e.ExecutedAt,
+	}
+}
+
+// toDBSchedule converts a core.Schedule to its cron & day of month columns, exactly one of them is set
+func toDBSchedule(s core.Schedule) (sql.NullString, sql.NullInt16) {
+	if s.Cron != "" {
+		return sql.NullString{String: s.Cron, Valid: true}, sql.NullInt16{}
+	}
+	return sql.NullString{}, sql.NullInt16{Int16: int16(s.DayOfMonth), Valid: true}
+}
+
+func toNullTime(t time.Time) sql.NullTime {
+	return sql.NullTime{Time: t, Valid: !t.IsZero()}
+}
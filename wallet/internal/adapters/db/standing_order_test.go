@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandingOrderRepository(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+	sr := NewStandingOrderRepository(conn)
+
+	now := time.Now().UTC().Truncate(time.Minute)
+	schedule := core.Schedule{DayOfMonth: 1}
+	order, err := sr.CreateStandingOrder(ctx, core.CreateStandingOrderParams{
+		UserID:    userID,
+		FromCard:  gofakeit.CreditCardNumber(nil),
+		ToCard:    gofakeit.CreditCardNumber(nil),
+		Amount:    500,
+		Currency:  core.CurrencyUSD,
+		Schedule:  schedule,
+		NextRunAt: now.Add(-time.Minute),
+	})
+	require.NoError(t, err)
+	require.Equal(t, core.StandingOrderStatusActive, order.Status)
+	require.Equal(t, schedule, order.Schedule)
+	require.Equal(t, core.CurrencyUSD, order.Currency)
+	require.True(t, order.EndDate.IsZero())
+
+	// The order is due & carries its owner's external id
+	due, err := sr.GetDueStandingOrders(ctx, now, 1000)
+	require.NoError(t, err)
+	var found bool
+	for _, o := range due {
+		if o.ID == order.ID {
+			found = true
+			require.NotEqual(t, uuid.Nil, o.UserExternalID)
+		}
+	}
+	require.True(t, found)
+
+	// Record a failed run & move to the next one
+	next := schedule.Next(now)
+	execution, err := sr.RecordExecution(ctx, core.RecordStandingOrderExecutionParams{
+		StandingOrderID: order.ID,
+		ScheduledAt:     order.NextRunAt,
+		Status:          core.StandingOrderExecutionStatusFailed,
+		FailureReason:   "no sufficient balance to perform transaction",
+		NextRunAt:       next,
+	})
+	require.NoError(t, err)
+	require.Equal(t, core.StandingOrderExecutionStatusFailed, execution.Status)
+
+	// The same run can't be recorded twice
+	_, err = sr.RecordExecution(ctx, core.RecordStandingOrderExecutionParams{
+		StandingOrderID: order.ID,
+		ScheduledAt:     order.NextRunAt,
+		Status:          core.StandingOrderExecutionStatusFailed,
+		NextRunAt:       next,
+	})
+	require.Error(t, err)
+
+	got, err := sr.GetStandingOrder(ctx, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, next, got.NextRunAt.UTC())
+
+	// Update the schedule & amount
+	updated, err := sr.UpdateStandingOrder(ctx, core.UpdateStandingOrderParams{
+		ID:        order.ID,
+		Amount:    700,
+		Schedule:  core.Schedule{Cron: "0 9 * * 1"},
+		EndDate:   now.AddDate(1, 0, 0),
+		NextRunAt: next,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(700), updated.Amount)
+	require.Equal(t, "0 9 * * 1", updated.Schedule.Cron)
+	require.Zero(t, updated.Schedule.DayOfMonth)
+
+	// Completing the order on its last run
+	_, err = sr.RecordExecution(ctx, core.RecordStandingOrderExecutionParams{
+		StandingOrderID: order.ID,
+		ScheduledAt:     next,
+		Status:          core.StandingOrderExecutionStatusSucceeded,
+	})
+	require.NoError(t, err)
+	got, err = sr.GetStandingOrder(ctx, order.ID)
+	require.NoError(t, err)
+	require.Equal(t, core.StandingOrderStatusCompleted, got.Status)
+	require.True(t, got.NextRunAt.IsZero())
+
+	executions, err := sr.GetExecutions(ctx, order.ID)
+	require.NoError(t, err)
+	require.Len(t, executions, 2)
+	require.Equal(t, core.StandingOrderExecutionStatusSucceeded, executions[0].Status)
+	require.Equal(t, "no sufficient balance to perform transaction", executions[1].FailureReason)
+
+	// Ended orders can't be updated or cancelled
+	require.Error(t, sr.CancelStandingOrder(ctx, order.ID))
+
+	orders, err := sr.GetStandingOrders(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/escalopa/fingo/pkg/tracer"
 	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
@@ -20,124 +21,150 @@ func NewTransactionRepository(db *sql.DB) *TransactionRepository {
 }
 
 // Transfer transfers money from one account to another
-func (r *TransactionRepository) Transfer(ctx context.Context, params core.CreateTransactionParams) error {
+func (r *TransactionRepository) Transfer(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.CreateTransaction")
 	defer span.End()
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errorTxNotStarted(err)
+		return core.Transaction{}, errorTxNotStarted(err)
 	}
 	defer func() { err = deferTx(tx, err) }()
-	// Add money to destination account
-	err = r.q.AddAccountBalance(ctx, tx, sqlc.AddAccountBalanceParams{
-		ID:      params.ToAccountID,
-		Balance: params.Amount,
-	})
+	transaction, err := transfer(ctx, r.q, tx, params)
 	if err != nil {
-		if IsNotFoundError(err) {
-			return errorNotFound(err, "destination account not found")
-		} else {
-			return errorQuery(err, "failed to add money to destination account")
-		}
+		return core.Transaction{}, err
 	}
-	// Subtract money from source account
-	err = r.q.SubAccountBalance(ctx, tx, sqlc.SubAccountBalanceParams{
-		ID:      params.FromAccountID,
-		Balance: params.Amount,
-	})
+	return transaction, nil
+}
+
+// BatchTransfer moves money for all the transfers within a single db transaction, either all or none of them are created
+func (r *TransactionRepository) BatchTransfer(ctx context.Context, params []core.CreateTransactionParams) ([]core.Transaction, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.BatchTransfer")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		if IsNotFoundError(err) {
-			return errorNotFound(err, "source account not found")
-		} else {
-			return errorQuery(err, "failed to subtract money from source account")
-		}
+		return nil, errorTxNotStarted(err)
 	}
-	// Create transaction
-	err = r.q.CreateTransferTransaction(ctx, tx, sqlc.CreateTransferTransactionParams{
-		SourceAccountID:      sql.NullInt64{Int64: params.FromAccountID, Valid: true},
-		DestinationAccountID: sql.NullInt64{Int64: params.ToAccountID, Valid: true},
-		Amount:               params.Amount,
-	})
-	if err != nil {
-		if IsUniqueViolationError(err) {
-			return errorUniqueViolation(err, "transaction id already exists")
-		} else {
-			return errorQuery(err, "failed to create transaction")
+	defer func() { err = deferTx(tx, err) }()
+	transactions := make([]core.Transaction, len(params))
+	for i, p := range params {
+		transactions[i], err = transfer(ctx, r.q, tx, p)
+		if err != nil {
+			return nil, err
 		}
 	}
-	return nil
+	return transactions, nil
 }
 
 // Deposit adds money to an account and creates a transaction
-func (r *TransactionRepository) Deposit(ctx context.Context, params core.CreateTransactionParams) error {
+func (r *TransactionRepository) Deposit(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.Deposit")
 	defer span.End()
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errorTxNotStarted(err)
+		return core.Transaction{}, errorTxNotStarted(err)
 	}
 	defer func() { err = deferTx(tx, err) }()
-	// Add money to source account
-	err = r.q.AddAccountBalance(ctx, tx, sqlc.AddAccountBalanceParams{
+	// Add money to destination account
+	toBalance, err := r.q.AddAccountBalance(ctx, tx, sqlc.AddAccountBalanceParams{
 		ID:      params.ToAccountID,
 		Balance: params.Amount,
 	})
 	if err != nil {
 		if IsNotFoundError(err) {
-			return errorNotFound(err, "source account not found")
+			return core.Transaction{}, errorNotFound(err, "source account not found")
 		} else {
-			return errorQuery(err, "failed to subtract money from source account")
+			return core.Transaction{}, errorQuery(err, "failed to subtract money from source account")
 		}
 	}
 	// Create transaction
-	err = r.q.CreateDepositTransaction(ctx, tx, sqlc.CreateDepositTransactionParams{
-		DestinationAccountID: sql.NullInt64{Int64: params.ToAccountID, Valid: true},
-		Amount:               params.Amount,
+	id, err := r.q.CreateDepositTransaction(ctx, tx, sqlc.CreateDepositTransactionParams{
+		DestinationAccountID:    sql.NullInt64{Int64: params.ToAccountID, Valid: true},
+		Amount:                  params.Amount,
+		IdempotencyKey:          toNullString(params.IdempotencyKey),
+		DestinationBalanceAfter: sql.NullInt64{Int64: toBalance, Valid: true},
+		CardNumber:              toNullString(params.Card),
 	})
 	if err != nil {
 		if IsUniqueViolationError(err) {
-			return errorUniqueViolation(err, "transaction id already exists")
+			return core.Transaction{}, errorUniqueViolation(err, "transaction with this id or idempotency key already exists")
 		} else {
-			return errorQuery(err, "failed to create transaction")
+			return core.Transaction{}, errorQuery(err, "failed to create transaction")
 		}
 	}
-	return nil
+	// Record the movement in the ledger, the cash comes from the ATM
+	atmID, err := getSystemAccountID(ctx, r.q, tx, systemAccountATM, params.ToAccountID)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	err = createJournalEntry(ctx, r.q, tx, id, string(sqlc.TransactionTypeDeposit),
+		posting{systemAccountID: atmID, amount: -params.Amount},
+		posting{accountID: params.ToAccountID, amount: params.Amount},
+	)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	// Read back the created transaction
+	transaction, err := getTransaction(ctx, r.q, tx, id)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	return transaction, nil
 }
 
 // Withdraw subtracts money from an account and creates a transaction
-func (r *TransactionRepository) Withdraw(ctx context.Context, params core.CreateTransactionParams) error {
+func (r *TransactionRepository) Withdraw(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.Withdraw")
 	defer span.End()
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
-		return errorTxNotStarted(err)
+		return core.Transaction{}, errorTxNotStarted(err)
 	}
 	defer func() { err = deferTx(tx, err) }()
 	// Subtract money from source account
-	err = r.q.SubAccountBalance(ctx, tx, sqlc.SubAccountBalanceParams{
+	fromBalance, err := r.q.SubAccountBalance(ctx, tx, sqlc.SubAccountBalanceParams{
 		ID:      params.FromAccountID,
 		Balance: params.Amount,
 	})
 	if err != nil {
 		if IsNotFoundError(err) {
-			return errorNotFound(err, "source account not found")
+			return core.Transaction{}, errorNoSufficientFunds
 		} else {
-			return errorQuery(err, "failed to subtract money from source account")
+			return core.Transaction{}, errorQuery(err, "failed to subtract money from source account")
 		}
 	}
 	// Create transaction
-	err = r.q.CreateWithdrawTransaction(ctx, tx, sqlc.CreateWithdrawTransactionParams{
-		SourceAccountID: sql.NullInt64{Int64: params.FromAccountID, Valid: true},
-		Amount:          params.Amount,
+	id, err := r.q.CreateWithdrawTransaction(ctx, tx, sqlc.CreateWithdrawTransactionParams{
+		SourceAccountID:    sql.NullInt64{Int64: params.FromAccountID, Valid: true},
+		Amount:             params.Amount,
+		IdempotencyKey:     toNullString(params.IdempotencyKey),
+		SourceBalanceAfter: sql.NullInt64{Int64: fromBalance, Valid: true},
+		CardNumber:         toNullString(params.Card),
 	})
 	if err != nil {
 		if IsUniqueViolationError(err) {
-			return errorUniqueViolation(err, "transaction id already exists")
+			return core.Transaction{}, errorUniqueViolation(err, "transaction with this id or idempotency key already exists")
 		} else {
-			return errorQuery(err, "failed to create transaction")
+			return core.Transaction{}, errorQuery(err, "failed to create transaction")
 		}
 	}
-	return nil
+	// Record the movement in the ledger, the cash leaves through the ATM
+	atmID, err := getSystemAccountID(ctx, r.q, tx, systemAccountATM, params.FromAccountID)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	err = createJournalEntry(ctx, r.q, tx, id, string(sqlc.TransactionTypeWithdrawal),
+		posting{accountID: params.FromAccountID, amount: -params.Amount},
+		posting{systemAccountID: atmID, amount: params.Amount},
+	)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	// Read back the created transaction
+	transaction, err := getTransaction(ctx, r.q, tx, id)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	return transaction, nil
 }
 
 // GetTransaction returns a transaction by its ID
@@ -149,7 +176,19 @@ func (r *TransactionRepository) GetTransaction(ctx context.Context, transactionI
 		return core.Transaction{}, errorTxNotStarted(err)
 	}
 	defer func() { err = deferTx(tx, err) }()
-	transaction, err := r.q.GetTransaction(ctx, tx, transactionID)
+	return getTransaction(ctx, r.q, tx, transactionID)
+}
+
+// GetTransactionByIdempotencyKey returns the transaction created with the given idempotency key
+func (r *TransactionRepository) GetTransactionByIdempotencyKey(ctx context.Context, key string) (core.Transaction, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.GetTransactionByIdempotencyKey")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Transaction{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	transaction, err := r.q.GetTransactionByIdempotencyKey(ctx, tx, toNullString(key))
 	if err != nil {
 		if IsNotFoundError(err) {
 			return core.Transaction{}, errorNotFound(err, "transaction not found")
@@ -157,11 +196,10 @@ func (r *TransactionRepository) GetTransaction(ctx context.Context, transactionI
 			return core.Transaction{}, errorQuery(err, "failed to get transaction")
 		}
 	}
-	coreTx := fromDBTransactionRowToTransaction(transaction)
-	return coreTx, nil
+	return fromDBTransactionRowToTransaction(sqlc.GetTransactionRow(transaction)), nil
 }
 
-// GetTransactions returns a list of transactions for a given account with filters(pagination, date range, etc)
+// GetTransactions returns a page of the transactions of a given account, newest first, with filters(date range, type, etc)
 func (r *TransactionRepository) GetTransactions(ctx context.Context, params core.GetTransactionsParams) ([]core.Transaction, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.GetTransactions")
 	defer span.End()
@@ -170,19 +208,24 @@ func (r *TransactionRepository) GetTransactions(ctx context.Context, params core
 		return nil, errorTxNotStarted(err)
 	}
 	defer func() { err = deferTx(tx, err) }()
-	// Get transactions
-	//var t sqlc.NullTransactionType
-	//err = t.Scan(params.Type.String())
-	//if err != nil {
-	//	return nil, errorQuery(err, "failed to convert transaction type")
-	//}
+	// Get the page of transactions after the cursor
 	transactions, err := r.q.GetTransactions(ctx, tx, sqlc.GetTransactionsParams{
 		AccountID: params.AccountID,
 		Limit:     params.Limit,
-		Offset:    params.Offset,
-		MinAmount: sql.NullFloat64{Float64: params.MinAmount, Valid: params.MinAmount > 0},
-		MaxAmount: sql.NullFloat64{Float64: params.MaxAmount, Valid: params.MaxAmount > 0},
-		//TransactionType: t,
+		TransactionType: sqlc.NullTransactionType{
+			TransactionType: sqlc.TransactionType(params.Type),
+			Valid:           params.Type != "",
+		},
+		MinAmount:       sql.NullInt64{Int64: params.MinAmount, Valid: params.MinAmount > 0},
+		MaxAmount:       sql.NullInt64{Int64: params.MaxAmount, Valid: params.MaxAmount > 0},
+		FromTime:        toNullTime(params.From),
+		ToTime:          toNullTime(params.To),
+		Direction:       toNullString(string(params.Direction)),
+		Counterparty:    toNullString(params.Counterparty),
+		Category:        toNullString(params.Category.String()),
+		Tag:             toNullString(params.Tag),
+		CursorCreatedAt: sql.NullTime{Time: params.After.CreatedAt, Valid: !params.After.IsZero()},
+		CursorID:        uuid.NullUUID{UUID: params.After.ID, Valid: !params.After.IsZero()},
 	})
 	if err != nil {
 		return nil, errorQuery(err, "failed to get transactions")
@@ -195,76 +238,369 @@ func (r *TransactionRepository) GetTransactions(ctx context.Context, params core
 	return res, nil
 }
 
-// RollbackTransaction deletes a transaction and restores the balance of the involved accounts
-func (r *TransactionRepository) RollbackTransaction(ctx context.Context, transactionID uuid.UUID) error {
-	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.RollbackTransaction")
+// ReverseTransaction moves back part or all of the refundable amount of a transaction with a linked reversal transaction
+// Transfers are moved back from the destination account at their original rate, deposits are taken back to the ATM
+// and withdrawals are credited back from the ATM, the reversed transaction is left untouched
+func (r *TransactionRepository) ReverseTransaction(ctx context.Context, params core.ReverseTransactionParams) (core.Transaction, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.ReverseTransaction")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return core.Transaction{}, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	transaction, err := reverse(ctx, r.q, tx, params)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	return transaction, nil
+}
+
+// SetTransactionLabels replaces the category & the tags an account gave to a transaction
+func (r *TransactionRepository) SetTransactionLabels(ctx context.Context, params core.SetTransactionLabelsParams) error {
+	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.SetTransactionLabels")
 	defer span.End()
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return errorTxNotStarted(err)
 	}
 	defer func() { err = deferTx(tx, err) }()
-	// Get transaction
-	transaction, err := r.q.GetTransaction(ctx, tx, transactionID)
+	// Set the category, or clear it to go back to the suggested one
+	if params.Category != "" {
+		err = r.q.SetTransactionCategory(ctx, tx, sqlc.SetTransactionCategoryParams{
+			TransactionID: params.TransactionID,
+			AccountID:     params.AccountID,
+			Category:      params.Category.String(),
+		})
+	} else {
+		err = r.q.DeleteTransactionCategory(ctx, tx, sqlc.DeleteTransactionCategoryParams{
+			TransactionID: params.TransactionID,
+			AccountID:     params.AccountID,
+		})
+	}
 	if err != nil {
-		if IsNotFoundError(err) {
-			return errorNotFound(err, "transaction not found")
-		} else {
-			return errorQuery(err, "failed to get transaction")
+		return errorQuery(err, "failed to set transaction category")
+	}
+	// Replace the tags
+	err = r.q.DeleteTransactionTags(ctx, tx, sqlc.DeleteTransactionTagsParams{
+		TransactionID: params.TransactionID,
+		AccountID:     params.AccountID,
+	})
+	if err != nil {
+		return errorQuery(err, "failed to delete transaction tags")
+	}
+	for _, tag := range params.Tags {
+		err = r.q.CreateTransactionTag(ctx, tx, sqlc.CreateTransactionTagParams{
+			TransactionID: params.TransactionID,
+			AccountID:     params.AccountID,
+			Tag:           tag,
+		})
+		if err != nil {
+			return errorQuery(err, "failed to create transaction tag")
 		}
 	}
-	if transaction.Type != sqlc.TransactionTypeTransfer {
-		return errorRollbackUnsupported
+	return nil
+}
+
+// GetSpendingByCategory returns the amounts debited from an account by category within [from, to), net of the reversals
+func (r *TransactionRepository) GetSpendingByCategory(ctx context.Context, accountID int64, from, to time.Time) ([]core.CategorySpending, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.GetSpendingByCategory")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, errorTxNotStarted(err)
 	}
-	// Set transaction as rolled back
-	err = r.q.SetTransactionRolledBack(ctx, tx, transactionID)
+	defer func() { err = deferTx(tx, err) }()
+	spending, err := r.q.GetSpendingByCategory(ctx, tx, sqlc.GetSpendingByCategoryParams{
+		AccountID: accountID,
+		FromTime:  from,
+		ToTime:    to,
+	})
 	if err != nil {
-		return errorQuery(err, "failed to set transaction as rolled back")
+		return nil, errorQuery(err, "failed to get spending by category")
+	}
+	res := make([]core.CategorySpending, len(spending))
+	for i, s := range spending {
+		res[i] = core.CategorySpending{Category: core.Category(s.Category), Amount: s.Amount, Count: s.Count}
 	}
-	// Add money to source account
-	err = r.q.AddAccountBalance(ctx, tx, sqlc.AddAccountBalanceParams{
-		ID:      transaction.FromAccountID.Int64,
-		Balance: transaction.Amount,
+	return res, nil
+}
+
+// PurgeIdempotencyKeys clears the idempotency keys of the transactions created before `before` and returns how many were cleared
+// Cleared keys can be used again, the unique index only holds the keys still in their retention window
+func (r *TransactionRepository) PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "TransactionRepository.PurgeIdempotencyKeys")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	purged, err := r.q.PurgeIdempotencyKeys(ctx, tx, before)
+	if err != nil {
+		return 0, errorQuery(err, "failed to purge idempotency keys")
+	}
+	return purged, nil
+}
+
+// transfer moves money from one account to another within the given db transaction
+func transfer(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, params core.CreateTransactionParams) (core.Transaction, error) {
+	// Consume the quote of cross-currency transfers
+	exchange := params.QuoteID != uuid.Nil
+	toAmount := params.Amount
+	if exchange {
+		_, err := q.UseQuote(ctx, tx, params.QuoteID)
+		if err != nil {
+			if IsNotFoundError(err) {
+				return core.Transaction{}, errorQuoteUsed
+			} else {
+				return core.Transaction{}, errorQuery(err, "failed to use quote")
+			}
+		}
+		toAmount = params.ToAmount
+	}
+	// Add money to destination account
+	toBalance, err := q.AddAccountBalance(ctx, tx, sqlc.AddAccountBalanceParams{
+		ID:      params.ToAccountID,
+		Balance: toAmount,
+	})
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Transaction{}, errorNotFound(err, "destination account not found")
+		} else {
+			return core.Transaction{}, errorQuery(err, "failed to add money to destination account")
+		}
+	}
+	// Subtract money from source account
+	fromBalance, err := q.SubAccountBalance(ctx, tx, sqlc.SubAccountBalanceParams{
+		ID:      params.FromAccountID,
+		Balance: params.Amount,
 	})
 	if err != nil {
-		return errorQuery(err, "failed to add money to source account")
+		if IsNotFoundError(err) {
+			return core.Transaction{}, errorNoSufficientFunds
+		} else {
+			return core.Transaction{}, errorQuery(err, "failed to subtract money from source account")
+		}
 	}
-	// Subtract money from destination account
-	err = r.q.SubAccountBalance(ctx, tx, sqlc.SubAccountBalanceParams{
-		ID:      transaction.ToAccountID.Int64,
-		Balance: transaction.Amount,
+	// Create transaction
+	id, err := q.CreateTransferTransaction(ctx, tx, sqlc.CreateTransferTransactionParams{
+		SourceAccountID:         sql.NullInt64{Int64: params.FromAccountID, Valid: true},
+		DestinationAccountID:    sql.NullInt64{Int64: params.ToAccountID, Valid: true},
+		Amount:                  params.Amount,
+		IdempotencyKey:          toNullString(params.IdempotencyKey),
+		SourceBalanceAfter:      sql.NullInt64{Int64: fromBalance, Valid: true},
+		DestinationBalanceAfter: sql.NullInt64{Int64: toBalance, Valid: true},
+		DestinationAmount:       sql.NullInt64{Int64: params.ToAmount, Valid: exchange},
+		ExchangeRate:            sql.NullString{String: params.ExchangeRate, Valid: exchange},
+		QuoteID:                 uuid.NullUUID{UUID: params.QuoteID, Valid: exchange},
+		Reason:                  toNullString(params.Reason),
+		CardNumber:              toNullString(params.Card),
 	})
 	if err != nil {
-		return errorQuery(err, "failed to subtract money from destination account")
+		if IsUniqueViolationError(err) {
+			return core.Transaction{}, errorUniqueViolation(err, "transaction with this id or idempotency key already exists")
+		} else {
+			return core.Transaction{}, errorQuery(err, "failed to create transaction")
+		}
 	}
-	return nil
+	// Record the movement in the ledger
+	postings, err := transferPostings(ctx, q, tx, params.FromAccountID, params.ToAccountID, params.Amount, toAmount, exchange)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	err = createJournalEntry(ctx, q, tx, id, string(sqlc.TransactionTypeTransfer), postings...)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	// Read back the created transaction
+	transaction, err := getTransaction(ctx, q, tx, id)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	return transaction, nil
+}
+
+// reverse creates the reversal of a transaction within the given db transaction
+func reverse(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, params core.ReverseTransactionParams) (core.Transaction, error) {
+	original, err := getTransaction(ctx, q, tx, params.TransactionID)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	if original.Type == core.TransactionTypeReversal {
+		return core.Transaction{}, errorReversalUnsupported
+	}
+	if params.Amount > original.RefundableAmount() {
+		return core.Transaction{}, errorReversalExceeded
+	}
+	reversal := sqlc.CreateReversalTransactionParams{
+		Amount:                params.Amount,
+		IdempotencyKey:        toNullString(params.IdempotencyKey),
+		ReversedTransactionID: uuid.NullUUID{UUID: original.ID, Valid: true},
+		Reason:                toNullString(params.Reason),
+	}
+	var postings []posting
+	switch original.Type {
+	case core.TransactionTypeTransfer:
+		// Take back the converted amount from the destination & credit the source with the reversed amount
+		exchange := original.ExchangeRate != ""
+		toAmount := original.ReversalToAmount(params.Amount)
+		fromBalance, err := q.SubAccountBalance(ctx, tx, sqlc.SubAccountBalanceParams{
+			ID:      original.ToAccountID,
+			Balance: toAmount,
+		})
+		if err != nil {
+			if IsNotFoundError(err) {
+				return core.Transaction{}, errorNoSufficientFunds
+			}
+			return core.Transaction{}, errorQuery(err, "failed to subtract money from destination account")
+		}
+		toBalance, err := q.AddAccountBalance(ctx, tx, sqlc.AddAccountBalanceParams{
+			ID:      original.FromAccountID,
+			Balance: params.Amount,
+		})
+		if err != nil {
+			return core.Transaction{}, errorQuery(err, "failed to add money to source account")
+		}
+		reversal.Amount = toAmount
+		reversal.SourceAccountID = sql.NullInt64{Int64: original.ToAccountID, Valid: true}
+		reversal.DestinationAccountID = sql.NullInt64{Int64: original.FromAccountID, Valid: true}
+		reversal.SourceBalanceAfter = sql.NullInt64{Int64: fromBalance, Valid: true}
+		reversal.DestinationBalanceAfter = sql.NullInt64{Int64: toBalance, Valid: true}
+		reversal.DestinationAmount = sql.NullInt64{Int64: params.Amount, Valid: exchange}
+		reversal.ExchangeRate = sql.NullString{String: original.ExchangeRate, Valid: exchange}
+		postings, err = transferPostings(ctx, q, tx, original.ToAccountID, original.FromAccountID, toAmount, params.Amount, exchange)
+		if err != nil {
+			return core.Transaction{}, err
+		}
+	case core.TransactionTypeDeposit:
+		// Take the money back to the ATM
+		fromBalance, err := q.SubAccountBalance(ctx, tx, sqlc.SubAccountBalanceParams{
+			ID:      original.ToAccountID,
+			Balance: params.Amount,
+		})
+		if err != nil {
+			if IsNotFoundError(err) {
+				return core.Transaction{}, errorNoSufficientFunds
+			}
+			return core.Transaction{}, errorQuery(err, "failed to subtract money from destination account")
+		}
+		reversal.SourceAccountID = sql.NullInt64{Int64: original.ToAccountID, Valid: true}
+		reversal.SourceBalanceAfter = sql.NullInt64{Int64: fromBalance, Valid: true}
+		atmID, err := getSystemAccountID(ctx, q, tx, systemAccountATM, original.ToAccountID)
+		if err != nil {
+			return core.Transaction{}, err
+		}
+		postings = []posting{
+			{accountID: original.ToAccountID, amount: -params.Amount},
+			{systemAccountID: atmID, amount: params.Amount},
+		}
+	case core.TransactionTypeWithdrawal:
+		// Credit the money back from the ATM
+		toBalance, err := q.AddAccountBalance(ctx, tx, sqlc.AddAccountBalanceParams{
+			ID:      original.FromAccountID,
+			Balance: params.Amount,
+		})
+		if err != nil {
+			return core.Transaction{}, errorQuery(err, "failed to add money to source account")
+		}
+		reversal.DestinationAccountID = sql.NullInt64{Int64: original.FromAccountID, Valid: true}
+		reversal.DestinationBalanceAfter = sql.NullInt64{Int64: toBalance, Valid: true}
+		atmID, err := getSystemAccountID(ctx, q, tx, systemAccountATM, original.FromAccountID)
+		if err != nil {
+			return core.Transaction{}, err
+		}
+		postings = []posting{
+			{accountID: original.FromAccountID, amount: params.Amount},
+			{systemAccountID: atmID, amount: -params.Amount},
+		}
+	default:
+		return core.Transaction{}, errorReversalTypeUnsupported
+	}
+	id, err := q.CreateReversalTransaction(ctx, tx, reversal)
+	if err != nil {
+		if IsUniqueViolationError(err) {
+			return core.Transaction{}, errorUniqueViolation(err, "transaction with this id or idempotency key already exists")
+		} else {
+			return core.Transaction{}, errorQuery(err, "failed to create reversal transaction")
+		}
+	}
+	// Record the movement in the ledger
+	err = createJournalEntry(ctx, q, tx, id, string(sqlc.TransactionTypeReversal), postings...)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	return getTransaction(ctx, q, tx, id)
+}
+
+// getTransaction reads a transaction by its ID within the given db transaction
+func getTransaction(ctx context.Context, q *sqlc.Queries, tx *sql.Tx, transactionID uuid.UUID) (core.Transaction, error) {
+	transaction, err := q.GetTransaction(ctx, tx, transactionID)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return core.Transaction{}, errorNotFound(err, "transaction not found")
+		} else {
+			return core.Transaction{}, errorQuery(err, "failed to get transaction")
+		}
+	}
+	return fromDBTransactionRowToTransaction(transaction), nil
 }
 
 // fromDBTransactionRowToTransaction converts a sqlc.GetTransactionRow to a core.Transaction
 func fromDBTransactionRowToTransaction(t sqlc.GetTransactionRow) core.Transaction {
-	return core.Transaction{
-		ID:              t.ID,
-		Amount:          t.Amount,
-		Type:            fromDBTransactionTypeToTransactionType(t.Type),
-		ToAccountID:     t.ToAccountID.Int64,
-		FromAccountName: convertATM(t.FromAccountName),
-		ToAccountName:   convertATM(t.ToAccountName),
-		CreatedAt:       t.CreatedAt,
-		IsRolledBack:    t.IsRolledBack,
+	transaction := core.Transaction{
+		ID:                    t.ID,
+		Amount:                t.Amount,
+		Currency:              core.Currency(t.CurrencyName.String),
+		Type:                  fromDBTransactionTypeToTransactionType(t.Type),
+		FromAccountID:         t.FromAccountID.Int64,
+		ToAccountID:           t.ToAccountID.Int64,
+		FromAccountName:       convertATM(t.FromAccountName),
+		ToAccountName:         convertATM(t.ToAccountName),
+		ToAmount:              toAmount(t.Amount, t.DestinationAmount),
+		ToCurrency:            toCurrency(t.CurrencyName, t.DestinationCurrencyName),
+		ExchangeRate:          t.ExchangeRate.String,
+		FromAccountBalance:    t.SourceBalanceAfter.Int64,
+		ToAccountBalance:      t.DestinationBalanceAfter.Int64,
+		CreatedAt:             t.CreatedAt,
+		IdempotencyKey:        t.IdempotencyKey.String,
+		ReversedTransactionID: t.ReversedTransactionID.UUID,
+		Reason:                t.Reason.String,
+		PotID:                 t.PotID.UUID,
 	}
+	setReversedAmounts(&transaction, t.IsRolledBack, t.ReversedAmount, t.ReversedToAmount)
+	return transaction
 }
 
 // fromDBTransactionsRowToTransaction converts a sqlc.GetTransactionsRow to a core.Transaction
 func fromDBTransactionsRowToTransaction(t sqlc.GetTransactionsRow) core.Transaction {
-	return core.Transaction{
-		ID:              t.ID,
-		Amount:          t.Amount,
-		Type:            fromDBTransactionTypeToTransactionType(t.Type),
-		FromAccountName: convertATM(t.FromAccountName),
-		ToAccountName:   convertATM(t.ToAccountName),
-		CreatedAt:       t.CreatedAt,
-		IsRolledBack:    t.IsRolledBack,
+	transaction := core.Transaction{
+		ID:                    t.ID,
+		Amount:                t.Amount,
+		Currency:              core.Currency(t.CurrencyName.String),
+		Type:                  fromDBTransactionTypeToTransactionType(t.Type),
+		FromAccountID:         t.FromAccountID.Int64,
+		FromAccountName:       convertATM(t.FromAccountName),
+		ToAccountID:           t.ToAccountID.Int64,
+		ToAccountName:         convertATM(t.ToAccountName),
+		ToAmount:              toAmount(t.Amount, t.DestinationAmount),
+		ToCurrency:            toCurrency(t.CurrencyName, t.DestinationCurrencyName),
+		ExchangeRate:          t.ExchangeRate.String,
+		FromAccountBalance:    t.SourceBalanceAfter.Int64,
+		ToAccountBalance:      t.DestinationBalanceAfter.Int64,
+		CreatedAt:             t.CreatedAt,
+		ReversedTransactionID: t.ReversedTransactionID.UUID,
+		Reason:                t.Reason.String,
+		PotID:                 t.PotID.UUID,
+		Category:              core.Category(t.CategorySuggested),
+		CategorySuggested:     !t.Category.Valid,
+		Tags:                  t.Tags,
 	}
+	if t.Category.Valid {
+		transaction.Category = core.Category(t.Category.String)
+	}
+	setReversedAmounts(&transaction, t.IsRolledBack, t.ReversedAmount, t.ReversedToAmount)
+	return transaction
 }
 
 // fromDBTransactionTypeToTransactionType converts a sqlc.TransactionType to a core.TransactionType
@@ -276,11 +612,49 @@ func fromDBTransactionTypeToTransactionType(t sqlc.TransactionType) core.Transac
 		return core.TransactionTypeWithdrawal
 	case sqlc.TransactionTypeTransfer:
 		return core.TransactionTypeTransfer
+	case sqlc.TransactionTypeReversal:
+		return core.TransactionTypeReversal
+	case sqlc.TransactionTypePot:
+		return core.TransactionTypePot
 	default:
 		return ""
 	}
 }
 
+// setReversedAmounts sets the reversed amounts of a transaction & whether it is fully reversed
+// A transfer's reversal moves its money backwards, so the reversal's amount is in the transfer's `ToAmount` currency &
+// its destination amount, set on cross-currency ones only, in the transfer's `Amount` currency
+// Transfers rolled back before reversals were introduced have no reversals and are fully reversed
+func setReversedAmounts(t *core.Transaction, legacyRolledBack bool, reversedAmount, reversedToAmount int64) {
+	if legacyRolledBack {
+		reversedAmount, reversedToAmount = t.Amount, t.ToAmount
+	}
+	t.ReversedAmount = reversedAmount
+	t.ReversedToAmount = reversedToAmount
+	t.IsRolledBack = t.Type != core.TransactionTypeReversal && reversedAmount >= t.Amount
+}
+
+// toAmount returns the amount credited to the destination account, which is only stored on cross-currency transfers
+func toAmount(amount int64, destinationAmount sql.NullInt64) int64 {
+	if destinationAmount.Valid {
+		return destinationAmount.Int64
+	}
+	return amount
+}
+
+// toCurrency returns the currency of the destination account, falling back to the transaction currency
+func toCurrency(currency, destinationCurrency sql.NullString) core.Currency {
+	if destinationCurrency.Valid {
+		return core.Currency(destinationCurrency.String)
+	}
+	return core.Currency(currency.String)
+}
+
+// toNullString converts a string to a sql.NullString, where the empty string is stored as NULL
+func toNullString(s string) sql.NullString {
+	return sql.NullString{String: s, Valid: s != ""}
+}
+
 // convertATM converts a sql.NullString to a string. If the string is null, it returns "ATM"
 func convertATM(s sql.NullString) string {
 	if s.Valid {
@@ -5,10 +5,13 @@ import (
 	"database/sql"
 	"reflect"
 	"testing"
+	"time"
 
+	"github.com/brianvoe/gofakeit/v6"
 	"github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
 	"github.com/escalopa/fingo/wallet/internal/core"
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewTransactionRepository(t *testing.T) {
@@ -52,13 +55,59 @@ func TestTransactionRepository_Transfer(t *testing.T) {
 			r := &TransactionRepository{
 				db: tt.fields.db,
 			}
-			if err := r.Transfer(tt.args.ctx, tt.args.params); (err != nil) != tt.wantErr {
+			if _, err := r.Transfer(tt.args.ctx, tt.args.params); (err != nil) != tt.wantErr {
 				t.Errorf("TransactionRepository.Transfer() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
 }
 
+func TestTransactionRepository_BatchTransfer(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	// Create three accounts & fund the first one
+	ar := NewAccountRepository(conn)
+	for i := 0; i < 3; i++ {
+		err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+		require.NoError(t, err)
+	}
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	from, to1, to2 := accounts[0].ID, accounts[1].ID, accounts[2].ID
+	tr := NewTransactionRepository(conn)
+	_, err = tr.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from})
+	require.NoError(t, err)
+
+	requireBalance := func(accountID, balance int64) {
+		account, err := ar.GetAccount(ctx, accountID)
+		require.NoError(t, err)
+		require.Equal(t, balance, account.Balance)
+	}
+
+	// All the transfers are created, with their reference
+	transactions, err := tr.BatchTransfer(ctx, []core.CreateTransactionParams{
+		{Amount: 300, FromAccountID: from, ToAccountID: to1, Reason: "salary"},
+		{Amount: 200, FromAccountID: from, ToAccountID: to2, Reason: "bonus"},
+	})
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+	require.Equal(t, "salary", transactions[0].Reason)
+	require.Equal(t, int64(500), transactions[1].FromAccountBalance)
+	requireBalance(from, 500)
+	requireBalance(to1, 300)
+	requireBalance(to2, 200)
+
+	// A failing transfer rolls back the whole batch
+	_, err = tr.BatchTransfer(ctx, []core.CreateTransactionParams{
+		{Amount: 100, FromAccountID: from, ToAccountID: to1},
+		{Amount: 100, FromAccountID: from, ToAccountID: -1},
+	})
+	require.Error(t, err)
+	requireBalance(from, 500)
+	requireBalance(to1, 300)
+}
+
 func TestTransactionRepository_Deposit(t *testing.T) {
 	type fields struct {
 		db *sql.DB
@@ -80,7 +129,7 @@ func TestTransactionRepository_Deposit(t *testing.T) {
 			r := &TransactionRepository{
 				db: tt.fields.db,
 			}
-			if err := r.Deposit(tt.args.ctx, tt.args.params); (err != nil) != tt.wantErr {
+			if _, err := r.Deposit(tt.args.ctx, tt.args.params); (err != nil) != tt.wantErr {
 				t.Errorf("TransactionRepository.Deposit() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -108,7 +157,7 @@ func TestTransactionRepository_Withdraw(t *testing.T) {
 			r := &TransactionRepository{
 				db: tt.fields.db,
 			}
-			if err := r.Withdraw(tt.args.ctx, tt.args.params); (err != nil) != tt.wantErr {
+			if _, err := r.Withdraw(tt.args.ctx, tt.args.params); (err != nil) != tt.wantErr {
 				t.Errorf("TransactionRepository.Withdraw() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -183,13 +232,132 @@ func TestTransactionRepository_GetTransactions(t *testing.T) {
 	}
 }
 
-func TestTransactionRepository_RollbackTransaction(t *testing.T) {
+func TestTransactionRepository_GetTransactionsPages(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+	ar := NewAccountRepository(conn)
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: "savings", Currency: core.CurrencyUSD}))
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: "checking", Currency: core.CurrencyUSD}))
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	from, to := accounts[0], accounts[1]
+
+	// 3 deposits then 2 transfers, newest first in the history
+	r := NewTransactionRepository(conn)
+	for i := 0; i < 3; i++ {
+		_, err = r.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from.ID})
+		require.NoError(t, err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err = r.Transfer(ctx, core.CreateTransactionParams{Amount: 100, FromAccountID: from.ID, ToAccountID: to.ID})
+		require.NoError(t, err)
+	}
+
+	// Pages follow each other without gaps nor repeats
+	var all []core.Transaction
+	params := core.GetTransactionsParams{AccountID: from.ID, Limit: 2}
+	for {
+		page, err := r.GetTransactions(ctx, params)
+		require.NoError(t, err)
+		all = append(all, page...)
+		if len(page) < int(params.Limit) {
+			break
+		}
+		params.After = page[len(page)-1].Cursor()
+	}
+	require.Len(t, all, 5)
+	require.Equal(t, core.TransactionTypeTransfer, all[0].Type)
+	require.Equal(t, core.TransactionTypeDeposit, all[4].Type)
+
+	// Filters
+	page, err := r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: from.ID, Limit: 10, Type: core.TransactionTypeDeposit})
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	page, err = r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: from.ID, Limit: 10, Direction: core.TransactionDirectionOutgoing})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	page, err = r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: from.ID, Limit: 10, Counterparty: to.Name[1:]})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	page, err = r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: from.ID, Limit: 10, From: all[1].CreatedAt, To: all[0].CreatedAt})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, all[1].ID, page[0].ID)
+}
+
+func TestTransactionRepository_Labels(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+	ar := NewAccountRepository(conn)
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: "wallet", Currency: core.CurrencyUSD}))
+	require.NoError(t, ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: "corner market", Currency: core.CurrencyUSD}))
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	from, to := accounts[0], accounts[1]
+
+	r := NewTransactionRepository(conn)
+	_, err = r.Deposit(ctx, core.CreateTransactionParams{Amount: 1000, ToAccountID: from.ID})
+	require.NoError(t, err)
+	t1, err := r.Transfer(ctx, core.CreateTransactionParams{Amount: 300, FromAccountID: from.ID, ToAccountID: to.ID, Reason: "lunch"})
+	require.NoError(t, err)
+	_, err = r.Transfer(ctx, core.CreateTransactionParams{Amount: 200, FromAccountID: from.ID, ToAccountID: to.ID})
+	require.NoError(t, err)
+
+	// Categories are suggested by the rules
+	page, err := r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: from.ID, Limit: 10})
+	require.NoError(t, err)
+	require.Len(t, page, 3)
+	require.Equal(t, core.CategoryGroceries, page[0].Category)
+	require.True(t, page[0].CategorySuggested)
+	require.Empty(t, page[0].Tags)
+	require.Equal(t, core.CategoryIncome, page[2].Category)
+	require.Equal(t, "lunch", page[1].Reason)
+
+	// Labels are private to the account
+	err = r.SetTransactionLabels(ctx, core.SetTransactionLabelsParams{
+		TransactionID: t1.ID,
+		AccountID:     from.ID,
+		Category:      core.CategoryDining,
+		Tags:          []string{"team", "work"},
+	})
+	require.NoError(t, err)
+	page, err = r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: from.ID, Limit: 10, Tag: "work"})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	require.Equal(t, t1.ID, page[0].ID)
+	require.Equal(t, core.CategoryDining, page[0].Category)
+	require.False(t, page[0].CategorySuggested)
+	require.Equal(t, []string{"team", "work"}, page[0].Tags)
+	page, err = r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: from.ID, Limit: 10, Category: core.CategoryGroceries})
+	require.NoError(t, err)
+	require.Len(t, page, 1)
+	page, err = r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: to.ID, Limit: 10, Tag: "work"})
+	require.NoError(t, err)
+	require.Empty(t, page)
+
+	// Spending is summed by category
+	spending, err := r.GetSpendingByCategory(ctx, from.ID, t1.CreatedAt.Add(-time.Hour), time.Now().UTC().Add(time.Hour))
+	require.NoError(t, err)
+	require.Equal(t, []core.CategorySpending{
+		{Category: core.CategoryDining, Amount: 300, Count: 1},
+		{Category: core.CategoryGroceries, Amount: 200, Count: 1},
+	}, spending)
+
+	// Clearing the labels goes back to the suggested category
+	require.NoError(t, r.SetTransactionLabels(ctx, core.SetTransactionLabelsParams{TransactionID: t1.ID, AccountID: from.ID}))
+	page, err = r.GetTransactions(ctx, core.GetTransactionsParams{AccountID: from.ID, Limit: 10, Category: core.CategoryGroceries})
+	require.NoError(t, err)
+	require.Len(t, page, 2)
+	require.Empty(t, page[1].Tags)
+}
+
+func TestTransactionRepository_ReverseTransaction(t *testing.T) {
 	type fields struct {
 		db *sql.DB
 	}
 	type args struct {
-		ctx           context.Context
-		transactionID uuid.UUID
+		ctx    context.Context
+		params core.ReverseTransactionParams
 	}
 	tests := []struct {
 		name    string
@@ -204,8 +372,8 @@ func TestTransactionRepository_RollbackTransaction(t *testing.T) {
 			r := &TransactionRepository{
 				db: tt.fields.db,
 			}
-			if err := r.RollbackTransaction(tt.args.ctx, tt.args.transactionID); (err != nil) != tt.wantErr {
-				t.Errorf("TransactionRepository.RollbackTransaction() error = %v, wantErr %v", err, tt.wantErr)
+			if _, err := r.ReverseTransaction(tt.args.ctx, tt.args.params); (err != nil) != tt.wantErr {
+				t.Errorf("TransactionRepository.ReverseTransaction() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
 	}
@@ -271,6 +439,30 @@ func Test_fromDBTransactionTypeToTransactionType(t *testing.T) {
 	}
 }
 
+func Test_toNullString(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		want sql.NullString
+	}{
+		{
+			name: "empty",
+			s:    "",
+			want: sql.NullString{},
+		},
+		{
+			name: "non empty",
+			s:    "9b2f7c1e-3f2a-4c1e-9d2a-1f0e8c7b6a5d",
+			want: sql.NullString{String: "9b2f7c1e-3f2a-4c1e-9d2a-1f0e8c7b6a5d", Valid: true},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, toNullString(tt.s))
+		})
+	}
+}
+
 func Test_convertATM(t *testing.T) {
 	type args struct {
 		s sql.NullString
@@ -290,3 +482,35 @@ func Test_convertATM(t *testing.T) {
 		})
 	}
 }
+
+func TestTransactionRepository_NoOverdraw(t *testing.T) {
+	ctx := context.Background()
+	userID := generateRandomUser(t)
+
+	ar := NewAccountRepository(conn)
+	for i := 0; i < 2; i++ {
+		err := ar.CreateAccount(ctx, core.CreateAccountParams{UserID: userID, Name: gofakeit.FirstName(), Currency: core.CurrencyUSD})
+		require.NoError(t, err)
+	}
+	accounts, err := ar.GetAccounts(ctx, userID)
+	require.NoError(t, err)
+	require.Len(t, accounts, 2)
+	from, to := accounts[0].ID, accounts[1].ID
+
+	tr := NewTransactionRepository(conn)
+	_, err = tr.Deposit(ctx, core.CreateTransactionParams{Amount: 100, ToAccountID: from})
+	require.NoError(t, err)
+
+	// Debits above the balance are refused even when the caller didn't check it
+	_, err = tr.Transfer(ctx, core.CreateTransactionParams{Amount: 101, FromAccountID: from, ToAccountID: to})
+	require.ErrorIs(t, err, errorNoSufficientFunds)
+	_, err = tr.Withdraw(ctx, core.CreateTransactionParams{Amount: 101, FromAccountID: from})
+	require.ErrorIs(t, err, errorNoSufficientFunds)
+	account, err := ar.GetAccount(ctx, from)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), account.Balance)
+
+	// The whole balance can still be spent
+	_, err = tr.Withdraw(ctx, core.CreateTransactionParams{Amount: 100, FromAccountID: from})
+	require.NoError(t, err)
+}
@@ -26,7 +26,19 @@ var (
 	errorTxNotRolledBack = func(err, err2 error) error {
 		return errs.B(err).Code(errs.Internal).Details(err2).Msg("transaction not rolled back").Err()
 	}
-	errorRollbackUnsupported = errs.B().Msg("rollback not supported for deposit & withdrawals transactions").Err()
+	errorNoSufficientFunds       = errs.B().Code(errs.InvalidArgument).Msg("no sufficient balance to perform transaction").Err()
+	errorReversalUnsupported     = errs.B().Code(errs.InvalidArgument).Msg("reversals can't be reversed").Err()
+	errorReversalTypeUnsupported = errs.B().Code(errs.InvalidArgument).Msg("only transfers, deposits & withdrawals can be reversed").Err()
+	errorReversalExceeded        = errs.B().Code(errs.InvalidArgument).Msg("reversal amount exceeds the transaction's refundable amount").Err()
+	errorQuoteUsed               = errs.B().Code(errs.InvalidArgument).Msg("quote already used by another transfer").Err()
+	errorHoldNotAuthorized       = errs.B().Code(errs.InvalidArgument).Msg("hold is no longer authorized, it was captured, voided or expired").Err()
+	errorStandingOrderEnded      = errs.B().Code(errs.InvalidArgument).Msg("standing order is no longer active, it was completed or cancelled").Err()
+	errorStandingOrderNotDue     = errs.B().Code(errs.FailedPrecondition).Msg("standing order run already executed or rescheduled").Err()
+	errorPaymentRequestClosed    = errs.B().Code(errs.InvalidArgument).Msg("payment request is no longer pending, it was paid, declined, cancelled or expired").Err()
+	errorAccountNotEmpty         = errs.B().Code(errs.FailedPrecondition).Msg("account's balance must be empty or swept into another account to be closed").Err()
+	errorBudgetNotFound          = errs.B().Code(errs.NotFound).Msg("budget not found").Err()
+	errorPotNotFound             = errs.B().Code(errs.NotFound).Msg("pot not found").Err()
+	errorPotNotEmpty             = errs.B().Code(errs.FailedPrecondition).Msg("pot's balance must be moved back to the account first").Err()
 )
 
 func deferTx(tx *sql.Tx, err error) error {
@@ -59,3 +59,23 @@ func (r *UserRepository) GetUser(ctx context.Context, uuid uuid.UUID) (int64, er
 	}
 	return userID, nil
 }
+
+// GetUserExternalID returns the uuid of the user with the given id, Where uuid is the global user id between services
+func (r *UserRepository) GetUserExternalID(ctx context.Context, id int64) (uuid.UUID, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "UserRepo.GetUserExternalID")
+	defer span.End()
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return uuid.Nil, errorTxNotStarted(err)
+	}
+	defer func() { err = deferTx(tx, err) }()
+	externalID, err := r.q.GetUserExternalID(ctx, tx, id)
+	if err != nil {
+		if IsNotFoundError(err) {
+			return uuid.Nil, errorNotFound(err, "user not found")
+		} else {
+			return uuid.Nil, errorQuery(err, "failed to get user")
+		}
+	}
+	return externalID, nil
+}
@@ -75,3 +75,18 @@ func TestUserRepository_GetUser(t *testing.T) {
 		})
 	}
 }
+
+func TestUserRepository_GetUserExternalID(t *testing.T) {
+	ctx := context.Background()
+	id := uuid.New()
+	ur := NewUserRepository(conn)
+	require.NoError(t, ur.CreateUser(ctx, id))
+	innerID, err := ur.GetUser(ctx, id)
+	require.NoError(t, err)
+
+	got, err := ur.GetUserExternalID(ctx, innerID)
+	require.NoError(t, err)
+	require.Equal(t, id, got)
+	_, err = ur.GetUserExternalID(ctx, -1)
+	require.Error(t, err)
+}
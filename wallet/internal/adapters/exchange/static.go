@@ -0,0 +1,74 @@
+package exchange
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"strings"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+// StaticRateProvider serves exchange rates from a fixed table of rates against a base currency
+// It is meant for local development & testing, rates never change while the service is running
+type StaticRateProvider struct {
+	rates map[core.Currency]*big.Rat // units of the currency per one unit of the base currency
+}
+
+// ratesFile is the layout of the rates file, e.g. {"base": "USD", "rates": {"EUR": "0.92", "EGP": "30.9"}}
+type ratesFile struct {
+	Base  string            `json:"base"`
+	Rates map[string]string `json:"rates"`
+}
+
+// NewStaticRateProvider creates a provider from decimal rates of each currency against the base currency
+func NewStaticRateProvider(base core.Currency, rates map[core.Currency]string) (*StaticRateProvider, error) {
+	p := &StaticRateProvider{rates: map[core.Currency]*big.Rat{base: big.NewRat(1, 1)}}
+	for c, rate := range rates {
+		r, ok := new(big.Rat).SetString(rate)
+		if !ok || r.Sign() <= 0 {
+			return nil, errs.B().Code(errs.InvalidArgument).Msgf("invalid exchange rate %s for %s", rate, c).Err()
+		}
+		p.rates[c] = r
+	}
+	return p, nil
+}
+
+// LoadStaticRateProvider creates a provider from a JSON rates file
+func LoadStaticRateProvider(path string) (*StaticRateProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errs.B(err).Msgf("failed to read exchange rates file %s", path).Err()
+	}
+	var f ratesFile
+	if err = json.Unmarshal(data, &f); err != nil {
+		return nil, errs.B(err).Msgf("failed to parse exchange rates file %s", path).Err()
+	}
+	rates := make(map[core.Currency]string, len(f.Rates))
+	for c, rate := range f.Rates {
+		rates[core.Currency(c)] = rate
+	}
+	return NewStaticRateProvider(core.Currency(f.Base), rates)
+}
+
+// GetRate returns the decimal rate of `to` currency units per one `from` currency unit
+func (p *StaticRateProvider) GetRate(ctx context.Context, from, to core.Currency) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	fromRate, ok1 := p.rates[from]
+	toRate, ok2 := p.rates[to]
+	if !ok1 || !ok2 {
+		return "", errs.B().Code(errs.NotFound).Msgf("exchange rate from %s to %s is not available", from, to).Err()
+	}
+	return formatRate(new(big.Rat).Quo(toRate, fromRate)), nil
+}
+
+// formatRate formats a rate as a decimal with at most 12 fractional digits
+func formatRate(r *big.Rat) string {
+	s := r.FloatString(12)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
@@ -0,0 +1,63 @@
+package exchange
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaticRateProvider_GetRate(t *testing.T) {
+	p, err := NewStaticRateProvider(core.CurrencyUSD, map[core.Currency]string{
+		core.CurrencyEUR: "0.92",
+		core.CurrencyEGP: "30.9",
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		from    core.Currency
+		to      core.Currency
+		want    string
+		wantErr bool
+	}{
+		{name: "from base", from: core.CurrencyUSD, to: core.CurrencyEUR, want: "0.92"},
+		{name: "to base", from: core.CurrencyEUR, to: core.CurrencyUSD, want: "1.086956521739"},
+		{name: "cross rate", from: core.CurrencyEUR, to: core.CurrencyEGP, want: "33.586956521739"},
+		{name: "same currency", from: core.CurrencyEGP, to: core.CurrencyEGP, want: "1"},
+		{name: "unknown currency", from: core.CurrencyUSD, to: core.CurrencyGBP, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := p.GetRate(context.Background(), tt.from, tt.to)
+			require.Truef(t, (err != nil) == tt.wantErr, "StaticRateProvider.GetRate() error = %v, wantErr %v", err, tt.wantErr)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewStaticRateProvider(t *testing.T) {
+	_, err := NewStaticRateProvider(core.CurrencyUSD, map[core.Currency]string{core.CurrencyEUR: "-1"})
+	require.Error(t, err)
+	_, err = NewStaticRateProvider(core.CurrencyUSD, map[core.Currency]string{core.CurrencyEUR: "abc"})
+	require.Error(t, err)
+}
+
+func TestLoadStaticRateProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rates.json")
+	err := os.WriteFile(path, []byte(`{"base": "USD", "rates": {"EUR": "0.92"}}`), 0o600)
+	require.NoError(t, err)
+
+	p, err := LoadStaticRateProvider(path)
+	require.NoError(t, err)
+	rate, err := p.GetRate(context.Background(), core.CurrencyUSD, core.CurrencyEUR)
+	require.NoError(t, err)
+	require.Equal(t, "0.92", rate)
+
+	_, err = LoadStaticRateProvider(filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
@@ -2,11 +2,14 @@ package grpc
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"github.com/escalopa/fingo/pb"
 	"github.com/escalopa/fingo/pkg/tracer"
 	"github.com/escalopa/fingo/wallet/internal/application"
 	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -34,7 +37,7 @@ func (wh *WalletHandler) CreateAccount(ctx context.Context, req *pb.CreateAccoun
 	defer span.End()
 	err := wh.u.CreateAccount.Execute(ctx, application.CreateAccountParams{
 		Name:     req.Name,
-		Currency: req.Currency.String(),
+		Currency: req.GetCurrency(),
 	})
 	if err != nil {
 		return nil, err
@@ -60,21 +63,90 @@ func (wh *WalletHandler) GetAccounts(ctx context.Context, req *pb.GetAccountsReq
 func (wh *WalletHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.DeleteAccount")
 	defer span.End()
-	err := wh.u.DeleteAccount.Execute(ctx, application.DeleteAccountParams{AccountID: req.AccountId})
+	_, err := wh.u.CloseAccount.Execute(ctx, application.CloseAccountParams{AccountID: req.AccountId})
 	if err != nil {
 		return nil, err
 	}
 	return &pb.DeleteAccountResponse{Success: true}, nil
 }
 
+func (wh *WalletHandler) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CloseAccount")
+	defer span.End()
+	sweep, err := wh.u.CloseAccount.Execute(ctx, application.CloseAccountParams{
+		AccountID:        req.AccountId,
+		SweepToAccountID: req.GetSweepToAccountId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := &pb.CloseAccountResponse{}
+	if sweep.ID != uuid.Nil {
+		res.SweepTransaction = fromCoreTransaction(sweep, req.AccountId)
+	}
+	return res, nil
+}
+
+func (wh *WalletHandler) FreezeAccount(ctx context.Context, req *pb.FreezeAccountRequest) (*pb.FreezeAccountResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.FreezeAccount")
+	defer span.End()
+	err := wh.u.FreezeAccount.Execute(ctx, application.FreezeAccountParams{AccountID: req.AccountId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.FreezeAccountResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) UnfreezeAccount(ctx context.Context, req *pb.UnfreezeAccountRequest) (*pb.UnfreezeAccountResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.UnfreezeAccount")
+	defer span.End()
+	err := wh.u.UnfreezeAccount.Execute(ctx, application.UnfreezeAccountParams{AccountID: req.AccountId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UnfreezeAccountResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) SetDefaultAccount(ctx context.Context, req *pb.SetDefaultAccountRequest) (*pb.SetDefaultAccountResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.SetDefaultAccount")
+	defer span.End()
+	err := wh.u.SetDefaultAccount.Execute(ctx, application.SetDefaultAccountParams{AccountID: req.AccountId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetDefaultAccountResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) ListCurrencies(ctx context.Context, _ *pb.ListCurrenciesRequest) (*pb.ListCurrenciesResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.ListCurrencies")
+	defer span.End()
+	currencies, err := wh.u.ListCurrencies.Execute(ctx, application.ListCurrenciesParams{})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	pbCurrencies := make([]*pb.Currency, len(currencies))
+	for i, c := range currencies {
+		pbCurrencies[i] = fromCoreCurrency(c)
+	}
+	return &pb.ListCurrenciesResponse{Currencies: pbCurrencies}, nil
+}
+
 func (wh *WalletHandler) CreateCard(ctx context.Context, req *pb.CreateCardRequest) (*pb.CreateCardResponse, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CreateCard")
 	defer span.End()
-	err := wh.u.CreateCard.Execute(ctx, application.CreateCardParams{AccountID: req.AccountId})
+	card, err := wh.u.CreateCard.Execute(ctx, application.CreateCardParams{
+		AccountID:         req.AccountId,
+		Nickname:          req.GetNickname(),
+		Kind:              toCoreCardKind(req.Kind),
+		SpendingCap:       req.GetSpendingCap(),
+		ExpiresAt:         toTime(req.ExpiresAt),
+		LockedToAccountID: req.GetLockedToAccountId(),
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &pb.CreateCardResponse{Success: true}, nil
+	return &pb.CreateCardResponse{Success: true, Card: fromCoreCard(card), Cvv: card.CVV}, nil
 }
 
 func (wh *WalletHandler) GetCards(ctx context.Context, req *pb.GetCardsRequest) (*pb.GetCardsResponse, error) {
@@ -87,7 +159,7 @@ func (wh *WalletHandler) GetCards(ctx context.Context, req *pb.GetCardsRequest)
 	// Convert to pb type
 	pbCards := make([]*pb.GetCardsResponse_Card, len(cards))
 	for i, c := range cards {
-		pbCards[i] = &pb.GetCardsResponse_Card{Number: c.Number}
+		pbCards[i] = fromCoreCard(c)
 	}
 	return &pb.GetCardsResponse{Cards: pbCards}, nil
 }
@@ -102,71 +174,1142 @@ func (wh *WalletHandler) DeleteCard(ctx context.Context, req *pb.DeleteCardReque
 	return &pb.DeleteCardResponse{Success: true}, nil
 }
 
+func (wh *WalletHandler) ReplaceCard(ctx context.Context, req *pb.ReplaceCardRequest) (*pb.ReplaceCardResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.ReplaceCard")
+	defer span.End()
+	card, err := wh.u.ReplaceCard.Execute(ctx, application.ReplaceCardParams{CardNumber: req.CardNumber})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ReplaceCardResponse{Card: fromCoreCard(card), Cvv: card.CVV}, nil
+}
+
+func (wh *WalletHandler) SetCardStatus(ctx context.Context, req *pb.SetCardStatusRequest) (*pb.SetCardStatusResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.SetCardStatus")
+	defer span.End()
+	err := wh.u.SetCardStatus.Execute(ctx, application.SetCardStatusParams{
+		CardNumber: req.CardNumber,
+		Status:     toCoreCardStatus(req.Status),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetCardStatusResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) SetCardNickname(ctx context.Context, req *pb.SetCardNicknameRequest) (*pb.SetCardNicknameResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.SetCardNickname")
+	defer span.End()
+	err := wh.u.SetCardNickname.Execute(ctx, application.SetCardNicknameParams{
+		CardNumber: req.CardNumber,
+		Nickname:   req.Nickname,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetCardNicknameResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) SetCardPin(ctx context.Context, req *pb.SetCardPinRequest) (*pb.SetCardPinResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.SetCardPin")
+	defer span.End()
+	err := wh.u.SetCardPin.Execute(ctx, application.SetCardPinParams{
+		CardNumber: req.CardNumber,
+		PIN:        req.Pin,
+		CurrentPIN: req.GetCurrentPin(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetCardPinResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) VerifyCardPin(ctx context.Context, req *pb.VerifyCardPinRequest) (*pb.VerifyCardPinResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.VerifyCardPin")
+	defer span.End()
+	err := wh.u.VerifyCardPin.Execute(ctx, application.VerifyCardPinParams{CardNumber: req.CardNumber, PIN: req.Pin})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VerifyCardPinResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) RevealCardNumber(ctx context.Context, req *pb.RevealCardNumberRequest) (*pb.RevealCardNumberResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.RevealCardNumber")
+	defer span.End()
+	number, err := wh.u.RevealCardNumber.Execute(ctx, application.RevealCardNumberParams{CardNumber: req.CardNumber})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevealCardNumberResponse{Number: number}, nil
+}
+
 func (wh *WalletHandler) CreateTransaction(ctx context.Context, req *pb.CreateTransactionRequest) (*pb.CreateTransactionResponse, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.Transfer")
 	defer span.End()
-	err := wh.u.CreateTransaction.Execute(ctx, application.CreateTransactionParams{
-		Amount:   req.Amount,
-		Type:     core.ParseTransactionType(req.Type.String()),
+	transaction, err := wh.u.CreateTransaction.Execute(ctx, application.CreateTransactionParams{
+		Amount:         req.GetAmount().GetAmount(),
+		Currency:       toCoreCurrency(req.GetAmount().GetCurrency()),
+		Type:           core.ParseTransactionType(req.Type.String()),
+		FromCard:       req.CardNumber,
+		ToCard:         req.GetRecipientCardNumber(),
+		ToUsername:     req.GetRecipientUsername(),
+		ToEmail:        req.GetRecipientEmail(),
+		ToCurrency:     toCoreCurrency(req.GetRecipientCurrency()),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		QuoteID:        req.GetQuoteId(),
+		CVV:            req.GetCvv(),
+		PIN:            req.GetPin(),
+		Note:           req.GetNote(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateTransactionResponse{
+		Success:       true,
+		TransactionId: transaction.ID.String(),
+		Transaction:   fromCoreTransaction(transaction, transaction.InitiatorAccountID()),
+	}, nil
+}
+
+func (wh *WalletHandler) BatchTransfer(ctx context.Context, req *pb.BatchTransferRequest) (*pb.BatchTransferResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.BatchTransfer")
+	defer span.End()
+	items := make([]application.BatchTransferItemParams, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = application.BatchTransferItemParams{
+			ToCard:    item.RecipientCardNumber,
+			Amount:    item.Amount,
+			Reference: item.Reference,
+		}
+	}
+	currency := toCoreCurrency(req.Currency)
+	batch, err := wh.u.BatchTransfer.Execute(ctx, application.BatchTransferParams{
 		FromCard: req.CardNumber,
-		ToCard:   req.GetRecipientCardNumber(),
+		Currency: currency,
+		Mode:     toCoreBatchMode(req.Mode),
+		Items:    items,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	pbItems := make([]*pb.BatchTransferResponse_Item, len(batch.Items))
+	for i, item := range batch.Items {
+		pbItems[i] = fromCoreBatchTransferItem(item)
+	}
+	succeeded, transferred := batch.Succeeded()
+	return &pb.BatchTransferResponse{
+		Items:       pbItems,
+		Succeeded:   int32(succeeded),
+		Transferred: fromCoreMoney(transferred, currency),
+	}, nil
+}
+
+func (wh *WalletHandler) CreateQuote(ctx context.Context, req *pb.CreateQuoteRequest) (*pb.CreateQuoteResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CreateQuote")
+	defer span.End()
+	quote, err := wh.u.CreateQuote.Execute(ctx, application.CreateQuoteParams{
+		Amount:     req.GetAmount().GetAmount(),
+		Currency:   toCoreCurrency(req.GetAmount().GetCurrency()),
+		FromCard:   req.CardNumber,
+		ToCard:     req.RecipientCardNumber,
+		ToUsername: req.GetRecipientUsername(),
+		ToEmail:    req.GetRecipientEmail(),
+		ToCurrency: toCoreCurrency(req.GetRecipientCurrency()),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateQuoteResponse{
+		QuoteId:         quote.ID.String(),
+		ExchangeRate:    quote.Rate,
+		Amount:          fromCoreMoney(quote.Amount, quote.Currency),
+		ConvertedAmount: fromCoreMoney(quote.ToAmount, quote.ToCurrency),
+		ExpiresAt:       timestamppb.New(quote.ExpiresAt),
+	}, nil
+}
+
+func (wh *WalletHandler) AuthorizeHold(ctx context.Context, req *pb.AuthorizeHoldRequest) (*pb.AuthorizeHoldResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.AuthorizeHold")
+	defer span.End()
+	hold, err := wh.u.AuthorizeHold.Execute(ctx, application.AuthorizeHoldParams{
+		Amount:   req.GetAmount().GetAmount(),
+		Currency: toCoreCurrency(req.GetAmount().GetCurrency()),
+		FromCard: req.CardNumber,
+		ToCard:   req.RecipientCardNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AuthorizeHoldResponse{
+		HoldId:    hold.ID.String(),
+		Amount:    fromCoreMoney(hold.Amount, hold.Currency),
+		ExpiresAt: timestamppb.New(hold.ExpiresAt),
+	}, nil
+}
+
+func (wh *WalletHandler) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.CaptureHoldResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CaptureHold")
+	defer span.End()
+	transaction, err := wh.u.CaptureHold.Execute(ctx, application.CaptureHoldParams{
+		HoldID: req.HoldId,
+		Amount: req.GetAmount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CaptureHoldResponse{Transaction: fromCoreTransaction(transaction, transaction.ToAccountID)}, nil
+}
+
+func (wh *WalletHandler) VoidHold(ctx context.Context, req *pb.VoidHoldRequest) (*pb.VoidHoldResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.VoidHold")
+	defer span.End()
+	err := wh.u.VoidHold.Execute(ctx, application.VoidHoldParams{HoldID: req.HoldId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.VoidHoldResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) CreateStandingOrder(ctx context.Context, req *pb.CreateStandingOrderRequest) (*pb.CreateStandingOrderResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CreateStandingOrder")
+	defer span.End()
+	order, err := wh.u.CreateStandingOrder.Execute(ctx, application.CreateStandingOrderParams{
+		FromCard:   req.FromCardNumber,
+		ToCard:     req.ToCardNumber,
+		Amount:     req.GetAmount().GetAmount(),
+		Currency:   toCoreCurrency(req.GetAmount().GetCurrency()),
+		Cron:       req.GetCron(),
+		DayOfMonth: int(req.GetDayOfMonth()),
+		EndDate:    toTime(req.EndDate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateStandingOrderResponse{StandingOrder: fromCoreStandingOrder(order)}, nil
+}
+
+func (wh *WalletHandler) GetStandingOrders(ctx context.Context, _ *pb.GetStandingOrdersRequest) (*pb.GetStandingOrdersResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetStandingOrders")
+	defer span.End()
+	orders, err := wh.u.GetStandingOrders.Execute(ctx, application.GetStandingOrdersParams{})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	pbOrders := make([]*pb.StandingOrder, len(orders))
+	for i, o := range orders {
+		pbOrders[i] = fromCoreStandingOrder(o)
+	}
+	return &pb.GetStandingOrdersResponse{StandingOrders: pbOrders}, nil
+}
+
+func (wh *WalletHandler) UpdateStandingOrder(ctx context.Context, req *pb.UpdateStandingOrderRequest) (*pb.UpdateStandingOrderResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.UpdateStandingOrder")
+	defer span.End()
+	order, err := wh.u.UpdateStandingOrder.Execute(ctx, application.UpdateStandingOrderParams{
+		StandingOrderID: req.StandingOrderId,
+		Amount:          req.GetAmount().GetAmount(),
+		Currency:        toCoreCurrency(req.GetAmount().GetCurrency()),
+		Cron:            req.GetCron(),
+		DayOfMonth:      int(req.GetDayOfMonth()),
+		EndDate:         toTime(req.EndDate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateStandingOrderResponse{StandingOrder: fromCoreStandingOrder(order)}, nil
+}
+
+func (wh *WalletHandler) DeleteStandingOrder(ctx context.Context, req *pb.DeleteStandingOrderRequest) (*pb.DeleteStandingOrderResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.DeleteStandingOrder")
+	defer span.End()
+	err := wh.u.DeleteStandingOrder.Execute(ctx, application.DeleteStandingOrderParams{StandingOrderID: req.StandingOrderId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteStandingOrderResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) GetStandingOrderExecutions(ctx context.Context, req *pb.GetStandingOrderExecutionsRequest) (*pb.GetStandingOrderExecutionsResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetStandingOrderExecutions")
+	defer span.End()
+	executions, err := wh.u.GetStandingOrderExecutions.Execute(ctx, application.GetStandingOrderExecutionsParams{
+		StandingOrderID: req.StandingOrderId,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	pbExecutions := make([]*pb.StandingOrderExecution, len(executions))
+	for i, e := range executions {
+		pbExecutions[i] = fromCoreStandingOrderExecution(e)
+	}
+	return &pb.GetStandingOrderExecutionsResponse{Executions: pbExecutions}, nil
+}
+
+func (wh *WalletHandler) CreatePaymentRequest(ctx context.Context, req *pb.CreatePaymentRequestRequest) (*pb.CreatePaymentRequestResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CreatePaymentRequest")
+	defer span.End()
+	request, err := wh.u.CreatePaymentRequest.Execute(ctx, application.CreatePaymentRequestParams{
+		Amount:        req.GetAmount().GetAmount(),
+		Currency:      toCoreCurrency(req.GetAmount().GetCurrency()),
+		PayerUsername: req.GetPayerUsername(),
+		PayerEmail:    req.GetPayerEmail(),
+		ToCard:        req.GetToCardNumber(),
+		Note:          req.Note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreatePaymentRequestResponse{PaymentRequest: fromCorePaymentRequest(request)}, nil
+}
+
+func (wh *WalletHandler) GetPaymentRequests(ctx context.Context, req *pb.GetPaymentRequestsRequest) (*pb.GetPaymentRequestsResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetPaymentRequests")
+	defer span.End()
+	requests, err := wh.u.GetPaymentRequests.Execute(ctx, application.GetPaymentRequestsParams{Outgoing: req.Outgoing})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	pbRequests := make([]*pb.PaymentRequest, len(requests))
+	for i, r := range requests {
+		pbRequests[i] = fromCorePaymentRequest(r)
+	}
+	return &pb.GetPaymentRequestsResponse{PaymentRequests: pbRequests}, nil
+}
+
+func (wh *WalletHandler) PayPaymentRequest(ctx context.Context, req *pb.PayPaymentRequestRequest) (*pb.PayPaymentRequestResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.PayPaymentRequest")
+	defer span.End()
+	transaction, err := wh.u.PayPaymentRequest.Execute(ctx, application.PayPaymentRequestParams{
+		RequestID: req.PaymentRequestId,
+		FromCard:  req.FromCardNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PayPaymentRequestResponse{Transaction: fromCoreTransaction(transaction, transaction.FromAccountID)}, nil
+}
+
+func (wh *WalletHandler) DeclinePaymentRequest(ctx context.Context, req *pb.DeclinePaymentRequestRequest) (*pb.DeclinePaymentRequestResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.DeclinePaymentRequest")
+	defer span.End()
+	err := wh.u.DeclinePaymentRequest.Execute(ctx, application.DeclinePaymentRequestParams{RequestID: req.PaymentRequestId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeclinePaymentRequestResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) CancelPaymentRequest(ctx context.Context, req *pb.CancelPaymentRequestRequest) (*pb.CancelPaymentRequestResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CancelPaymentRequest")
+	defer span.End()
+	err := wh.u.CancelPaymentRequest.Execute(ctx, application.CancelPaymentRequestParams{RequestID: req.PaymentRequestId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CancelPaymentRequestResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) CreateSplit(ctx context.Context, req *pb.CreateSplitRequest) (*pb.CreateSplitResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CreateSplit")
+	defer span.End()
+	participants := make([]application.SplitParticipantParams, len(req.Participants))
+	for i, p := range req.Participants {
+		participants[i] = application.SplitParticipantParams{
+			Username:    p.GetUsername(),
+			Email:       p.GetEmail(),
+			BasisPoints: p.BasisPoints,
+			Amount:      p.Amount,
+		}
+	}
+	split, err := wh.u.CreateSplit.Execute(ctx, application.CreateSplitParams{
+		Amount:           req.GetAmount().GetAmount(),
+		Currency:         toCoreCurrency(req.GetAmount().GetCurrency()),
+		Method:           toCoreSplitMethod(req.Method),
+		Participants:     participants,
+		IncludeRequester: req.IncludeRequester,
+		ToCard:           req.GetToCardNumber(),
+		Note:             req.Note,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateSplitResponse{Split: fromCoreSplit(split)}, nil
+}
+
+func (wh *WalletHandler) GetSplit(ctx context.Context, req *pb.GetSplitRequest) (*pb.GetSplitResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetSplit")
+	defer span.End()
+	split, err := wh.u.GetSplit.Execute(ctx, application.GetSplitParams{SplitID: req.SplitId})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetSplitResponse{Split: fromCoreSplit(split)}, nil
+}
+
+func (wh *WalletHandler) GetSplits(ctx context.Context, _ *pb.GetSplitsRequest) (*pb.GetSplitsResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetSplits")
+	defer span.End()
+	splits, err := wh.u.GetSplits.Execute(ctx, application.GetSplitsParams{})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	pbSplits := make([]*pb.Split, len(splits))
+	for i, s := range splits {
+		pbSplits[i] = fromCoreSplit(s)
+	}
+	return &pb.GetSplitsResponse{Splits: pbSplits}, nil
+}
+
+func (wh *WalletHandler) SettleSplit(ctx context.Context, req *pb.SettleSplitRequest) (*pb.SettleSplitResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.SettleSplit")
+	defer span.End()
+	transaction, err := wh.u.SettleSplit.Execute(ctx, application.SettleSplitParams{
+		SplitID:  req.SplitId,
+		FromCard: req.FromCardNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SettleSplitResponse{Transaction: fromCoreTransaction(transaction, transaction.FromAccountID)}, nil
+}
+
+func (wh *WalletHandler) GetLimits(ctx context.Context, req *pb.GetLimitsRequest) (*pb.GetLimitsResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetLimits")
+	defer span.End()
+	limits, err := wh.u.GetLimits.Execute(ctx, application.GetLimitsParams{
+		AccountID:  req.GetAccountId(),
+		CardNumber: req.GetCardNumber(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	pbLimits := make([]*pb.SpendingLimit, len(limits))
+	for i, l := range limits {
+		pbLimits[i] = fromCoreSpendingLimit(l)
+	}
+	return &pb.GetLimitsResponse{Limits: pbLimits}, nil
+}
+
+func (wh *WalletHandler) SetLimit(ctx context.Context, req *pb.SetLimitRequest) (*pb.SetLimitResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.SetLimit")
+	defer span.End()
+	limit, err := wh.u.SetLimit.Execute(ctx, application.SetLimitParams{
+		AccountID:      req.GetLimit().GetAccountId(),
+		CardNumber:     req.GetLimit().GetCardNumber(),
+		Type:           core.ParseTransactionType(req.GetLimit().GetType().String()),
+		PerTransaction: req.GetLimit().GetPerTransaction(),
+		Daily:          req.GetLimit().GetDaily(),
+		Monthly:        req.GetLimit().GetMonthly(),
 	})
 	if err != nil {
 		return nil, err
 	}
-	return &pb.CreateTransactionResponse{Success: true}, nil
+	return &pb.SetLimitResponse{Limit: fromCoreSpendingLimit(limit)}, nil
 }
 
 func (wh *WalletHandler) TransferRollback(ctx context.Context, req *pb.TransferRollbackRequest) (*pb.TransferRollbackResponse, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.TransferRollback")
 	defer span.End()
-	err := wh.u.TransferRollback.Execute(ctx, application.TransferRollbackParams{TransactionID: req.TransactionId})
+	err := wh.u.TransferRollback.Execute(ctx, application.TransferRollbackParams{
+		TransactionID:  req.TransactionId,
+		IdempotencyKey: req.GetIdempotencyKey(),
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &pb.TransferRollbackResponse{Success: true}, nil
 }
 
+func (wh *WalletHandler) RefundTransaction(ctx context.Context, req *pb.RefundTransactionRequest) (*pb.RefundTransactionResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.RefundTransaction")
+	defer span.End()
+	refund, err := wh.u.RefundTransaction.Execute(ctx, application.RefundTransactionParams{
+		TransactionID:  req.TransactionId,
+		Amount:         req.GetAmount(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Reason:         req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefundTransactionResponse{Transaction: fromCoreTransaction(refund, refund.FromAccountID)}, nil
+}
+
+func (wh *WalletHandler) ReverseTransaction(ctx context.Context, req *pb.ReverseTransactionRequest) (*pb.ReverseTransactionResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.ReverseTransaction")
+	defer span.End()
+	reversal, err := wh.u.ReverseTransaction.Execute(ctx, application.ReverseTransactionParams{
+		TransactionID:  req.TransactionId,
+		Amount:         req.GetAmount(),
+		IdempotencyKey: req.GetIdempotencyKey(),
+		Reason:         req.GetReason(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Deposit reversals have no destination account
+	accountID := reversal.FromAccountID
+	if accountID == 0 {
+		accountID = reversal.ToAccountID
+	}
+	return &pb.ReverseTransactionResponse{Transaction: fromCoreTransaction(reversal, accountID)}, nil
+}
+
 func (wh *WalletHandler) GetTransactionHistory(ctx context.Context, req *pb.GetTransactionHistoryRequest) (*pb.GetTransactionHistoryResponse, error) {
 	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetTransactionHistory")
 	defer span.End()
-	transactions, err := wh.u.GetTransactionHistory.Execute(ctx, application.GetTransactionHistoryParams{
-		AccountID:       req.AccountId,
-		Offset:          req.Offset,
-		Limit:           req.Limit,
-		MinAmount:       req.GetMinAmount(),
-		MaxAmount:       req.GetMaxAmount(),
-		TransactionType: core.ParseTransactionType(req.GetTransactionType().String()),
+	page, err := wh.u.GetTransactionHistory.Execute(ctx, application.GetTransactionHistoryParams{
+		TransactionHistoryFilters: application.TransactionHistoryFilters{
+			AccountID:       req.AccountId,
+			MinAmount:       req.GetMinAmount(),
+			MaxAmount:       req.GetMaxAmount(),
+			TransactionType: core.ParseTransactionType(req.GetTransactionType().String()),
+			From:            toTime(req.From),
+			To:              toTime(req.To),
+			Direction:       toCoreTransactionDirection(req.Direction),
+			Counterparty:    req.GetCounterparty(),
+			Category:        core.Category(strings.ToLower(req.GetCategory())),
+			Tag:             req.GetTag(),
+		},
+		Cursor: req.GetCursor(),
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	pbTransactions := make([]*pb.Transaction, len(page.Transactions))
+	for i, t := range page.Transactions {
+		pbTransactions[i] = fromCoreTransaction(t, req.AccountId)
+	}
+	res := &pb.GetTransactionHistoryResponse{Transactions: pbTransactions}
+	if !page.Next.IsZero() {
+		next := page.Next.String()
+		res.NextCursor = &next
+	}
+	return res, nil
+}
+
+func (wh *WalletHandler) StreamTransactionHistory(req *pb.StreamTransactionHistoryRequest, stream pb.WalletService_StreamTransactionHistoryServer) error {
+	ctx, span := tracer.Tracer().Start(stream.Context(), "WalletHandler.StreamTransactionHistory")
+	defer span.End()
+	return wh.u.StreamTransactionHistory.Execute(ctx, application.StreamTransactionHistoryParams{
+		TransactionHistoryFilters: application.TransactionHistoryFilters{
+			AccountID:       req.AccountId,
+			MinAmount:       req.GetMinAmount(),
+			MaxAmount:       req.GetMaxAmount(),
+			TransactionType: core.ParseTransactionType(req.GetTransactionType().String()),
+			From:            toTime(req.From),
+			To:              toTime(req.To),
+			Direction:       toCoreTransactionDirection(req.Direction),
+			Counterparty:    req.GetCounterparty(),
+			Category:        core.Category(strings.ToLower(req.GetCategory())),
+			Tag:             req.GetTag(),
+		},
+	}, func(t core.Transaction) error {
+		return stream.Send(fromCoreTransaction(t, req.AccountId))
+	})
+}
+
+func (wh *WalletHandler) SetTransactionLabels(ctx context.Context, req *pb.SetTransactionLabelsRequest) (*pb.SetTransactionLabelsResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.SetTransactionLabels")
+	defer span.End()
+	err := wh.u.SetTransactionLabels.Execute(ctx, application.SetTransactionLabelsParams{
+		TransactionID: req.TransactionId,
+		AccountID:     req.AccountId,
+		Category:      core.Category(strings.ToLower(req.Category)),
+		Tags:          req.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetTransactionLabelsResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) GetSpendingSummary(ctx context.Context, req *pb.GetSpendingSummaryRequest) (*pb.GetSpendingSummaryResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetSpendingSummary")
+	defer span.End()
+	summary, err := wh.u.GetSpendingSummary.Execute(ctx, application.GetSpendingSummaryParams{
+		AccountID: req.AccountId,
+		From:      toTime(req.From),
+		To:        toTime(req.To),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Convert to pb type
+	categories := make([]*pb.GetSpendingSummaryResponse_Category, len(summary.Categories))
+	for i, c := range summary.Categories {
+		categories[i] = &pb.GetSpendingSummaryResponse_Category{
+			Category:     c.Category.String(),
+			Amount:       fromCoreMoney(c.Amount, summary.Currency),
+			Transactions: c.Count,
+		}
+	}
+	return &pb.GetSpendingSummaryResponse{
+		Total:      fromCoreMoney(summary.Total, summary.Currency),
+		Categories: categories,
+	}, nil
+}
+
+func (wh *WalletHandler) SetBudget(ctx context.Context, req *pb.SetBudgetRequest) (*pb.SetBudgetResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.SetBudget")
+	defer span.End()
+	budget, err := wh.u.SetBudget.Execute(ctx, application.SetBudgetParams{
+		AccountID: req.AccountId,
+		Category:  core.Category(strings.ToLower(req.Category)),
+		Amount:    req.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetBudgetResponse{Budget: fromCoreBudget(budget)}, nil
+}
+
+func (wh *WalletHandler) DeleteBudget(ctx context.Context, req *pb.DeleteBudgetRequest) (*pb.DeleteBudgetResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.DeleteBudget")
+	defer span.End()
+	err := wh.u.DeleteBudget.Execute(ctx, application.DeleteBudgetParams{
+		AccountID: req.AccountId,
+		Category:  core.Category(strings.ToLower(req.Category)),
 	})
 	if err != nil {
 		return nil, err
 	}
+	return &pb.DeleteBudgetResponse{Success: true}, nil
+}
+
+func (wh *WalletHandler) GetBudgets(ctx context.Context, req *pb.GetBudgetsRequest) (*pb.GetBudgetsResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.GetBudgets")
+	defer span.End()
+	statuses, err := wh.u.GetBudgets.Execute(ctx, application.GetBudgetsParams{AccountID: req.AccountId})
+	if err != nil {
+		return nil, err
+	}
 	// Convert to pb type
-	pbTransactions := make([]*pb.GetTransactionHistoryResponse_Transaction, len(transactions))
-	for i, t := range transactions {
-		pbTransactions[i] = fromCoreTransaction(t)
+	budgets := make([]*pb.GetBudgetsResponse_BudgetStatus, len(statuses))
+	for i, s := range statuses {
+		budgets[i] = &pb.GetBudgetsResponse_BudgetStatus{
+			Budget:    fromCoreBudget(s.Budget),
+			Month:     timestamppb.New(s.Month),
+			Spent:     fromCoreMoney(s.Spent, s.Currency),
+			Remaining: fromCoreMoney(s.Remaining, s.Currency),
+			Percent:   int32(s.Percent),
+		}
+	}
+	return &pb.GetBudgetsResponse{Budgets: budgets}, nil
+}
+
+func (wh *WalletHandler) CreatePot(ctx context.Context, req *pb.CreatePotRequest) (*pb.CreatePotResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.CreatePot")
+	defer span.End()
+	pot, err := wh.u.CreatePot.Execute(ctx, application.CreatePotParams{
+		AccountID:    req.AccountId,
+		Name:         req.Name,
+		TargetAmount: req.GetTargetAmount(),
+		TargetDate:   toTime(req.TargetDate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreatePotResponse{Pot: fromCorePot(pot)}, nil
+}
+
+func (wh *WalletHandler) UpdatePot(ctx context.Context, req *pb.UpdatePotRequest) (*pb.UpdatePotResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.UpdatePot")
+	defer span.End()
+	pot, err := wh.u.UpdatePot.Execute(ctx, application.UpdatePotParams{
+		PotID:        req.PotId,
+		Name:         req.Name,
+		TargetAmount: req.GetTargetAmount(),
+		TargetDate:   toTime(req.TargetDate),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdatePotResponse{Pot: fromCorePot(pot)}, nil
+}
+
+func (wh *WalletHandler) DeletePot(ctx context.Context, req *pb.DeletePotRequest) (*pb.DeletePotResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.DeletePot")
+	defer span.End()
+	err := wh.u.DeletePot.Execute(ctx, application.DeletePotParams{PotID: req.PotId})
+	if err != nil {
+		return nil, err
 	}
-	return &pb.GetTransactionHistoryResponse{Transactions: pbTransactions}, nil
+	return &pb.DeletePotResponse{Success: true}, nil
 }
 
-func fromCoreTransaction(t core.Transaction) *pb.GetTransactionHistoryResponse_Transaction {
-	return &pb.GetTransactionHistoryResponse_Transaction{
+func (wh *WalletHandler) MovePotMoney(ctx context.Context, req *pb.MovePotMoneyRequest) (*pb.MovePotMoneyResponse, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "WalletHandler.MovePotMoney")
+	defer span.End()
+	transaction, err := wh.u.MovePotMoney.Execute(ctx, application.MovePotMoneyParams{
+		PotID:  req.PotId,
+		Amount: req.Amount,
+		Move:   toCorePotMove(req.Move),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Show the move from the account's side
+	accountID := transaction.FromAccountID
+	if accountID == 0 {
+		accountID = transaction.ToAccountID
+	}
+	return &pb.MovePotMoneyResponse{Transaction: fromCoreTransaction(transaction, accountID)}, nil
+}
+
+// statementChunkSize is the max size of the statement chunks streamed to the clients
+const statementChunkSize = 64 * 1024
+
+func (wh *WalletHandler) GetStatement(req *pb.GetStatementRequest, stream pb.WalletService_GetStatementServer) error {
+	ctx, span := tracer.Tracer().Start(stream.Context(), "WalletHandler.GetStatement")
+	defer span.End()
+	file, err := wh.u.GetStatement.Execute(ctx, application.GetStatementParams{
+		AccountID: req.AccountId,
+		From:      toTime(req.From),
+		To:        toTime(req.To),
+		Format:    toCoreStatementFormat(req.Format),
+	})
+	if err != nil {
+		return err
+	}
+	// Send the file's name & content type with the first chunk
+	res := &pb.GetStatementResponse{FileName: file.Name, ContentType: file.ContentType}
+	for content := file.Content; ; {
+		n := len(content)
+		if n > statementChunkSize {
+			n = statementChunkSize
+		}
+		res.Chunk, content = content[:n], content[n:]
+		if err = stream.Send(res); err != nil {
+			return err
+		}
+		if len(content) == 0 {
+			return nil
+		}
+		res = &pb.GetStatementResponse{}
+	}
+}
+
+func fromCoreTransaction(t core.Transaction, accountID int64) *pb.Transaction {
+	amount, currency := t.AmountFor(accountID)
+	res := &pb.Transaction{
 		Id:            t.ID.String(),
-		Amount:        t.Amount,
+		Amount:        fromCoreMoney(amount, currency),
 		Type:          fromCoreTransactionType(t.Type),
 		SenderName:    t.FromAccountName,
 		RecipientName: t.ToAccountName,
 		CreatedAt:     timestamppb.New(t.CreatedAt),
 		IsRolledBack:  t.IsRolledBack,
+		BalanceAfter:  fromCoreMoney(t.BalanceAfter(accountID), currency),
+	}
+	// Show the account's labels, set in histories only
+	res.Category, res.CategorySuggested, res.Tags = t.Category.String(), t.CategorySuggested, t.Tags
+	// Show what was reversed so far in the viewing account's currency
+	if accountID == t.ToAccountID && t.ToCurrency != "" {
+		res.ReversedAmount = fromCoreMoney(t.ReversedToAmount, currency)
+	} else {
+		res.ReversedAmount = fromCoreMoney(t.ReversedAmount, currency)
+	}
+	// Link reversals to the transaction they reverse
+	if t.Type == core.TransactionTypeReversal {
+		reversedID := t.ReversedTransactionID.String()
+		res.ReversedTransactionId = &reversedID
+		res.Reason = &t.Reason
+	} else if t.Reason != "" {
+		res.Reason = &t.Reason
+	}
+	// Link pot moves to their pot
+	if t.Type == core.TransactionTypePot {
+		potID := t.PotID.String()
+		res.PotId = &potID
+	}
+	// Show the amount on the other side of cross-currency transfers
+	if t.ExchangeRate != "" {
+		if accountID == t.ToAccountID {
+			res.CounterAmount = fromCoreMoney(t.Amount, t.Currency)
+		} else {
+			res.CounterAmount = fromCoreMoney(t.ToAmount, t.ToCurrency)
+		}
+		res.ExchangeRate = &t.ExchangeRate
+	}
+	return res
+}
+
+func fromCoreStandingOrder(o core.StandingOrder) *pb.StandingOrder {
+	res := &pb.StandingOrder{
+		Id:             o.ID.String(),
+		FromCardNumber: core.MaskCardNumber(o.FromCard),
+		ToCardNumber:   core.MaskCardNumber(o.ToCard),
+		Amount:         fromCoreMoney(o.Amount, o.Currency),
+		Status:         fromCoreStandingOrderStatus(o.Status),
+		CreatedAt:      timestamppb.New(o.CreatedAt),
+	}
+	if o.Schedule.Cron != "" {
+		res.Schedule = &pb.StandingOrder_Cron{Cron: o.Schedule.Cron}
+	} else {
+		res.Schedule = &pb.StandingOrder_DayOfMonth{DayOfMonth: int32(o.Schedule.DayOfMonth)}
+	}
+	if !o.EndDate.IsZero() {
+		res.EndDate = timestamppb.New(o.EndDate)
+	}
+	if !o.NextRunAt.IsZero() {
+		res.NextRunAt = timestamppb.New(o.NextRunAt)
+	}
+	return res
+}
+
+func fromCoreStandingOrderStatus(status core.StandingOrderStatus) pb.StandingOrderStatus {
+	switch status {
+	case core.StandingOrderStatusActive:
+		return pb.StandingOrderStatus_STANDING_ORDER_ACTIVE
+	case core.StandingOrderStatusCompleted:
+		return pb.StandingOrderStatus_STANDING_ORDER_COMPLETED
+	case core.StandingOrderStatusCancelled:
+		return pb.StandingOrderStatus_STANDING_ORDER_CANCELLED
+	default:
+		return pb.StandingOrderStatus_STANDING_ORDER_STATUS_UNKNOWN
+	}
+}
+
+func fromCoreStandingOrderExecution(e core.StandingOrderExecution) *pb.StandingOrderExecution {
+	res := &pb.StandingOrderExecution{
+		Id:          e.ID.String(),
+		ScheduledAt: timestamppb.New(e.ScheduledAt),
+		ExecutedAt:  timestamppb.New(e.ExecutedAt),
+	}
+	switch e.Status {
+	case core.StandingOrderExecutionStatusSucceeded:
+		res.Status = pb.StandingOrderExecutionStatus_STANDING_ORDER_EXECUTION_SUCCEEDED
+		transactionID := e.TransactionID.String()
+		res.TransactionId = &transactionID
+	case core.StandingOrderExecutionStatusFailed:
+		res.Status = pb.StandingOrderExecutionStatus_STANDING_ORDER_EXECUTION_FAILED
+		res.FailureReason = &e.FailureReason
+	}
+	return res
+}
+
+func fromCorePaymentRequest(r core.PaymentRequest) *pb.PaymentRequest {
+	res := &pb.PaymentRequest{
+		Id:          r.ID.String(),
+		RequesterId: r.RequesterExternalID.String(),
+		PayerId:     r.PayerExternalID.String(),
+		Amount:      fromCoreMoney(r.Amount, r.Currency),
+		Note:        r.Note,
+		Status:      fromCorePaymentRequestStatus(r.Status),
+		ExpiresAt:   timestamppb.New(r.ExpiresAt),
+		CreatedAt:   timestamppb.New(r.CreatedAt),
+	}
+	if r.TransactionID != uuid.Nil {
+		transactionID := r.TransactionID.String()
+		res.TransactionId = &transactionID
+	}
+	if r.SplitID != uuid.Nil {
+		splitID := r.SplitID.String()
+		res.SplitId = &splitID
+	}
+	return res
+}
+
+func fromCorePaymentRequestStatus(status core.PaymentRequestStatus) pb.PaymentRequestStatus {
+	switch status {
+	case core.PaymentRequestStatusPending:
+		return pb.PaymentRequestStatus_PAYMENT_REQUEST_PENDING
+	case core.PaymentRequestStatusPaid:
+		return pb.PaymentRequestStatus_PAYMENT_REQUEST_PAID
+	case core.PaymentRequestStatusDeclined:
+		return pb.PaymentRequestStatus_PAYMENT_REQUEST_DECLINED
+	case core.PaymentRequestStatusCancelled:
+		return pb.PaymentRequestStatus_PAYMENT_REQUEST_CANCELLED
+	case core.PaymentRequestStatusExpired:
+		return pb.PaymentRequestStatus_PAYMENT_REQUEST_EXPIRED
+	default:
+		return pb.PaymentRequestStatus_PAYMENT_REQUEST_STATUS_UNKNOWN
+	}
+}
+
+func fromCoreBatchTransferItem(item core.BatchTransferItem) *pb.BatchTransferResponse_Item {
+	res := &pb.BatchTransferResponse_Item{}
+	switch item.Status {
+	case core.BatchItemStatusSucceeded:
+		res.Status = pb.BatchItemStatus_BATCH_ITEM_SUCCEEDED
+		res.Transaction = fromCoreTransaction(item.Transaction, item.Transaction.FromAccountID)
+	case core.BatchItemStatusFailed:
+		res.Status = pb.BatchItemStatus_BATCH_ITEM_FAILED
+		res.Error = &item.Error
+	case core.BatchItemStatusSkipped:
+		res.Status = pb.BatchItemStatus_BATCH_ITEM_SKIPPED
+	}
+	return res
+}
+
+// toCoreBatchMode converts a batch mode, unknown modes are empty & fail validation
+func toCoreBatchMode(mode pb.BatchMode) core.BatchMode {
+	switch mode {
+	case pb.BatchMode_BATCH_ALL_OR_NOTHING:
+		return core.BatchModeAllOrNothing
+	case pb.BatchMode_BATCH_BEST_EFFORT:
+		return core.BatchModeBestEffort
+	default:
+		return ""
+	}
+}
+
+func fromCoreSplit(s core.Split) *pb.Split {
+	res := &pb.Split{
+		Id:             s.ID.String(),
+		RequesterId:    s.RequesterExternalID.String(),
+		Amount:         fromCoreMoney(s.Amount, s.Currency),
+		Method:         fromCoreSplitMethod(s.Method),
+		Note:           s.Note,
+		Shares:         make([]*pb.PaymentRequest, len(s.Shares)),
+		RequesterShare: fromCoreMoney(s.RequesterShare(), s.Currency),
+		Outstanding:    fromCoreMoney(s.Outstanding(time.Now().UTC()), s.Currency),
+		Settled:        fromCoreMoney(s.Settled(), s.Currency),
+		CreatedAt:      timestamppb.New(s.CreatedAt),
+	}
+	for i, share := range s.Shares {
+		res.Shares[i] = fromCorePaymentRequest(share)
+	}
+	return res
+}
+
+func fromCoreSplitMethod(method core.SplitMethod) pb.SplitMethod {
+	switch method {
+	case core.SplitMethodEqual:
+		return pb.SplitMethod_SPLIT_EQUAL
+	case core.SplitMethodPercentage:
+		return pb.SplitMethod_SPLIT_PERCENTAGE
+	case core.SplitMethodAmount:
+		return pb.SplitMethod_SPLIT_AMOUNT
+	default:
+		return pb.SplitMethod_SPLIT_METHOD_UNKNOWN
+	}
+}
+
+// toCoreSplitMethod converts a split method, unknown methods are empty & fail validation
+func toCoreSplitMethod(method pb.SplitMethod) core.SplitMethod {
+	switch method {
+	case pb.SplitMethod_SPLIT_EQUAL:
+		return core.SplitMethodEqual
+	case pb.SplitMethod_SPLIT_PERCENTAGE:
+		return core.SplitMethodPercentage
+	case pb.SplitMethod_SPLIT_AMOUNT:
+		return core.SplitMethodAmount
+	default:
+		return ""
+	}
+}
+
+func fromCoreSpendingLimit(l core.SpendingLimit) *pb.SpendingLimit {
+	res := &pb.SpendingLimit{
+		Type:           fromCoreTransactionType(l.Type),
+		PerTransaction: l.PerTransaction,
+		Daily:          l.Daily,
+		Monthly:        l.Monthly,
+		UpdatedAt:      timestamppb.New(l.UpdatedAt),
+	}
+	if l.CardNumber != "" {
+		res.Subject = &pb.SpendingLimit_CardNumber{CardNumber: l.CardNumber}
+	} else {
+		res.Subject = &pb.SpendingLimit_AccountId{AccountId: l.AccountID}
+	}
+	return res
+}
+
+func fromCoreBudget(b core.Budget) *pb.Budget {
+	return &pb.Budget{
+		AccountId: b.AccountID,
+		Category:  b.Category.String(),
+		Amount:    b.Amount,
+		UpdatedAt: timestamppb.New(b.UpdatedAt),
+	}
+}
+
+func fromCorePot(p core.Pot) *pb.Pot {
+	res := &pb.Pot{
+		Id:        p.ID.String(),
+		AccountId: p.AccountID,
+		Name:      p.Name,
+		Balance:   fromCoreMoney(p.Balance, p.Currency),
+		Progress:  int32(p.Progress()),
+		CreatedAt: timestamppb.New(p.CreatedAt),
+	}
+	if p.TargetAmount > 0 {
+		res.TargetAmount = fromCoreMoney(p.TargetAmount, p.Currency)
+	}
+	if !p.TargetDate.IsZero() {
+		res.TargetDate = timestamppb.New(p.TargetDate)
+	}
+	return res
+}
+
+func toCorePotMove(move pb.PotMove) core.PotMove {
+	switch move {
+	case pb.PotMove_POT_DEPOSIT:
+		return core.PotMoveDeposit
+	case pb.PotMove_POT_WITHDRAW:
+		return core.PotMoveWithdraw
+	default:
+		return ""
+	}
+}
+
+func fromCoreCard(c core.Card) *pb.GetCardsResponse_Card {
+	res := &pb.GetCardsResponse_Card{
+		Number:    core.MaskCardNumber(c.Number),
+		Token:     c.Number,
+		Status:    fromCoreCardStatus(c.Status),
+		ExpiresAt: timestamppb.New(c.ExpiresAt),
+		CreatedAt: timestamppb.New(c.CreatedAt),
+		PinSet:    c.HasPIN(),
+		Kind:      fromCoreCardKind(c.Kind),
+	}
+	if c.SpendingCap > 0 {
+		res.SpendingCap = &c.SpendingCap
+	}
+	if c.LockedToAccountID != 0 {
+		res.LockedToAccountId = &c.LockedToAccountID
+	}
+	if c.PINLockedUntil.After(time.Now()) {
+		res.PinLockedUntil = timestamppb.New(c.PINLockedUntil)
+	}
+	if c.Nickname != "" {
+		res.Nickname = &c.Nickname
+	}
+	if c.ReplacedBy != "" {
+		res.ReplacedBy = &c.ReplacedBy
+	}
+	return res
+}
+
+func fromCoreCardStatus(status core.CardStatus) pb.CardStatus {
+	switch status {
+	case core.CardStatusActive:
+		return pb.CardStatus_CARD_ACTIVE
+	case core.CardStatusBlocked:
+		return pb.CardStatus_CARD_BLOCKED
+	case core.CardStatusLost:
+		return pb.CardStatus_CARD_LOST
+	case core.CardStatusExpired:
+		return pb.CardStatus_CARD_EXPIRED
+	default:
+		return pb.CardStatus_CARD_STATUS_UNKNOWN
+	}
+}
+
+func toCoreCardStatus(status pb.CardStatus) core.CardStatus {
+	switch status {
+	case pb.CardStatus_CARD_ACTIVE:
+		return core.CardStatusActive
+	case pb.CardStatus_CARD_BLOCKED:
+		return core.CardStatusBlocked
+	case pb.CardStatus_CARD_LOST:
+		return core.CardStatusLost
+	case pb.CardStatus_CARD_EXPIRED:
+		return core.CardStatusExpired
+	default:
+		return ""
+	}
+}
+
+func toCoreStatementFormat(format pb.StatementFormat) core.StatementFormat {
+	switch format {
+	case pb.StatementFormat_STATEMENT_CSV:
+		return core.StatementFormatCSV
+	case pb.StatementFormat_STATEMENT_OFX:
+		return core.StatementFormatOFX
+	case pb.StatementFormat_STATEMENT_PDF:
+		return core.StatementFormatPDF
+	default:
+		return ""
+	}
+}
+
+func toCoreTransactionDirection(direction pb.TransactionDirection) core.TransactionDirection {
+	switch direction {
+	case pb.TransactionDirection_TRANSACTION_INCOMING:
+		return core.TransactionDirectionIncoming
+	case pb.TransactionDirection_TRANSACTION_OUTGOING:
+		return core.TransactionDirectionOutgoing
+	default:
+		return ""
+	}
+}
+
+func fromCoreCardKind(kind core.CardKind) pb.CardKind {
+	switch kind {
+	case core.CardKindPhysical:
+		return pb.CardKind_CARD_PHYSICAL
+	case core.CardKindVirtual:
+		return pb.CardKind_CARD_VIRTUAL
+	case core.CardKindSingleUse:
+		return pb.CardKind_CARD_SINGLE_USE
+	default:
+		return pb.CardKind_CARD_KIND_UNKNOWN
+	}
+}
+
+func toCoreCardKind(kind pb.CardKind) core.CardKind {
+	switch kind {
+	case pb.CardKind_CARD_PHYSICAL:
+		return core.CardKindPhysical
+	case pb.CardKind_CARD_VIRTUAL:
+		return core.CardKindVirtual
+	case pb.CardKind_CARD_SINGLE_USE:
+		return core.CardKindSingleUse
+	default:
+		return ""
 	}
 }
 
 func fromCoreAccount(a core.Account) *pb.GetAccountsResponse_Account {
-	return &pb.GetAccountsResponse_Account{
-		Id:       a.ID,
-		Name:     a.Name,
-		Currency: fromCoreCurrency(a.Currency),
-		Balance:  a.Balance,
+	res := &pb.GetAccountsResponse_Account{
+		Id:               a.ID,
+		Name:             a.Name,
+		Currency:         a.Currency.String(),
+		Balance:          fromCoreMoney(a.Balance, a.Currency),
+		AvailableBalance: fromCoreMoney(a.AvailableBalance, a.Currency),
+		IsDefault:        a.IsDefault,
+		Status:           fromCoreAccountStatus(a.Status),
+		FrozenByOps:      a.FrozenByOps,
+	}
+	if !a.ClosedAt.IsZero() {
+		res.ClosedAt = timestamppb.New(a.ClosedAt)
+	}
+	res.Pots = make([]*pb.Pot, len(a.Pots))
+	for i, p := range a.Pots {
+		res.Pots[i] = fromCorePot(p)
+	}
+	return res
+}
+
+func fromCoreAccountStatus(status core.AccountStatus) pb.AccountStatus {
+	switch status {
+	case core.AccountStatusActive:
+		return pb.AccountStatus_ACCOUNT_ACTIVE
+	case core.AccountStatusFrozen:
+		return pb.AccountStatus_ACCOUNT_FROZEN
+	case core.AccountStatusClosed:
+		return pb.AccountStatus_ACCOUNT_CLOSED
+	default:
+		return pb.AccountStatus_ACCOUNT_STATUS_UNKNOWN
+	}
+}
+
+func fromCoreMoney(amount int64, c core.Currency) *pb.Money {
+	return &pb.Money{
+		Amount:   amount,
+		Currency: c.String(),
 	}
 }
 
@@ -178,24 +1321,31 @@ func fromCoreTransactionType(transactionType core.TransactionType) pb.Transactio
 		return pb.TransactionType_WITHDRAWAL
 	case core.TransactionTypeTransfer:
 		return pb.TransactionType_TRANSFER
+	case core.TransactionTypeReversal:
+		return pb.TransactionType_REVERSAL
+	case core.TransactionTypePot:
+		return pb.TransactionType_POT
 	default:
 		return pb.TransactionType_UNKNOWN
 	}
 }
 
-func fromCoreCurrency(c core.Currency) pb.Currency {
-	switch c {
-	case core.CurrencyUSD:
-		return pb.Currency_USD
-	case core.CurrencyEUR:
-		return pb.Currency_EUR
-	case core.CurrencyGBP:
-		return pb.Currency_GBP
-	case core.CurrencyRUB:
-		return pb.Currency_RUB
-	case core.CurrencyEGP:
-		return pb.Currency_EGP
-	default:
-		return pb.Currency_UNDEFINED
+func fromCoreCurrency(c core.CurrencyInfo) *pb.Currency {
+	return &pb.Currency{
+		Code:       c.Code.String(),
+		IsoNumber:  int32(c.ISONumber),
+		MinorUnits: int32(c.MinorUnits),
+	}
+}
+
+func toCoreCurrency(code string) core.Currency {
+	return core.ParseCurrency(code)
+}
+
+// toTime converts an optional timestamp, zero time if not set
+func toTime(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
 	}
+	return t.AsTime()
 }
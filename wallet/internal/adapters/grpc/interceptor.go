@@ -43,3 +43,16 @@ func (ai *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		},
 	)
 }
+
+// Stream returns a StreamServerInterceptor that validates the access token and set the user id in the stream's context
+func (ai *AuthInterceptor) Stream() grpc.StreamServerInterceptor {
+	return interceptors.TokenStreamInterceptor(
+		unauthorizedRequests,
+		func(ctx context.Context, accessToken string) (string, error) {
+			ctx, span := tracer.Tracer().Start(ctx, "ValidateToken")
+			defer span.End()
+			response, err := ai.c.ValidateToken(ctx, &pb.ValidateTokenRequest{AccessToken: accessToken})
+			return response.GetUserId(), err
+		},
+	)
+}
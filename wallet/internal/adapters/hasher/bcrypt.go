@@ -0,0 +1,30 @@
+package hasher
+
+import (
+	"context"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type BcryptHasher struct{}
+
+func NewBcryptHasher() *BcryptHasher {
+	return &BcryptHasher{}
+}
+
+func (c *BcryptHasher) Hash(ctx context.Context, secret string) (string, error) {
+	_, span := tracer.Tracer().Start(ctx, "BcryptHasher.Hash")
+	defer span.End()
+	hash, err := bcrypt.GenerateFromPassword([]byte(secret), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (c *BcryptHasher) Compare(ctx context.Context, hash, secret string) bool {
+	_, span := tracer.Tracer().Start(ctx, "BcryptHasher.Compare")
+	defer span.End()
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(secret)) == nil
+}
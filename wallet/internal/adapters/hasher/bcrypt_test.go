@@ -0,0 +1,20 @@
+package hasher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBcryptHash(t *testing.T) {
+	ctx := context.Background()
+	c := NewBcryptHasher()
+	// Hash the secret
+	hash, err := c.Hash(ctx, "123")
+	require.NoError(t, err)
+	// Compare the hash with the secret
+	require.True(t, c.Compare(ctx, hash, "123"))
+	// Compare the hash with a different secret
+	require.False(t, c.Compare(ctx, hash, "124"))
+}
@@ -34,23 +34,33 @@ func (l *Locker) cleanup(ctx context.Context, frequency time.Duration) {
 				return true
 			})
 		case <-ctx.Done():
+			ticker.Stop()
+			return
 		}
 	}
 }
 
-// values passed to Lock must be of the same type [either all strings or all ints]
+// Lock locks the keys and returns the function unlocking them, keys are either strings or integers of any size
+// Keys are locked in a global order & repeated keys once, so that callers locking overlapping keys never deadlock
 func (l *Locker) Lock(ctx context.Context, x any, y ...any) func() {
 	if ctx.Err() != nil {
 		return func() { /* Return empty function since context is empty */ }
 	}
-	locks := make([]*sync.Mutex, len(y)+1)
-	// sort the keys to avoid deadlocks
 	arr := orderedLocks(append([]any{x}, y...))
+	for i, key := range arr {
+		arr[i] = lockKey(key)
+	}
+	// sort the keys to avoid deadlocks
 	sort.Sort(arr)
+	locks := make([]*sync.Mutex, 0, len(arr))
 	for i, key := range arr {
+		// a mutex locked twice by the same caller deadlocks
+		if i > 0 && key == arr[i-1] {
+			continue
+		}
 		lock, _ := l.mx.LoadOrStore(key, &sync.Mutex{})
 		lock.(*sync.Mutex).Lock()
-		locks[i] = lock.(*sync.Mutex)
+		locks = append(locks, lock.(*sync.Mutex))
 	}
 	return func() {
 		for _, lock := range locks {
@@ -59,20 +69,37 @@ func (l *Locker) Lock(ctx context.Context, x any, y ...any) func() {
 	}
 }
 
+// lockKey converts integer keys to int64, so that the same id locks the same mutex whatever its type
+func lockKey(key any) any {
+	switch k := key.(type) {
+	case int:
+		return int64(k)
+	case int32:
+		return int64(k)
+	case int64, string:
+		return k
+	default:
+		panic("Lock key must either be strings or integers")
+	}
+}
+
 type orderedLocks []any
 
 func (o orderedLocks) Len() int {
 	return len(o)
 }
 
+// Less orders integers before strings
 func (o orderedLocks) Less(i int, j int) bool {
-	switch o[i].(type) {
+	switch x := o[i].(type) {
+	case int64:
+		y, ok := o[j].(int64)
+		return !ok || x < y
 	case string:
-		return o[i].(string) < o[j].(string)
-	case int:
-		return o[i].(int) < o[j].(int)
+		y, ok := o[j].(string)
+		return ok && x < y
 	default:
-		panic("Lock key must either be strings or ints")
+		panic("Lock key must either be strings or integers")
 	}
 }
 
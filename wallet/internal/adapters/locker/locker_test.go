@@ -50,6 +50,24 @@ func TestLocker_Lock(t *testing.T) {
 				f:   time.Minute,
 			},
 		},
+		{
+			name: "lock integers of any size",
+			args: args{
+				ctx: context.Background(),
+				x:   int64(3),
+				y:   []any{1, int32(2)},
+				f:   time.Minute,
+			},
+		},
+		{
+			name: "lock repeated keys",
+			args: args{
+				ctx: context.Background(),
+				x:   int64(1),
+				y:   []any{1, "x", "x", int64(2)},
+				f:   time.Minute,
+			},
+		},
 		{
 			name: "test cleanup",
 			args: args{
@@ -67,7 +85,7 @@ func TestLocker_Lock(t *testing.T) {
 			unlock := l.Lock(tt.args.ctx, tt.args.x, tt.args.y...)
 			unlock()
 			for _, key := range append([]any{tt.args.x}, tt.args.y...) {
-				_, ok := l.mx.Load(key)
+				_, ok := l.mx.Load(lockKey(key))
 				if !ok {
 					t.Errorf("lock not found")
 				}
@@ -75,7 +93,7 @@ func TestLocker_Lock(t *testing.T) {
 			if tt.args.wait {
 				time.Sleep(tt.args.f * 2)
 				for _, key := range append([]any{tt.args.x}, tt.args.y...) {
-					_, ok := l.mx.Load(key)
+					_, ok := l.mx.Load(lockKey(key))
 					if ok {
 						t.Errorf("lock not cleaned up")
 					}
@@ -84,3 +102,30 @@ func TestLocker_Lock(t *testing.T) {
 		})
 	}
 }
+
+func TestLocker_LockOrder(t *testing.T) {
+	l := NewLocker(context.TODO(), time.Minute)
+	var wg sync.WaitGroup
+	// Overlapping keys passed in different orders must not deadlock
+	keys := [][]any{{int64(1), int64(2), int64(3)}, {int64(3), 2, int64(1)}, {int64(2), int64(3), int64(3)}}
+	for _, k := range keys {
+		wg.Add(1)
+		go func(k []any) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				unlock := l.Lock(context.TODO(), k[0], k[1:]...)
+				unlock()
+			}
+		}(k)
+	}
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("deadlock")
+	}
+}
@@ -2,25 +2,81 @@ package numgen
 
 import (
 	"context"
-
-	"github.com/brianvoe/gofakeit/v6"
+	"crypto/rand"
+	"fmt"
+	"math/big"
 )
 
+const cvvLength = 3
+
 type NumGen struct {
-	l int // length of the generated number
+	l   int    // length of the generated number, check digit included
+	bin string // bank identification number, prefix of the generated numbers
 }
 
-func NewNumGen(l int) *NumGen {
-	return &NumGen{l: l}
+// NewNumGen returns a generator of `l` digits card numbers starting with `bin` & ending with a Luhn check digit
+func NewNumGen(l int, bin string) (*NumGen, error) {
+	if !isDigits(bin) {
+		return nil, fmt.Errorf("card bin must only contain digits, bin: %s", bin)
+	}
+	// At least one random digit between the bin & the check digit
+	if len(bin)+2 > l {
+		return nil, fmt.Errorf("card number length must exceed the bin length by 2 at least, length: %d, bin: %s", l, bin)
+	}
+	return &NumGen{l: l, bin: bin}, nil
 }
 
 func (n *NumGen) GenCardNumber(ctx context.Context) (string, error) {
+	digits, err := genDigits(ctx, n.l-len(n.bin)-1)
+	if err != nil {
+		return "", err
+	}
+	number := n.bin + digits
+	return number + string(luhnCheckDigit(number)), nil
+}
+
+func (n *NumGen) GenCVV(ctx context.Context) (string, error) {
+	return genDigits(ctx, cvvLength)
+}
+
+// genDigits returns `l` digits read from a cryptographically secure random source
+func genDigits(ctx context.Context, l int) (string, error) {
 	if err := ctx.Err(); err != nil {
 		return "", err
 	}
-	var number []byte
-	for i := 0; i < n.l; i++ {
-		number = append(number, byte(gofakeit.Number(0, 9)+'0'))
+	number := make([]byte, l)
+	for i := range number {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		number[i] = byte(d.Int64()) + '0'
 	}
 	return string(number), nil
 }
+
+// luhnCheckDigit returns the digit appended to the payload so that the whole number passes the Luhn check
+func luhnCheckDigit(payload string) byte {
+	sum := 0
+	for i := len(payload) - 1; i >= 0; i-- {
+		d := int(payload[i] - '0')
+		// Double every other digit starting with the rightmost one, the check digit goes on its right
+		if (len(payload)-1-i)%2 == 0 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return byte((10-sum%10)%10) + '0'
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
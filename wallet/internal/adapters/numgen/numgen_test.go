@@ -2,14 +2,27 @@ package numgen
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 )
 
+func TestNewNumGen(t *testing.T) {
+	_, err := NewNumGen(16, "400000")
+	require.NoError(t, err)
+	_, err = NewNumGen(16, "")
+	require.NoError(t, err)
+	_, err = NewNumGen(16, "40a000")
+	require.Error(t, err)
+	_, err = NewNumGen(7, "400000")
+	require.Error(t, err)
+}
+
 func TestNumGen_GenCardNumber(t *testing.T) {
 	type fields struct {
-		l int
+		l   int
+		bin string
 	}
 	type args struct {
 		ctx func() context.Context
@@ -21,7 +34,8 @@ func TestNumGen_GenCardNumber(t *testing.T) {
 		check  func(t *testing.T, card string, err error)
 	}{
 		{
-			name: "conetxt timeout",
+			name:   "conetxt timeout",
+			fields: fields{l: 16},
 			args: args{ctx: func() context.Context {
 				ctx, cancel := context.WithCancel(context.Background())
 				defer cancel()
@@ -34,29 +48,53 @@ func TestNumGen_GenCardNumber(t *testing.T) {
 		},
 		{
 			name:   "16",
-			fields: fields{l: 16},
+			fields: fields{l: 16, bin: "400000"},
 			args:   args{ctx: func() context.Context { return context.Background() }},
 			check: func(t *testing.T, s string, err error) {
 				require.NoError(t, err)
 				require.Len(t, s, 16)
+				require.True(t, strings.HasPrefix(s, "400000"))
+				require.True(t, isLuhnValid(s))
 			},
 		},
 		{
-			name:   "32",
-			fields: fields{l: 32},
+			name:   "19",
+			fields: fields{l: 19, bin: "5"},
 			args:   args{ctx: func() context.Context { return context.Background() }},
 			check: func(t *testing.T, s string, err error) {
 				require.NoError(t, err)
-				require.Len(t, s, 32)
+				require.Len(t, s, 19)
+				require.True(t, strings.HasPrefix(s, "5"))
+				require.True(t, isLuhnValid(s))
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			n := NewNumGen(tt.fields.l)
+			n, err := NewNumGen(tt.fields.l, tt.fields.bin)
+			require.NoError(t, err)
 			got, err := n.GenCardNumber(tt.args.ctx())
 			tt.check(t, got, err)
 		})
 	}
 }
+
+func TestNumGen_GenCVV(t *testing.T) {
+	n, err := NewNumGen(16, "")
+	require.NoError(t, err)
+	cvv, err := n.GenCVV(context.Background())
+	require.NoError(t, err)
+	require.Len(t, cvv, cvvLength)
+}
+
+func TestLuhnCheckDigit(t *testing.T) {
+	// Known valid numbers, their last digit is the check digit
+	for _, number := range []string{"79927398713", "4111111111111111", "5500005555555559", "4000000000000002"} {
+		require.Equal(t, number[len(number)-1], luhnCheckDigit(number[:len(number)-1]), number)
+	}
+}
+
+func isLuhnValid(number string) bool {
+	return luhnCheckDigit(number[:len(number)-1]) == number[len(number)-1]
+}
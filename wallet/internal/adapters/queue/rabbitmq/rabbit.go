@@ -0,0 +1,124 @@
+package rabbitmq
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+	amqp "github.com/rabbitmq/amqp091-go"
+)
+
+type Producer struct {
+	q       *amqp.Connection
+	prq     string // paymentRequestQueueName
+	bq      string // budgetAlertQueueName
+	msgChan *amqp.Channel
+}
+
+func NewProducer(url string, opts ...func(*Producer)) (*Producer, error) {
+	// Connect to rabbitmq server
+	conn, err := amqp.Dial(url)
+	if err != nil {
+		return nil, errs.B(err).Code(errs.InvalidArgument).Msg("failed to connect to rabbitmq").Err()
+	}
+	// Create producer
+	p := &Producer{q: conn}
+	for _, opt := range opts {
+		opt(p)
+	}
+	// Check if queue name is set for sending payment request messages
+	if p.prq == "" {
+		return nil, errs.B(err).Code(errs.InvalidArgument).
+			Msg("failed to parse queue name for sending payment request message").Err()
+	}
+	// Check if queue name is set for sending budget alert messages
+	if p.bq == "" {
+		return nil, errs.B(err).Code(errs.InvalidArgument).
+			Msg("failed to parse queue name for sending budget alert message").Err()
+	}
+	// Create user channel
+	p.msgChan, err = p.q.Channel()
+	if err != nil {
+		return nil, errs.B(err).Code(errs.InvalidArgument).Msg("failed to open a channel").Err()
+	}
+	for _, queue := range []string{p.prq, p.bq} {
+		_, err = p.msgChan.QueueDeclare(
+			queue, // name
+			true,
+			false,
+			false,
+			false,
+			nil,
+		)
+		if err != nil {
+			return nil, errs.B(err).Code(errs.Internal).Msg("failed to declare a queue for producer").Err()
+		}
+	}
+	return p, nil
+}
+
+// WithPaymentRequestQueue sets the queue name for sending payment request messages
+func WithPaymentRequestQueue(name string) func(*Producer) {
+	return func(r *Producer) {
+		r.prq = name
+	}
+}
+
+// WithBudgetAlertQueue sets the queue name for sending budget alert messages
+func WithBudgetAlertQueue(name string) func(*Producer) {
+	return func(r *Producer) {
+		r.bq = name
+	}
+}
+
+// SendPaymentRequestMessage sends a message to the queue to send a payment request email to the payer
+func (r *Producer) SendPaymentRequestMessage(ctx context.Context, params core.PaymentRequestMessage) error {
+	ctx, span := tracer.Tracer().Start(ctx, "rabbitmq.SendPaymentRequestMessage")
+	defer span.End()
+	return r.publish(ctx, r.prq, params)
+}
+
+// SendBudgetAlertMessage sends a message to the queue to send a budget alert email to the account's owner
+func (r *Producer) SendBudgetAlertMessage(ctx context.Context, params core.BudgetAlertMessage) error {
+	ctx, span := tracer.Tracer().Start(ctx, "rabbitmq.SendBudgetAlertMessage")
+	defer span.End()
+	return r.publish(ctx, r.bq, params)
+}
+
+// publish sends the message marshalled as json to the queue
+func (r *Producer) publish(ctx context.Context, queue string, message any) error {
+	// Marshal message
+	b, err := json.Marshal(message)
+	if err != nil {
+		return errs.B(err).Code(errs.InvalidArgument).Msg("failed to marshal message").Err()
+	}
+	// Publish message to queue
+	err = r.msgChan.PublishWithContext(ctx,
+		"",
+		queue,
+		false,
+		false,
+		amqp.Publishing{
+			ContentType: "application/json",
+			Body:        b,
+		})
+	if err != nil {
+		return errs.B(err).Code(errs.InvalidArgument).Msg("failed to publish message").Err()
+	}
+	return nil
+}
+
+// Close closes the connection to the queue
+func (r *Producer) Close() error {
+	err := r.msgChan.Close()
+	if err != nil {
+		return errs.B(err).Code(errs.Internal).Msg("failed to close message channel").Err()
+	}
+	err = r.q.Close()
+	if err != nil {
+		return errs.B(err).Code(errs.Internal).Msg("failed to close connection with rabbitmq").Err()
+	}
+	return nil
+}
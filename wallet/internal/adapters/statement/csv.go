@@ -0,0 +1,33 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+var csvHeader = []string{"date", "transaction_id", "type", "description", "amount", "balance"}
+
+// renderCSV writes a row per transaction between an opening & a closing balance row, amounts are decimals in the account's currency
+func renderCSV(s core.Statement) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	amount := func(a int64) string { return core.FormatDecimal(a, s.Account.MinorUnits) }
+	rows := [][]string{csvHeader, {formatTime(s.From), "", "", "Opening balance", "", amount(s.OpeningBalance)}}
+	for _, l := range s.Lines {
+		rows = append(rows, []string{
+			formatTime(l.Transaction.CreatedAt),
+			l.Transaction.ID.String(),
+			l.Transaction.Type.String(),
+			l.Description,
+			amount(l.Amount),
+			amount(l.Balance),
+		})
+	}
+	rows = append(rows, []string{formatTime(s.To), "", "", "Closing balance", "", amount(s.ClosingBalance)})
+	if err := w.WriteAll(rows); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
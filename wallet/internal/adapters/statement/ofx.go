@@ -0,0 +1,131 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strconv"
+	"time"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+const ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+`
+
+// ofxNameMaxLength is the max length of a transaction's payee name in OFX
+const ofxNameMaxLength = 32
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxTransaction struct {
+	Type   string `xml:"TRNTYPE"`
+	Posted string `xml:"DTPOSTED"`
+	Amount string `xml:"TRNAMT"`
+	ID     string `xml:"FITID"`
+	Name   string `xml:"NAME"`
+	Memo   string `xml:"MEMO,omitempty"`
+}
+
+type ofxBalance struct {
+	Amount string `xml:"BALAMT"`
+	AsOf   string `xml:"DTASOF"`
+}
+
+type ofxDocument struct {
+	XMLName  xml.Name  `xml:"OFX"`
+	Status   ofxStatus `xml:"SIGNONMSGSRSV1>SONRS>STATUS"`
+	Server   string    `xml:"SIGNONMSGSRSV1>SONRS>DTSERVER"`
+	Language string    `xml:"SIGNONMSGSRSV1>SONRS>LANGUAGE"`
+	Response struct {
+		ID        string    `xml:"TRNUID"`
+		Status    ofxStatus `xml:"STATUS"`
+		Statement struct {
+			Currency string `xml:"CURDEF"`
+			Account  struct {
+				BankID string `xml:"BANKID"`
+				ID     string `xml:"ACCTID"`
+				Type   string `xml:"ACCTTYPE"`
+			} `xml:"BANKACCTFROM"`
+			Transactions struct {
+				Start        string           `xml:"DTSTART"`
+				End          string           `xml:"DTEND"`
+				Transactions []ofxTransaction `xml:"STMTTRN"`
+			} `xml:"BANKTRANLIST"`
+			LedgerBalance ofxBalance `xml:"LEDGERBAL"`
+		} `xml:"STMTRS"`
+	} `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+// renderOFX writes an OFX 2.2 bank statement, which accounting software also imports as QFX
+// OFX has no running balances, the closing balance is the statement's ledger balance
+func renderOFX(s core.Statement, bankID string) ([]byte, error) {
+	var doc ofxDocument
+	doc.Status = ofxStatus{Code: 0, Severity: "INFO"}
+	doc.Server = formatOFXTime(s.CreatedAt)
+	doc.Language = "ENG"
+	doc.Response.ID = "0"
+	doc.Response.Status = ofxStatus{Code: 0, Severity: "INFO"}
+	stmt := &doc.Response.Statement
+	stmt.Currency = s.Account.Currency.String()
+	stmt.Account.BankID = bankID
+	stmt.Account.ID = strconv.FormatInt(s.Account.ID, 10)
+	stmt.Account.Type = "CHECKING"
+	stmt.Transactions.Start = formatOFXTime(s.From)
+	stmt.Transactions.End = formatOFXTime(s.To)
+	stmt.Transactions.Transactions = make([]ofxTransaction, len(s.Lines))
+	for i, l := range s.Lines {
+		name := l.Transaction.ToAccountName
+		if l.Amount > 0 {
+			name = l.Transaction.FromAccountName
+		}
+		if r := []rune(name); len(r) > ofxNameMaxLength {
+			name = string(r[:ofxNameMaxLength])
+		}
+		stmt.Transactions.Transactions[i] = ofxTransaction{
+			Type:   ofxTransactionType(l),
+			Posted: formatOFXTime(l.Transaction.CreatedAt),
+			Amount: core.FormatDecimal(l.Amount, s.Account.MinorUnits),
+			ID:     l.Transaction.ID.String(),
+			Name:   name,
+			Memo:   l.Transaction.Reason,
+		}
+	}
+	stmt.LedgerBalance = ofxBalance{
+		Amount: core.FormatDecimal(s.ClosingBalance, s.Account.MinorUnits),
+		AsOf:   formatOFXTime(s.To),
+	}
+	var buf bytes.Buffer
+	buf.WriteString(ofxHeader)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
+
+// ofxTransactionType maps the transaction types to the OFX ones
+func ofxTransactionType(l core.StatementLine) string {
+	switch l.Transaction.Type {
+	case core.TransactionTypeDeposit:
+		return "DEP"
+	case core.TransactionTypeWithdrawal:
+		return "ATM"
+	case core.TransactionTypeTransfer:
+		return "XFER"
+	}
+	if l.Amount < 0 {
+		return "DEBIT"
+	}
+	return "CREDIT"
+}
+
+// formatOFXTime formats times in UTC with the OFX date time format
+func formatOFXTime(t time.Time) string {
+	return t.UTC().Format("20060102150405.000") + "[0:UTC]"
+}
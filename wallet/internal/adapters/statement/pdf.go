@@ -0,0 +1,135 @@
+package statement
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+// A4 pages written in a 9pt monospace font, so that the columns are aligned with spaces
+const (
+	pdfPageWidth    = 595
+	pdfPageHeight   = 842
+	pdfMargin       = 40
+	pdfFontSize     = 9
+	pdfLineHeight   = 12
+	pdfLinesPerPage = (pdfPageHeight - 2*pdfMargin) / pdfLineHeight
+)
+
+// Widths of the PDF statement columns, in characters
+const (
+	pdfDateWidth        = 20
+	pdfDescriptionWidth = 34
+	pdfTypeWidth        = 10
+	pdfAmountWidth      = 16
+)
+
+// renderPDF writes a plain statement, a header then a table of the transactions between the opening & the closing balances
+// The last line of each page is its page number
+func renderPDF(s core.Statement) []byte {
+	from, to := formatPeriod(s)
+	amount := func(a int64) string { return core.FormatDecimal(a, s.Account.MinorUnits) }
+	header := []string{
+		"Account statement",
+		"",
+		fmt.Sprintf("Account:   %s (#%d)", s.Account.Name, s.Account.ID),
+		fmt.Sprintf("Currency:  %s", s.Account.Currency),
+		fmt.Sprintf("Period:    %s to %s", from, to),
+		fmt.Sprintf("Generated: %s", formatTime(s.CreatedAt)),
+		"",
+		pdfRow("Date", "Description", "Type", "Amount", "Balance"),
+		strings.Repeat("-", pdfDateWidth+pdfDescriptionWidth+pdfTypeWidth+2*pdfAmountWidth),
+		pdfRow(formatTime(s.From), "Opening balance", "", "", amount(s.OpeningBalance)),
+	}
+	lines := header
+	for _, l := range s.Lines {
+		lines = append(lines, pdfRow(
+			formatTime(l.Transaction.CreatedAt),
+			l.Description,
+			l.Transaction.Type.String(),
+			amount(l.Amount),
+			amount(l.Balance),
+		))
+	}
+	lines = append(lines, pdfRow(formatTime(s.To), "Closing balance", "", "", amount(s.ClosingBalance)))
+	// Split the lines in pages, keeping a line for the page number
+	var pages [][]string
+	for len(lines) > 0 {
+		n := pdfLinesPerPage - 2
+		if n > len(lines) {
+			n = len(lines)
+		}
+		pages, lines = append(pages, lines[:n]), lines[n:]
+	}
+	for i := range pages {
+		pages[i] = append(pages[i], "", fmt.Sprintf("Page %d/%d", i+1, len(pages)))
+	}
+	return writePDF(pages)
+}
+
+// pdfRow aligns the cells of a row of the transactions table, long descriptions are cut
+func pdfRow(date, description, typ, amount, balance string) string {
+	if utf8.RuneCountInString(description) > pdfDescriptionWidth-1 {
+		description = string([]rune(description)[:pdfDescriptionWidth-4]) + "..."
+	}
+	return fmt.Sprintf("%-*s%-*s%-*s%*s%*s",
+		pdfDateWidth, date, pdfDescriptionWidth, description, pdfTypeWidth, typ, pdfAmountWidth, amount, pdfAmountWidth, balance)
+}
+
+// writePDF writes a PDF document of text pages, each page being a list of lines
+func writePDF(pages [][]string) []byte {
+	var buf bytes.Buffer
+	var offsets []int // of the objects, object i+1 is at offsets[i]
+	obj := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+	buf.WriteString("%PDF-1.4\n")
+	// Catalog, page tree & font are objects 1 to 3, each page is followed by its content
+	kids := make([]string, len(pages))
+	for i := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 4+2*i)
+	}
+	obj("<< /Type /Catalog /Pages 2 0 R >>")
+	obj(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)))
+	obj("<< /Type /Font /Subtype /Type1 /BaseFont /Courier /Encoding /WinAnsiEncoding >>")
+	for i, lines := range pages {
+		var content bytes.Buffer
+		fmt.Fprintf(&content, "BT\n/F1 %d Tf\n%d TL\n%d %d Td\n", pdfFontSize, pdfLineHeight, pdfMargin, pdfPageHeight-pdfMargin)
+		for _, line := range lines {
+			fmt.Fprintf(&content, "(%s) '\n", pdfEscape(line))
+		}
+		content.WriteString("ET")
+		obj(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] /Resources << /Font << /F1 3 0 R >> >> /Contents %d 0 R >>",
+			pdfPageWidth, pdfPageHeight, 5+2*i))
+		obj(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", content.Len(), content.String()))
+	}
+	// Cross-reference table, the offsets are written on 10 digits as required
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
+
+// pdfEscape escapes a line for a PDF string, characters out of Latin-1 are replaced by '?'
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(byte(r))
+		case r < 0x20 || r > 0xff || (r >= 0x7f && r < 0xa0):
+			b.WriteByte('?')
+		default:
+			b.WriteByte(byte(r))
+		}
+	}
+	return b.String()
+}
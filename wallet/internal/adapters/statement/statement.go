@@ -0,0 +1,46 @@
+package statement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+// Renderer renders account statements as CSV, OFX or PDF files
+type Renderer struct {
+	bankID string // identifies the wallet in the OFX files, e.g. to tell its accounts apart in accounting software
+}
+
+// NewRenderer returns a statements renderer, `bankID` is set as the bank id of the accounts in the OFX files
+func NewRenderer(bankID string) *Renderer {
+	return &Renderer{bankID: bankID}
+}
+
+// Render returns the statement's content in the format
+func (r *Renderer) Render(ctx context.Context, s core.Statement, format core.StatementFormat) ([]byte, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	switch format {
+	case core.StatementFormatCSV:
+		return renderCSV(s)
+	case core.StatementFormatOFX:
+		return renderOFX(s, r.bankID)
+	case core.StatementFormatPDF:
+		return renderPDF(s), nil
+	default:
+		return nil, fmt.Errorf("unsupported statement format: %s", format)
+	}
+}
+
+// formatTime formats the times of the statements in UTC, to the second
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// formatPeriod formats the period bounds of the statements, the period's end is exclusive so its last day is the day before
+func formatPeriod(s core.Statement) (string, string) {
+	return s.From.UTC().Format("2006-01-02"), s.To.UTC().Add(-time.Nanosecond).Format("2006-01-02")
+}
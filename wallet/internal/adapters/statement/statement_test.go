@@ -0,0 +1,101 @@
+package statement
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func testStatement(transactions int) core.Statement {
+	account := core.Account{ID: 7, Name: "checking (main)", Currency: core.CurrencyEUR, MinorUnits: 2}
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	var list []core.Transaction
+	for i := 0; i < transactions; i++ {
+		list = append(list, core.Transaction{
+			ID:              uuid.New(),
+			Type:            core.TransactionTypeTransfer,
+			Amount:          1250,
+			Currency:        core.CurrencyEUR,
+			FromAccountID:   7,
+			FromAccountName: "checking (main)",
+			ToAccountID:     8,
+			ToAccountName:   "rent, \"flat\"",
+			Reason:          "march",
+			CreatedAt:       from.Add(time.Duration(i) * time.Minute),
+		})
+	}
+	s := core.NewStatement(account, from, from.AddDate(0, 1, 0), 100000, list)
+	s.CreatedAt = from.AddDate(0, 1, 1)
+	return s
+}
+
+func TestRenderer_RenderCSV(t *testing.T) {
+	content, err := NewRenderer("FINGO").Render(context.Background(), testStatement(2), core.StatementFormatCSV)
+	require.NoError(t, err)
+	rows, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 5)
+	require.Equal(t, csvHeader, rows[0])
+	require.Equal(t, []string{"2024-03-01T00:00:00Z", "", "", "Opening balance", "", "1000.00"}, rows[1])
+	require.Equal(t, "rent, \"flat\" - march", rows[2][3])
+	require.Equal(t, "-12.50", rows[2][4])
+	require.Equal(t, "987.50", rows[2][5])
+	require.Equal(t, []string{"2024-04-01T00:00:00Z", "", "", "Closing balance", "", "975.00"}, rows[4])
+}
+
+func TestRenderer_RenderOFX(t *testing.T) {
+	content, err := NewRenderer("FINGO").Render(context.Background(), testStatement(2), core.StatementFormatOFX)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(content, []byte(ofxHeader)))
+	var doc ofxDocument
+	require.NoError(t, xml.Unmarshal(content, &doc))
+	stmt := doc.Response.Statement
+	require.Equal(t, "EUR", stmt.Currency)
+	require.Equal(t, "FINGO", stmt.Account.BankID)
+	require.Equal(t, "7", stmt.Account.ID)
+	require.Equal(t, "20240301000000.000[0:UTC]", stmt.Transactions.Start)
+	require.Len(t, stmt.Transactions.Transactions, 2)
+	require.Equal(t, "XFER", stmt.Transactions.Transactions[0].Type)
+	require.Equal(t, "-12.50", stmt.Transactions.Transactions[0].Amount)
+	require.Equal(t, "rent, \"flat\"", stmt.Transactions.Transactions[0].Name)
+	require.Equal(t, "975.00", stmt.LedgerBalance.Amount)
+}
+
+func TestRenderer_RenderPDF(t *testing.T) {
+	content, err := NewRenderer("FINGO").Render(context.Background(), testStatement(150), core.StatementFormatPDF)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(content, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(content, []byte("%%EOF\n")))
+	// 150 transactions & the header span 3 pages
+	require.Contains(t, string(content), "/Count 3 >>")
+	require.Contains(t, string(content), "(Page 3/3) '")
+	require.Contains(t, string(content), `checking \(main\) \(#7\)`)
+	require.Contains(t, string(content), "Closing balance")
+	// The cross-reference table points at the objects
+	xref := content[bytes.Index(content, []byte("\nxref\n"))+1:]
+	lines := strings.Split(string(xref), "\n")
+	require.Equal(t, "0 10", lines[1])
+	var offset int
+	_, err = fmt.Sscanf(lines[4], "%010d", &offset)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(content[offset:], []byte("2 0 obj")))
+}
+
+func TestRenderer_RenderUnsupportedFormat(t *testing.T) {
+	_, err := NewRenderer("FINGO").Render(context.Background(), testStatement(1), "xls")
+	require.Error(t, err)
+}
+
+func Test_pdfEscape(t *testing.T) {
+	require.Equal(t, `a\(b\)c\\d`, pdfEscape(`a(b)c\d`))
+	require.Equal(t, "caf\xe9 ?", pdfEscape("café €"))
+}
@@ -0,0 +1,162 @@
+package vault
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+const (
+	keySize   = 32 // AES-256
+	last4Size = 4
+	// tokens are the truncated keyed hash of the number followed by its last 4 digits, 64 characters in total
+	tokenHashSize = 64 - last4Size
+)
+
+// Vault tokenizes & encrypts card numbers with envelope encryption
+// Each number is encrypted with its own data key, which is encrypted with the key encryption key
+// Both the key encryption key & the tokens key are derived from the vault's key
+type Vault struct {
+	kek      cipher.AEAD
+	tokenKey []byte
+}
+
+// LoadVault reads the vault's key from a file holding 32 base64 encoded bytes
+// generate creates the key file when it's missing, it's only meant for development, elsewhere a missing key must fail
+// rather than encrypt the cards with a key nobody keeps
+func LoadVault(keyFile string, generate bool) (*Vault, error) {
+	content, err := os.ReadFile(keyFile)
+	if err != nil && generate && errors.Is(err, os.ErrNotExist) {
+		content, err = generateKeyFile(keyFile)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read card vault key file: %w", err)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(content)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode card vault key: %w", err)
+	}
+	return NewVault(key)
+}
+
+// generateKeyFile writes a new random key to the file and returns its content
+func generateKeyFile(keyFile string) ([]byte, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	content := []byte(base64.StdEncoding.EncodeToString(key) + "\n")
+	if err := os.WriteFile(keyFile, content, 0o600); err != nil {
+		return nil, err
+	}
+	return content, nil
+}
+
+func NewVault(key []byte) (*Vault, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("card vault key must be %d bytes, got %d", keySize, len(key))
+	}
+	kek, err := newAEAD(deriveKey(key, "card-number-encryption"))
+	if err != nil {
+		return nil, err
+	}
+	return &Vault{kek: kek, tokenKey: deriveKey(key, "card-number-token")}, nil
+}
+
+// Token returns the keyed hash of the card number followed by its last 4 digits
+func (v *Vault) Token(ctx context.Context, number string) string {
+	_, span := tracer.Tracer().Start(ctx, "Vault.Token")
+	defer span.End()
+	mac := hmac.New(sha256.New, v.tokenKey)
+	mac.Write([]byte(number))
+	hash := hex.EncodeToString(mac.Sum(nil))[:tokenHashSize]
+	if len(number) < last4Size {
+		return hash
+	}
+	return hash + number[len(number)-last4Size:]
+}
+
+// Encrypt encrypts the card number with a new data key, the ciphertext is bound to the number's token
+func (v *Vault) Encrypt(ctx context.Context, number string) (core.EncryptedCardNumber, error) {
+	ctx, span := tracer.Tracer().Start(ctx, "Vault.Encrypt")
+	defer span.End()
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return core.EncryptedCardNumber{}, err
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return core.EncryptedCardNumber{}, err
+	}
+	ciphertext, err := seal(dek, []byte(number), []byte(v.Token(ctx, number)))
+	if err != nil {
+		return core.EncryptedCardNumber{}, err
+	}
+	encryptedKey, err := seal(v.kek, dataKey, nil)
+	if err != nil {
+		return core.EncryptedCardNumber{}, err
+	}
+	return core.EncryptedCardNumber{Ciphertext: ciphertext, DataKey: encryptedKey}, nil
+}
+
+// Decrypt returns the card number encrypted for the token
+func (v *Vault) Decrypt(ctx context.Context, token string, encrypted core.EncryptedCardNumber) (string, error) {
+	_, span := tracer.Tracer().Start(ctx, "Vault.Decrypt")
+	defer span.End()
+	dataKey, err := open(v.kek, encrypted.DataKey, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt card data key: %w", err)
+	}
+	dek, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+	number, err := open(dek, encrypted.Ciphertext, []byte(token))
+	if err != nil {
+		return "", fmt.Errorf("failed to decrypt card number: %w", err)
+	}
+	return string(number), nil
+}
+
+func deriveKey(key []byte, purpose string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(purpose))
+	return mac.Sum(nil)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts the plaintext & prepends the random nonce used
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, additionalData []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, additionalData)
+}
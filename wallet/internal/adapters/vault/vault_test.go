@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestVault(t *testing.T) {
+	ctx := context.Background()
+	v, err := NewVault(bytes.Repeat([]byte{1}, keySize))
+	require.NoError(t, err)
+	number := "4000001234567899"
+
+	// Tokens are stable, 64 characters long & end with the last 4 digits
+	token := v.Token(ctx, number)
+	require.Len(t, token, 64)
+	require.Equal(t, "7899", token[60:])
+	require.Equal(t, token, v.Token(ctx, number))
+	require.NotEqual(t, token, v.Token(ctx, "4000001234567881"))
+
+	// Encrypting twice gives different ciphertexts, both decrypted with the number's token only
+	encrypted, err := v.Encrypt(ctx, number)
+	require.NoError(t, err)
+	again, err := v.Encrypt(ctx, number)
+	require.NoError(t, err)
+	require.NotEqual(t, encrypted.Ciphertext, again.Ciphertext)
+	decrypted, err := v.Decrypt(ctx, token, encrypted)
+	require.NoError(t, err)
+	require.Equal(t, number, decrypted)
+	_, err = v.Decrypt(ctx, v.Token(ctx, "4000001234567881"), encrypted)
+	require.Error(t, err)
+
+	// Another key neither decrypts the number nor gives the same token
+	other, err := NewVault(bytes.Repeat([]byte{2}, keySize))
+	require.NoError(t, err)
+	_, err = other.Decrypt(ctx, token, encrypted)
+	require.Error(t, err)
+	require.NotEqual(t, token, other.Token(ctx, number))
+}
+
+func TestLoadVault(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "card-vault.key")
+	err := os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, keySize))+"\n"), 0o600)
+	require.NoError(t, err)
+	_, err = LoadVault(keyFile, false)
+	require.NoError(t, err)
+
+	// Keys of the wrong size are refused
+	err = os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString([]byte("short"))), 0o600)
+	require.NoError(t, err)
+	_, err = LoadVault(keyFile, false)
+	require.Error(t, err)
+	_, err = LoadVault(filepath.Join(dir, "missing.key"), false)
+	require.Error(t, err)
+
+	// Missing keys are generated on demand only, the generated key is kept for the next loads
+	generated := filepath.Join(dir, "generated.key")
+	v, err := LoadVault(generated, true)
+	require.NoError(t, err)
+	reloaded, err := LoadVault(generated, true)
+	require.NoError(t, err)
+	require.Equal(t, v.Token(context.Background(), "4000001234567899"), reloaded.Token(context.Background(), "4000001234567899"))
+	// Existing keys are never replaced
+	_, err = LoadVault(keyFile, true)
+	require.Error(t, err)
+}
@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type CloseAccountParams struct {
+	AccountID        int64 `validate:"required,min=1"`
+	SweepToAccountID int64 `validate:"omitempty,min=1"` // another account of the caller in the same currency, receives the remaining balance
+}
+
+var (
+	errorAccountClosed    = errs.B().Code(errs.FailedPrecondition).Msg("account is closed").Err()
+	errorAccountNotEmpty  = errs.B().Code(errs.FailedPrecondition).Msg("account's balance must be empty or swept into another account to be closed").Err()
+	errorAccountHasHolds  = errs.B().Code(errs.FailedPrecondition).Msg("account has authorized holds, void them or wait for them to expire before closing it").Err()
+	errorCloseFrozen      = errs.B().Code(errs.FailedPrecondition).Msg("frozen accounts can't be closed, unfreeze it first").Err()
+	errorSweepSameAccount = errs.B().Code(errs.InvalidArgument).Msg("cannot sweep the balance into the closed account").Err()
+)
+
+type CloseAccountCommand interface {
+	Execute(ctx context.Context, params CloseAccountParams) (core.Transaction, error)
+}
+
+type CloseAccountCommandImpl struct {
+	v  Validator
+	l  Locker
+	ur UserRepository
+	ar AccountRepository
+}
+
+// Execute closes one of the caller's accounts, its remaining balance is swept into `SweepToAccountID` by a transfer
+// Closed accounts & their transactions are kept, they can't send nor receive money anymore, their cards can't be used
+// The sweep transfer is returned, it's empty when the account had no balance
+func (c *CloseAccountCommandImpl) Execute(ctx context.Context, params CloseAccountParams) (core.Transaction, error) {
+	var sweep core.Transaction
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CloseAccountCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Get the account from database
+		account, err := c.ar.GetAccount(ctx, params.AccountID)
+		if err != nil {
+			return err
+		}
+		// Check if the caller is the account's owner
+		if innerID != account.OwnerID {
+			return errorNotAccountOwner
+		}
+		lockID := int64(-1)
+		if params.SweepToAccountID != 0 {
+			if params.SweepToAccountID == account.ID {
+				return errorSweepSameAccount
+			}
+			lockID = params.SweepToAccountID
+		}
+		// Lock both accounts & check their state now that no money can move in or out
+		unlock := c.l.Lock(ctx, account.ID, lockID)
+		defer unlock()
+		account, err = c.ar.GetAccount(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case account.Status == core.AccountStatusClosed:
+			return errorAccountClosed
+		case account.Status == core.AccountStatusFrozen:
+			return errorCloseFrozen
+		case account.AvailableBalance != account.Balance:
+			return errorAccountHasHolds
+		case account.Balance != 0 && params.SweepToAccountID == 0:
+			return errorAccountNotEmpty
+		}
+		// Check that the sweep account is another account of the caller in the same currency that can receive the balance
+		if params.SweepToAccountID != 0 {
+			toAccount, err := c.ar.GetAccount(ctx, params.SweepToAccountID)
+			if err != nil {
+				return err
+			}
+			if innerID != toAccount.OwnerID {
+				return errorNotAccountOwner
+			}
+			if toAccount.Currency != account.Currency {
+				return errs.B().Code(errs.InvalidArgument).
+					Msgf("accounts currency mismatch, account currency: %s, sweep account currency: %s", account.Currency, toAccount.Currency).
+					Err()
+			}
+			if err = checkCanReceive(toAccount); err != nil {
+				return err
+			}
+		}
+		sweep, err = c.ar.CloseAccount(ctx, account.ID, params.SweepToAccountID)
+		return err
+	})
+	return sweep, err
+}
+
+func NewCloseAccountCommand(v Validator, l Locker, ur UserRepository, ar AccountRepository) CloseAccountCommand {
+	return &CloseAccountCommandImpl{v: v, l: l, ur: ur, ar: ar}
+}
@@ -0,0 +1,92 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/escalopa/fingo/wallet/internal/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCloseAccount_Execute(t *testing.T) {
+	userID := uuid.New()
+	var innerID int64 = 1
+	account := core.Account{ID: 1, OwnerID: innerID, Currency: core.CurrencyUSD, Balance: 5000, AvailableBalance: 5000, Status: core.AccountStatusActive}
+	sweepAccount := core.Account{ID: 2, OwnerID: innerID, Currency: core.CurrencyUSD, Status: core.AccountStatusActive}
+
+	tests := []struct {
+		name  string
+		sweep core.Account // sweep account as read under the lock
+		stubs func(ar *mock.MockAccountRepository)
+		check func(t *testing.T, err error)
+	}{
+		{
+			name: "sweep into a frozen account",
+			sweep: func() core.Account {
+				a := sweepAccount
+				a.Status = core.AccountStatusFrozen
+				return a
+			}(),
+			stubs: func(ar *mock.MockAccountRepository) {
+				ar.EXPECT().CloseAccount(gomock.Any(), account.ID, sweepAccount.ID).Return(core.Transaction{Amount: account.Balance}, nil)
+			},
+			check: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "sweep into a closed account",
+			sweep: func() core.Account {
+				a := sweepAccount
+				a.Status = core.AccountStatusClosed
+				return a
+			}(),
+			stubs: func(ar *mock.MockAccountRepository) {},
+			check: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, errorReceivingAccountClosed)
+			},
+		},
+		{
+			name: "sweep into an account in another currency",
+			sweep: func() core.Account {
+				a := sweepAccount
+				a.Currency = core.CurrencyEUR
+				return a
+			}(),
+			stubs: func(ar *mock.MockAccountRepository) {},
+			check: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			v := mock.NewMockValidator(ctrl)
+			l := mock.NewMockLocker(ctrl)
+			ur := mock.NewMockUserRepository(ctrl)
+			ar := mock.NewMockAccountRepository(ctrl)
+
+			v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil)
+			ur.EXPECT().GetUser(gomock.Any(), userID).Return(innerID, nil)
+			// The sweep account is only read once both accounts are locked
+			lock := l.EXPECT().Lock(gomock.Any(), account.ID, sweepAccount.ID).Return(func() {})
+			ar.EXPECT().GetAccount(gomock.Any(), account.ID).Return(account, nil).Times(2)
+			ar.EXPECT().GetAccount(gomock.Any(), sweepAccount.ID).Return(tt.sweep, nil).After(lock)
+			tt.stubs(ar)
+
+			cmd := NewCloseAccountCommand(v, l, ur, ar)
+
+			ctx := contextutils.SetUserID(context.Background(), userID.String())
+			_, err := cmd.Execute(ctx, CloseAccountParams{AccountID: account.ID, SweepToAccountID: sweepAccount.ID})
+			tt.check(t, err)
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/escalopa/fingo/pkg/contextutils"
 	"github.com/escalopa/fingo/pkg/tracer"
 	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
 )
 
 type CreateAccountParams struct {
@@ -19,9 +20,10 @@ type CreateAccountCommand interface {
 }
 
 type CreateAccountCommandImpl struct {
-	v  Validator
-	ur UserRepository
-	ar AccountRepository
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	cur CurrencyRepository
 }
 
 func (c *CreateAccountCommandImpl) Execute(ctx context.Context, params CreateAccountParams) error {
@@ -42,11 +44,22 @@ func (c *CreateAccountCommandImpl) Execute(ctx context.Context, params CreateAcc
 		if err != nil {
 			return err
 		}
+		// Check the currency is registered & enabled
+		currency, err := c.cur.GetCurrency(ctx, core.ParseCurrency(params.Currency))
+		if err != nil {
+			if errErrs, ok := err.(*errs.Error); ok && errErrs.Code == errs.NotFound {
+				return errs.B(err).Code(errs.InvalidArgument).Msgf("unsupported currency %s", params.Currency).Err()
+			}
+			return err
+		}
+		if !currency.Enabled {
+			return errs.B().Code(errs.InvalidArgument).Msgf("currency %s is not enabled", currency.Code).Err()
+		}
 		// Create a new account
 		err = c.ar.CreateAccount(ctx, core.CreateAccountParams{
 			UserID:   innerID,
 			Name:     params.Name,
-			Currency: core.ParseCurrency(params.Currency),
+			Currency: currency.Code,
 		})
 		if err != nil {
 			return err
@@ -55,6 +68,6 @@ func (c *CreateAccountCommandImpl) Execute(ctx context.Context, params CreateAcc
 	})
 }
 
-func NewCreateAccountCommand(v Validator, ur UserRepository, ar AccountRepository) CreateAccountCommand {
-	return &CreateAccountCommandImpl{v: v, ur: ur, ar: ar}
+func NewCreateAccountCommand(v Validator, ur UserRepository, ar AccountRepository, cur CurrencyRepository) CreateAccountCommand {
+	return &CreateAccountCommandImpl{v: v, ur: ur, ar: ar, cur: cur}
 }
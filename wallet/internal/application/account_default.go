@@ -6,26 +6,27 @@ import (
 
 	"github.com/escalopa/fingo/pkg/contextutils"
 	"github.com/escalopa/fingo/pkg/tracer"
-	"github.com/lordvidex/errs"
+	"github.com/escalopa/fingo/wallet/internal/core"
 )
 
-type DeleteAccountParams struct {
+type SetDefaultAccountParams struct {
 	AccountID int64 `validate:"required,min=1"`
 }
 
-type DeleteAccountCommand interface {
-	Execute(ctx context.Context, params DeleteAccountParams) error
+type SetDefaultAccountCommand interface {
+	Execute(ctx context.Context, params SetDefaultAccountParams) error
 }
 
-type DeleteAccountCommandImpl struct {
+type SetDefaultAccountCommandImpl struct {
 	v  Validator
 	ur UserRepository
 	ar AccountRepository
 }
 
-func (c *DeleteAccountCommandImpl) Execute(ctx context.Context, params DeleteAccountParams) error {
+// Execute makes the account receive the payments addressed to the caller by username or email in its currency
+func (c *SetDefaultAccountCommandImpl) Execute(ctx context.Context, params SetDefaultAccountParams) error {
 	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
-		ctx, span := tracer.Tracer().Start(ctx, "DeleteAccountCommand.Execute")
+		ctx, span := tracer.Tracer().Start(ctx, "SetDefaultAccountCommand.Execute")
 		defer span.End()
 		// Validate params
 		if err := c.v.Validate(ctx, params); err != nil {
@@ -49,19 +50,13 @@ func (c *DeleteAccountCommandImpl) Execute(ctx context.Context, params DeleteAcc
 		if innerID != account.OwnerID {
 			return errorNotAccountOwner
 		}
-		// Check that the account balance is not empty
-		if account.Balance > 0 {
-			return errs.B().Code(errs.InvalidArgument).Msg("account's balance must be empty to be deleted").Err()
+		if account.Status == core.AccountStatusClosed {
+			return errorAccountClosed
 		}
-		// Delete account
-		err = c.ar.DeleteAccount(ctx, params.AccountID)
-		if err != nil {
-			return err
-		}
-		return nil
+		return c.ar.SetDefaultAccount(ctx, params.AccountID)
 	})
 }
 
-func NewDeleteAccountCommand(v Validator, ur UserRepository, ar AccountRepository) DeleteAccountCommand {
-	return &DeleteAccountCommandImpl{v: v, ur: ur, ar: ar}
+func NewSetDefaultAccountCommand(v Validator, ur UserRepository, ar AccountRepository) SetDefaultAccountCommand {
+	return &SetDefaultAccountCommandImpl{v: v, ur: ur, ar: ar}
 }
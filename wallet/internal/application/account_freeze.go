@@ -0,0 +1,89 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+)
+
+type FreezeAccountParams struct {
+	AccountID int64 `validate:"required,min=1"`
+}
+
+type FreezeAccountCommand interface {
+	Execute(ctx context.Context, params FreezeAccountParams) error
+}
+
+type FreezeAccountCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	ops map[uuid.UUID]struct{}
+}
+
+// Execute freezes an account, it keeps receiving money but no money can be moved out of it until it's unfrozen
+// Owners freeze their accounts, operations users freeze any account & only they can unfreeze it then
+func (c *FreezeAccountCommandImpl) Execute(ctx context.Context, params FreezeAccountParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "FreezeAccountCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Lock the account so that the transactions in progress complete before it's frozen
+		unlock := c.l.Lock(ctx, params.AccountID, -1)
+		defer unlock()
+		account, isOps, err := getStatusAccount(ctx, c.ur, c.ar, c.ops, userID, params.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Status == core.AccountStatusClosed {
+			return errorAccountClosed
+		}
+		if account.Status == core.AccountStatusFrozen && (account.FrozenByOps || !isOps) {
+			return nil
+		}
+		return c.ar.SetAccountStatus(ctx, account.ID, core.AccountStatusFrozen, isOps)
+	})
+}
+
+// getStatusAccount returns an account whose status is changed by the caller, who must own it or be an operations user
+func getStatusAccount(
+	ctx context.Context,
+	ur UserRepository,
+	ar AccountRepository,
+	ops map[uuid.UUID]struct{},
+	userID uuid.UUID,
+	accountID int64,
+) (account core.Account, isOps bool, err error) {
+	account, err = ar.GetAccount(ctx, accountID)
+	if err != nil {
+		return core.Account{}, false, err
+	}
+	if _, ok := ops[userID]; ok {
+		return account, true, nil
+	}
+	innerID, err := ur.GetUser(ctx, userID)
+	if err != nil {
+		return core.Account{}, false, err
+	}
+	if innerID != account.OwnerID {
+		return core.Account{}, false, errorNotAccountOwner
+	}
+	return account, false, nil
+}
+
+func NewFreezeAccountCommand(v Validator, l Locker, ur UserRepository, ar AccountRepository, ops map[uuid.UUID]struct{}) FreezeAccountCommand {
+	return &FreezeAccountCommandImpl{v: v, l: l, ur: ur, ar: ar, ops: ops}
+}
@@ -16,9 +16,10 @@ type GetAccountsCommand interface {
 }
 
 type GetAccountsCommandImpl struct {
-	v  Validator
-	ur UserRepository
-	ar AccountRepository
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	ptr PotRepository
 }
 
 func (c *GetAccountsCommandImpl) Execute(ctx context.Context, params GetAccountsParams) ([]core.Account, error) {
@@ -44,11 +45,26 @@ func (c *GetAccountsCommandImpl) Execute(ctx context.Context, params GetAccounts
 		if err != nil {
 			return err
 		}
+		// Attach the pots to their accounts
+		pots, err := c.ptr.GetUserPots(ctx, innerID)
+		if err != nil {
+			return err
+		}
+		byAccount := make(map[int64][]core.Pot, len(accounts))
+		for _, p := range pots {
+			byAccount[p.AccountID] = append(byAccount[p.AccountID], p)
+		}
+		for i := range accounts {
+			accounts[i].Pots = byAccount[accounts[i].ID]
+			for j := range accounts[i].Pots {
+				accounts[i].Pots[j].Currency = accounts[i].Currency
+			}
+		}
 		return nil
 	})
 	return accounts, err
 }
 
-func NewGetAccountsCommand(v Validator, ur UserRepository, ar AccountRepository) GetAccountsCommand {
-	return &GetAccountsCommandImpl{v: v, ur: ur, ar: ar}
+func NewGetAccountsCommand(v Validator, ur UserRepository, ar AccountRepository, ptr PotRepository) GetAccountsCommand {
+	return &GetAccountsCommandImpl{v: v, ur: ur, ar: ar, ptr: ptr}
 }
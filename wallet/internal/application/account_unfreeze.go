@@ -0,0 +1,66 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type UnfreezeAccountParams struct {
+	AccountID int64 `validate:"required,min=1"`
+}
+
+var errorFrozenByOps = errs.B().Code(errs.Forbidden).Msg("account was frozen by operations, only operations users can unfreeze it").Err()
+
+type UnfreezeAccountCommand interface {
+	Execute(ctx context.Context, params UnfreezeAccountParams) error
+}
+
+type UnfreezeAccountCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	ops map[uuid.UUID]struct{}
+}
+
+// Execute makes a frozen account send money again, accounts frozen by operations users are only unfrozen by them
+func (c *UnfreezeAccountCommandImpl) Execute(ctx context.Context, params UnfreezeAccountParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "UnfreezeAccountCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		unlock := c.l.Lock(ctx, params.AccountID, -1)
+		defer unlock()
+		account, isOps, err := getStatusAccount(ctx, c.ur, c.ar, c.ops, userID, params.AccountID)
+		if err != nil {
+			return err
+		}
+		switch {
+		case account.Status == core.AccountStatusClosed:
+			return errorAccountClosed
+		case account.Status == core.AccountStatusActive:
+			return nil
+		case account.FrozenByOps && !isOps:
+			return errorFrozenByOps
+		}
+		return c.ar.SetAccountStatus(ctx, account.ID, core.AccountStatusActive, false)
+	})
+}
+
+func NewUnfreezeAccountCommand(v Validator, l Locker, ur UserRepository, ar AccountRepository, ops map[uuid.UUID]struct{}) UnfreezeAccountCommand {
+	return &UnfreezeAccountCommandImpl{v: v, l: l, ur: ur, ar: ar, ops: ops}
+}
@@ -0,0 +1,144 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/sirupsen/logrus"
+)
+
+// budgetCheckPageSize is how many accounts' budgets are read from the database at once while checking the budgets
+const budgetCheckPageSize = 100
+
+type CheckBudgetsParams struct{}
+
+type CheckBudgetsCommand interface {
+	Execute(ctx context.Context, params CheckBudgetsParams) (int, error)
+}
+
+type CheckBudgetsCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	tr TransactionRepository
+	br BudgetRepository
+	ud UserDirectory
+	mp MessageProducer
+}
+
+// Execute alerts the accounts' owners about the budgets whose month-to-date spending crossed a threshold
+// and returns how many alerts were sent, each threshold is alerted once a month
+// Alerts failing to be sent are retried on the next call
+func (c *CheckBudgetsCommandImpl) Execute(ctx context.Context, params CheckBudgetsParams) (int, error) {
+	var sent int
+	err := contextutils.ExecuteWithContextTimeout(ctx, 1*time.Minute, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CheckBudgetsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		// Budgets are read by pages of accounts & ordered by account, keep checking the other accounts when one fails,
+		// the first error is returned
+		var firstErr error
+		var after int64 // last account of the previous page
+		for {
+			budgets, err := c.br.GetBudgets(ctx, after, budgetCheckPageSize)
+			if err != nil {
+				return err
+			}
+			if len(budgets) == 0 {
+				return firstErr
+			}
+			for i := 0; i < len(budgets); {
+				j := i + 1
+				for j < len(budgets) && budgets[j].AccountID == budgets[i].AccountID {
+					j++
+				}
+				alerted, err := c.check(ctx, budgets[i:j], now)
+				if err != nil && firstErr == nil {
+					firstErr = err
+				}
+				sent += alerted
+				i = j
+			}
+			after = budgets[len(budgets)-1].AccountID
+		}
+	})
+	return sent, err
+}
+
+// check alerts the owner of an account about its budgets crossing a threshold, the budgets are all of the account
+func (c *CheckBudgetsCommandImpl) check(ctx context.Context, budgets []core.Budget, now time.Time) (int, error) {
+	account, err := c.ar.GetAccount(ctx, budgets[0].AccountID)
+	if err != nil {
+		return 0, err
+	}
+	month := core.StartOfMonth(now)
+	spending, err := c.tr.GetSpendingByCategory(ctx, account.ID, month, now)
+	if err != nil {
+		return 0, err
+	}
+	var owner core.User
+	sent := 0
+	for _, b := range budgets {
+		spent := b.Spent(spending)
+		threshold := b.ThresholdToAlert(month, spent)
+		if threshold == 0 {
+			continue
+		}
+		// Look up the owner's contact details once, only when there's something to alert about
+		if owner.Email == "" {
+			ownerID, err := c.ur.GetUserExternalID(ctx, account.OwnerID)
+			if err != nil {
+				return sent, err
+			}
+			owner, err = c.ud.LookupUser(ctx, core.LookupUserParams{UserID: ownerID})
+			if err != nil {
+				return sent, err
+			}
+		}
+		err = c.mp.SendBudgetAlertMessage(ctx, core.BudgetAlertMessage{
+			Name:        owner.FirstName,
+			Email:       owner.Email,
+			AccountName: account.Name,
+			Category:    b.Category.String(),
+			Threshold:   threshold,
+			Spent:       core.FormatAmount(spent, account.MinorUnits, account.Currency),
+			Budget:      core.FormatAmount(b.Amount, account.MinorUnits, account.Currency),
+			Month:       month.Format("January 2006"),
+		})
+		if err != nil {
+			l, err2 := contextutils.GetLogger(ctx)
+			if err2 == nil {
+				l.WithFields(logrus.Fields{
+					"AccountID": account.ID,
+					"Category":  b.Category,
+					"Error":     err.Error(),
+				}).Error("failed to send message for budget alert")
+			}
+			continue
+		}
+		if err = c.br.SetBudgetAlerted(ctx, account.ID, b.Category, month, threshold); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+func NewCheckBudgetsCommand(
+	v Validator,
+	ur UserRepository,
+	ar AccountRepository,
+	tr TransactionRepository,
+	br BudgetRepository,
+	ud UserDirectory,
+	mp MessageProducer,
+) CheckBudgetsCommand {
+	return &CheckBudgetsCommandImpl{v: v, ur: ur, ar: ar, tr: tr, br: br, ud: ud, mp: mp}
+}
@@ -0,0 +1,132 @@
+package application
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v6"
+	"github.com/escalopa/fingo/pb"
+	"github.com/escalopa/fingo/pkg/interceptors"
+	"github.com/escalopa/fingo/wallet/internal/adapters/auth"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/escalopa/fingo/wallet/internal/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// fakeAuthInternalServer answers the lookups of the user directory like the internal service of auth
+type fakeAuthInternalServer struct {
+	pb.UnimplementedAuthInternalServiceServer
+	user core.User
+}
+
+func (s *fakeAuthInternalServer) LookupUser(ctx context.Context, req *pb.LookupUserRequest) (*pb.LookupUserResponse, error) {
+	// Jobs have no user, so no access token is forwarded
+	md, _ := metadata.FromIncomingContext(ctx)
+	if len(md.Get("authorization")) > 0 {
+		return nil, gofakeit.Error()
+	}
+	return &pb.LookupUserResponse{
+		UserId:    req.GetUserId(),
+		Username:  s.user.Username,
+		FirstName: s.user.FirstName,
+		LastName:  s.user.LastName,
+		Email:     s.user.Email,
+	}, nil
+}
+
+// startAuthInternalServer serves the fake internal service of auth guarded by the service key and returns its address
+func startAuthInternalServer(t *testing.T, key string, user core.User) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer(grpc.UnaryInterceptor(interceptors.ServiceKeyUnaryInterceptor("/pb.AuthInternalService/", key)))
+	pb.RegisterAuthInternalServiceServer(server, &fakeAuthInternalServer{user: user})
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+	return lis.Addr().String()
+}
+
+func TestCheckBudgets_Execute(t *testing.T) {
+	key := gofakeit.UUID()
+	owner := core.User{
+		ID:        uuid.New(),
+		Username:  gofakeit.Username(),
+		FirstName: gofakeit.FirstName(),
+		LastName:  gofakeit.LastName(),
+		Email:     gofakeit.Email(),
+	}
+	url := startAuthInternalServer(t, key, owner)
+	account := core.Account{ID: 1, OwnerID: 2, Name: gofakeit.Word(), Currency: core.CurrencyUSD, MinorUnits: 2}
+	budget := core.Budget{AccountID: account.ID, Amount: 10000}
+	spending := []core.CategorySpending{{Category: core.CategoryHousing, Amount: 9000, Count: 3}}
+
+	tests := []struct {
+		name  string
+		key   string
+		stubs func(br *mock.MockBudgetRepository, mp *mock.MockMessageProducer)
+		check func(t *testing.T, sent int, err error)
+	}{
+		{
+			name: "success without token",
+			key:  key,
+			stubs: func(br *mock.MockBudgetRepository, mp *mock.MockMessageProducer) {
+				mp.EXPECT().SendBudgetAlertMessage(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, params core.BudgetAlertMessage) error {
+						require.Equal(t, owner.FirstName, params.Name)
+						require.Equal(t, owner.Email, params.Email)
+						require.Equal(t, 80, params.Threshold)
+						return nil
+					})
+				br.EXPECT().SetBudgetAlerted(gomock.Any(), account.ID, budget.Category, gomock.Any(), 80).Return(nil)
+			},
+			check: func(t *testing.T, sent int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, sent)
+			},
+		},
+		{
+			name:  "wrong service key",
+			key:   gofakeit.UUID(),
+			stubs: func(br *mock.MockBudgetRepository, mp *mock.MockMessageProducer) {},
+			check: func(t *testing.T, sent int, err error) {
+				require.Error(t, err)
+				require.Zero(t, sent)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			v := mock.NewMockValidator(ctrl)
+			ur := mock.NewMockUserRepository(ctrl)
+			ar := mock.NewMockAccountRepository(ctrl)
+			tr := mock.NewMockTransactionRepository(ctrl)
+			br := mock.NewMockBudgetRepository(ctrl)
+			mp := mock.NewMockMessageProducer(ctrl)
+			ud, err := auth.NewUserDirectory(url, insecure.NewCredentials(), tt.key)
+			require.NoError(t, err)
+
+			v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil)
+			br.EXPECT().GetBudgets(gomock.Any(), int64(0), int32(budgetCheckPageSize)).Return([]core.Budget{budget}, nil)
+			br.EXPECT().GetBudgets(gomock.Any(), account.ID, int32(budgetCheckPageSize)).Return(nil, nil)
+			ar.EXPECT().GetAccount(gomock.Any(), account.ID).Return(account, nil)
+			tr.EXPECT().GetSpendingByCategory(gomock.Any(), account.ID, gomock.Any(), gomock.Any()).Return(spending, nil)
+			ur.EXPECT().GetUserExternalID(gomock.Any(), account.OwnerID).Return(owner.ID, nil)
+			tt.stubs(br, mp)
+
+			cmd := NewCheckBudgetsCommand(v, ur, ar, tr, br, ud, mp)
+
+			// Jobs run on the app's context, which carries no access token
+			sent, err := cmd.Execute(context.Background(), CheckBudgetsParams{})
+			tt.check(t, sent, err)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type DeleteBudgetParams struct {
+	AccountID int64         `validate:"required,min=1"`
+	Category  core.Category `validate:"omitempty,oneof=income cash refunds housing transport groceries dining bills health shopping entertainment savings transfers other"` // empty for the whole spending
+}
+
+type DeleteBudgetCommand interface {
+	Execute(ctx context.Context, params DeleteBudgetParams) error
+}
+
+type DeleteBudgetCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	br BudgetRepository
+}
+
+// Execute deletes the monthly budget of a category on the caller's account
+func (c *DeleteBudgetCommandImpl) Execute(ctx context.Context, params DeleteBudgetParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "DeleteBudgetCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		account, err := getHistoryAccount(ctx, c.ur, c.ar, params.AccountID)
+		if err != nil {
+			return err
+		}
+		return c.br.DeleteBudget(ctx, account.ID, params.Category)
+	})
+}
+
+func NewDeleteBudgetCommand(v Validator, ur UserRepository, ar AccountRepository, br BudgetRepository) DeleteBudgetCommand {
+	return &DeleteBudgetCommandImpl{v: v, ur: ur, ar: ar, br: br}
+}
@@ -0,0 +1,66 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type GetBudgetsParams struct {
+	AccountID int64 `validate:"required,min=1"`
+}
+
+type GetBudgetsCommand interface {
+	Execute(ctx context.Context, params GetBudgetsParams) ([]core.BudgetStatus, error)
+}
+
+type GetBudgetsCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	tr TransactionRepository
+	br BudgetRepository
+}
+
+// Execute returns the budgets of the caller's account with the month-to-date spending within each, months are in UTC
+func (c *GetBudgetsCommandImpl) Execute(ctx context.Context, params GetBudgetsParams) ([]core.BudgetStatus, error) {
+	var statuses []core.BudgetStatus
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetBudgetsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		account, err := getHistoryAccount(ctx, c.ur, c.ar, params.AccountID)
+		if err != nil {
+			return err
+		}
+		budgets, err := c.br.GetAccountBudgets(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		if len(budgets) == 0 {
+			return nil
+		}
+		now := time.Now().UTC()
+		month := core.StartOfMonth(now)
+		spending, err := c.tr.GetSpendingByCategory(ctx, account.ID, month, now)
+		if err != nil {
+			return err
+		}
+		statuses = make([]core.BudgetStatus, len(budgets))
+		for i, b := range budgets {
+			statuses[i] = core.NewBudgetStatus(b, account.Currency, month, spending)
+		}
+		return nil
+	})
+	return statuses, err
+}
+
+func NewGetBudgetsCommand(v Validator, ur UserRepository, ar AccountRepository, tr TransactionRepository, br BudgetRepository) GetBudgetsCommand {
+	return &GetBudgetsCommandImpl{v: v, ur: ur, ar: ar, tr: tr, br: br}
+}
@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type SetBudgetParams struct {
+	AccountID int64         `validate:"required,min=1"`
+	Category  core.Category `validate:"omitempty,oneof=income cash refunds housing transport groceries dining bills health shopping entertainment savings transfers other"` // empty for the whole spending
+	Amount    int64         `validate:"required,min=1"`                                                                                                                     // monthly, in minor units of the account's currency
+}
+
+type SetBudgetCommand interface {
+	Execute(ctx context.Context, params SetBudgetParams) (core.Budget, error)
+}
+
+type SetBudgetCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	br BudgetRepository
+}
+
+// Execute creates or replaces the monthly budget of a category on the caller's account
+// Changing a budget's amount lets its owner be alerted again about the thresholds within the month
+func (c *SetBudgetCommandImpl) Execute(ctx context.Context, params SetBudgetParams) (core.Budget, error) {
+	var budget core.Budget
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "SetBudgetCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		account, err := getHistoryAccount(ctx, c.ur, c.ar, params.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Status == core.AccountStatusClosed {
+			return errorAccountClosed
+		}
+		budget, err = c.br.SetBudget(ctx, core.SetBudgetParams{
+			AccountID: account.ID,
+			Category:  params.Category,
+			Amount:    params.Amount,
+		})
+		return err
+	})
+	return budget, err
+}
+
+func NewSetBudgetCommand(v Validator, ur UserRepository, ar AccountRepository, br BudgetRepository) SetBudgetCommand {
+	return &SetBudgetCommandImpl{v: v, ur: ur, ar: ar, br: br}
+}
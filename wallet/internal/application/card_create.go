@@ -7,26 +7,47 @@ import (
 	"github.com/escalopa/fingo/pkg/contextutils"
 	"github.com/escalopa/fingo/pkg/tracer"
 	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
 )
 
 type CreateCardParams struct {
-	AccountID int64 `validate:"required,min=1"`
+	AccountID   int64         `validate:"required,min=1"`
+	Nickname    string        `validate:"max=64"`
+	Kind        core.CardKind `validate:"omitempty,oneof=physical virtual single_use"` // physical when empty
+	SpendingCap int64         `validate:"min=0"`                                       // zero is uncapped
+	ExpiresAt   time.Time
+	// Only account the card can pay, another account of the caller in the same currency, zero pays any account
+	LockedToAccountID int64 `validate:"omitempty,min=1"`
 }
 
+var (
+	errorCardExpiryInPast       = errs.B().Code(errs.InvalidArgument).Msg("card expiry must be in the future").Err()
+	errorCardExpiryTooFar       = errs.B().Code(errs.InvalidArgument).Msg("card expiry can't be after the default card validity").Err()
+	errorCardLockedToOwnAccount = errs.B().Code(errs.InvalidArgument).Msg("card can't be locked to its own account").Err()
+)
+
+// cardNumberAttempts is how many numbers are generated for a new card before giving up on collisions
+const cardNumberAttempts = 5
+
 type CreateCardCommand interface {
-	Execute(ctx context.Context, params CreateCardParams) error
+	Execute(ctx context.Context, params CreateCardParams) (core.Card, error)
 }
 
 type CreateCardCommandImpl struct {
-	v  Validator
-	ur UserRepository
-	ar AccountRepository
-	cr CardRepository
-	ng CardNumberGenerator
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	ng  CardNumberGenerator
+	sh  SecretHasher
+	cv  CardVault
+	cvy int
 }
 
-func (c *CreateCardCommandImpl) Execute(ctx context.Context, params CreateCardParams) error {
-	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+// Execute issues a new card on the account, the card is returned with its CVV which is never shown again
+func (c *CreateCardCommandImpl) Execute(ctx context.Context, params CreateCardParams) (core.Card, error) {
+	var card core.Card
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
 		ctx, span := tracer.Tracer().Start(ctx, "CreateCardCommand.Execute")
 		defer span.End()
 		// Validate params
@@ -51,21 +72,116 @@ func (c *CreateCardCommandImpl) Execute(ctx context.Context, params CreateCardPa
 		if innerID != account.OwnerID {
 			return errorNotAccountOwner
 		}
-		// Create any random card number
-		number, err := c.ng.GenCardNumber(ctx)
-		if err != nil {
-			return err
+		if account.Status == core.AccountStatusClosed {
+			return errorAccountClosed
 		}
-		// Add new card to account
-		err = c.cr.CreateCard(ctx, core.CreateCardParams{
-			AccountID: params.AccountID,
-			Number:    number,
-		})
+		// Check the card's expiry, it can be shortened but not extended
+		now := time.Now()
+		if !params.ExpiresAt.IsZero() {
+			if !params.ExpiresAt.After(now) {
+				return errorCardExpiryInPast
+			}
+			if params.ExpiresAt.After(core.CardExpiry(now, c.cvy)) {
+				return errorCardExpiryTooFar
+			}
+		}
+		// Check that the account the card is locked to is another open account of the caller in the card's currency
+		if params.LockedToAccountID != 0 {
+			if params.LockedToAccountID == params.AccountID {
+				return errorCardLockedToOwnAccount
+			}
+			lockedTo, err := c.ar.GetAccount(ctx, params.LockedToAccountID)
+			if err != nil {
+				return err
+			}
+			if innerID != lockedTo.OwnerID {
+				return errorNotAccountOwner
+			}
+			if lockedTo.Currency != account.Currency {
+				return errs.B().Code(errs.InvalidArgument).
+					Msgf("accounts currency mismatch, card currency: %s, locked to account currency: %s", account.Currency, lockedTo.Currency).
+					Err()
+			}
+			if lockedTo.Status == core.AccountStatusClosed {
+				return errorAccountClosed
+			}
+		}
+		kind := params.Kind
+		if kind == "" {
+			kind = core.CardKindPhysical
+		}
+		// Issue a new card on the account
+		card, err = issueCard(ctx, c.ng, c.sh, c.cv, c.cvy, core.CreateCardParams{
+			AccountID:         params.AccountID,
+			Nickname:          params.Nickname,
+			ExpiresAt:         params.ExpiresAt,
+			Kind:              kind,
+			SpendingCap:       params.SpendingCap,
+			LockedToAccountID: params.LockedToAccountID,
+		}, c.cr.CreateCard)
 		if err != nil {
 			return err
 		}
 		return nil
 	})
+	return card, err
+}
+
+// issueCard generates the number & CVV of a new card from `params` & stores it, the CVV is returned as it's only stored hashed
+// The card expires after `validityYears` unless `params` has an expiry, it's stored by its token with its number encrypted
+// Generated numbers colliding with an existing card are generated again, up to `cardNumberAttempts` times
+func issueCard(
+	ctx context.Context,
+	ng CardNumberGenerator,
+	sh SecretHasher,
+	cv CardVault,
+	validityYears int,
+	params core.CreateCardParams,
+	store func(ctx context.Context, params core.CreateCardParams) error,
+) (core.Card, error) {
+	cvv, err := ng.GenCVV(ctx)
+	if err != nil {
+		return core.Card{}, err
+	}
+	cvvHash, err := sh.Hash(ctx, cvv)
+	if err != nil {
+		return core.Card{}, err
+	}
+	params.CVVHash = cvvHash
+	if params.ExpiresAt.IsZero() {
+		params.ExpiresAt = core.CardExpiry(time.Now(), validityYears)
+	}
+	for attempt := 1; ; attempt++ {
+		number, err := ng.GenCardNumber(ctx)
+		if err != nil {
+			return core.Card{}, err
+		}
+		params.Number = cv.Token(ctx, number)
+		params.EncryptedNumber, err = cv.Encrypt(ctx, number)
+		if err != nil {
+			return core.Card{}, err
+		}
+		err = store(ctx, params)
+		if err == nil {
+			break
+		}
+		if errErrs, ok := err.(*errs.Error); !ok || errErrs.Code != errs.AlreadyExists || attempt == cardNumberAttempts {
+			return core.Card{}, err
+		}
+	}
+	return core.Card{
+		AccountID:         params.AccountID,
+		Number:            params.Number,
+		EncryptedNumber:   params.EncryptedNumber,
+		Nickname:          params.Nickname,
+		Status:            core.CardStatusActive,
+		CVV:               cvv,
+		ExpiresAt:         params.ExpiresAt,
+		CreatedAt:         time.Now(),
+		Kind:              params.Kind,
+		SpendingCap:       params.SpendingCap,
+		LockedToAccountID: params.LockedToAccountID,
+	}, nil
 }
 
 func NewCreateCardCommand(
@@ -74,6 +190,9 @@ func NewCreateCardCommand(
 	ar AccountRepository,
 	cr CardRepository,
 	ng CardNumberGenerator,
+	sh SecretHasher,
+	cv CardVault,
+	cvy int,
 ) CreateCardCommand {
-	return &CreateCardCommandImpl{v: v, ur: ur, ar: ar, cr: cr, ng: ng}
+	return &CreateCardCommandImpl{v: v, ur: ur, ar: ar, cr: cr, ng: ng, sh: sh, cv: cv, cvy: cvy}
 }
@@ -0,0 +1,87 @@
+package application
+
+import (
+	"context"
+	"testing"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/escalopa/fingo/wallet/internal/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCard_ExecuteLockedToAccount(t *testing.T) {
+	userID := uuid.New()
+	var innerID int64 = 1
+	account := core.Account{ID: 1, OwnerID: innerID, Currency: core.CurrencyUSD, Status: core.AccountStatusActive}
+	lockedTo := core.Account{ID: 2, OwnerID: innerID, Currency: core.CurrencyUSD, Status: core.AccountStatusActive}
+
+	tests := []struct {
+		name     string
+		lockedTo func(ar *mock.MockAccountRepository)
+		err      error
+	}{
+		{
+			name: "missing account",
+			lockedTo: func(ar *mock.MockAccountRepository) {
+				ar.EXPECT().GetAccount(gomock.Any(), lockedTo.ID).Return(core.Account{}, errs.B().Code(errs.NotFound).Msg("account not found").Err())
+			},
+		},
+		{
+			name: "another user's account",
+			lockedTo: func(ar *mock.MockAccountRepository) {
+				a := lockedTo
+				a.OwnerID = innerID + 1
+				ar.EXPECT().GetAccount(gomock.Any(), lockedTo.ID).Return(a, nil)
+			},
+			err: errorNotAccountOwner,
+		},
+		{
+			name: "account in another currency",
+			lockedTo: func(ar *mock.MockAccountRepository) {
+				a := lockedTo
+				a.Currency = core.CurrencyEUR
+				ar.EXPECT().GetAccount(gomock.Any(), lockedTo.ID).Return(a, nil)
+			},
+		},
+		{
+			name: "closed account",
+			lockedTo: func(ar *mock.MockAccountRepository) {
+				a := lockedTo
+				a.Status = core.AccountStatusClosed
+				ar.EXPECT().GetAccount(gomock.Any(), lockedTo.ID).Return(a, nil)
+			},
+			err: errorAccountClosed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			v := mock.NewMockValidator(ctrl)
+			ur := mock.NewMockUserRepository(ctrl)
+			ar := mock.NewMockAccountRepository(ctrl)
+
+			v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil)
+			ur.EXPECT().GetUser(gomock.Any(), userID).Return(innerID, nil)
+			ar.EXPECT().GetAccount(gomock.Any(), account.ID).Return(account, nil)
+			tt.lockedTo(ar)
+
+			// No card is issued, so the card dependencies are never called
+			cmd := NewCreateCardCommand(v, ur, ar, mock.NewMockCardRepository(ctrl), mock.NewMockCardNumberGenerator(ctrl),
+				mock.NewMockSecretHasher(ctrl), mock.NewMockCardVault(ctrl), 3)
+
+			ctx := contextutils.SetUserID(context.Background(), userID.String())
+			_, err := cmd.Execute(ctx, CreateCardParams{AccountID: account.ID, LockedToAccountID: lockedTo.ID})
+			require.Error(t, err)
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			}
+		})
+	}
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type DeleteCardParams struct {
-	CardNumber string `validate:"required,len=16"`
+	CardNumber string `validate:"required,len=64"` // card token
 }
 
 type DeleteCardCommand interface {
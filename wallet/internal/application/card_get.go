@@ -55,6 +55,11 @@ func (c *GetCardsCommandImpl) Execute(ctx context.Context, params GetCardsParams
 		if err != nil {
 			return err
 		}
+		// Report the active cards past their expiry date as expired
+		now := time.Now()
+		for i := range cards {
+			cards[i].Status = cards[i].StatusAt(now)
+		}
 		return nil
 	})
 	return cards, err
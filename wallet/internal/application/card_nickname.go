@@ -0,0 +1,53 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+)
+
+type SetCardNicknameParams struct {
+	CardNumber string `validate:"required,len=64"` // card token
+	Nickname   string `validate:"max=64"`          // empty removes the nickname
+}
+
+type SetCardNicknameCommand interface {
+	Execute(ctx context.Context, params SetCardNicknameParams) error
+}
+
+type SetCardNicknameCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	cr CardRepository
+}
+
+func (c *SetCardNicknameCommandImpl) Execute(ctx context.Context, params SetCardNicknameParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "SetCardNicknameCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		card, err := getOwnedCard(ctx, c.cr, innerID, params.CardNumber)
+		if err != nil {
+			return err
+		}
+		return c.cr.SetCardNickname(ctx, card.Number, params.Nickname)
+	})
+}
+
+func NewSetCardNicknameCommand(v Validator, ur UserRepository, cr CardRepository) SetCardNicknameCommand {
+	return &SetCardNicknameCommandImpl{v: v, ur: ur, cr: cr}
+}
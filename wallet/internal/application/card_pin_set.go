@@ -0,0 +1,85 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/lordvidex/errs"
+)
+
+type SetCardPinParams struct {
+	CardNumber string `validate:"required,len=64"` // card token
+	PIN        string `validate:"required,len=4,number"`
+	CurrentPIN string `validate:"omitempty,len=4,number"` // required to change the PIN of a card that has one
+}
+
+var errorCurrentPINRequired = errs.B().Code(errs.InvalidArgument).Msg("current PIN is required to change the card PIN").Err()
+
+type SetCardPinCommand interface {
+	Execute(ctx context.Context, params SetCardPinParams) error
+}
+
+type SetCardPinCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	cr  CardRepository
+	sh  SecretHasher
+	pma int           // card PIN max failed attempts in a row
+	pl  time.Duration // card PIN lockout
+}
+
+// Execute sets or changes the PIN of one of the caller's cards, the PIN is only stored hashed
+// Changing the PIN checks the current one like any other attempt, a new PIN clears the failed attempts
+func (c *SetCardPinCommandImpl) Execute(ctx context.Context, params SetCardPinParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "SetCardPinCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		card, err := getOwnedCard(ctx, c.cr, innerID, params.CardNumber)
+		if err != nil {
+			return err
+		}
+		// Check the current PIN before changing it
+		if card.HasPIN() {
+			if params.CurrentPIN == "" {
+				return errorCurrentPINRequired
+			}
+			err = verifyCardPIN(ctx, c.l, c.cr, c.sh, card.Number, params.CurrentPIN, c.pma, c.pl)
+			if err != nil {
+				return err
+			}
+		}
+		pinHash, err := c.sh.Hash(ctx, params.PIN)
+		if err != nil {
+			return err
+		}
+		return c.cr.SetCardPin(ctx, card.Number, pinHash)
+	})
+}
+
+func NewSetCardPinCommand(
+	v Validator,
+	l Locker,
+	ur UserRepository,
+	cr CardRepository,
+	sh SecretHasher,
+	pma int,
+	pl time.Duration,
+) SetCardPinCommand {
+	return &SetCardPinCommandImpl{v: v, l: l, ur: ur, cr: cr, sh: sh, pma: pma, pl: pl}
+}
@@ -0,0 +1,97 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type VerifyCardPinParams struct {
+	CardNumber string `validate:"required,len=64"` // card token
+	PIN        string `validate:"required,len=4,number"`
+}
+
+var errorInvalidPIN = errs.B().Code(errs.InvalidArgument).Msg("invalid card PIN").Err()
+
+type VerifyCardPinCommand interface {
+	Execute(ctx context.Context, params VerifyCardPinParams) error
+}
+
+type VerifyCardPinCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	cr  CardRepository
+	sh  SecretHasher
+	pma int           // card PIN max failed attempts in a row
+	pl  time.Duration // card PIN lockout
+}
+
+// Execute checks the PIN of one of the caller's cards, failed attempts count towards the PIN lockout
+func (c *VerifyCardPinCommandImpl) Execute(ctx context.Context, params VerifyCardPinParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "VerifyCardPinCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		card, err := getOwnedCard(ctx, c.cr, innerID, params.CardNumber)
+		if err != nil {
+			return err
+		}
+		return verifyCardPIN(ctx, c.l, c.cr, c.sh, card.Number, params.PIN, c.pma, c.pl)
+	})
+}
+
+// verifyCardPIN checks the card's PIN & records the attempt, `maxAttempts` failed attempts in a row lock the PIN for `lockout`
+func verifyCardPIN(ctx context.Context, l Locker, cr CardRepository, sh SecretHasher, cardNumber, pin string, maxAttempts int, lockout time.Duration) error {
+	// Lock the card so that concurrent attempts are all counted
+	unlock := l.Lock(ctx, cardNumber)
+	defer unlock()
+	card, err := cr.GetCard(ctx, cardNumber)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	if err = card.CheckPINAttempt(now); err != nil {
+		return errs.B(err).Code(errs.FailedPrecondition).Msg(err.Error()).Err()
+	}
+	if !sh.Compare(ctx, card.PINHash, pin) {
+		err = cr.SetCardPinAttempts(ctx, card.Number, card.PINFailed(now, maxAttempts, lockout))
+		if err != nil {
+			return err
+		}
+		return errorInvalidPIN
+	}
+	// A successful attempt starts the count over
+	if card.PINFailedAttempts > 0 {
+		return cr.SetCardPinAttempts(ctx, card.Number, core.CardPINAttempts{LockedUntil: card.PINLockedUntil})
+	}
+	return nil
+}
+
+func NewVerifyCardPinCommand(
+	v Validator,
+	l Locker,
+	ur UserRepository,
+	cr CardRepository,
+	sh SecretHasher,
+	pma int,
+	pl time.Duration,
+) VerifyCardPinCommand {
+	return &VerifyCardPinCommandImpl{v: v, l: l, ur: ur, cr: cr, sh: sh, pma: pma, pl: pl}
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+)
+
+type RekeyCardsParams struct{}
+
+type RekeyCardsCommand interface {
+	Execute(ctx context.Context, params RekeyCardsParams) (int, error)
+}
+
+type RekeyCardsCommandImpl struct {
+	v   Validator
+	cr  CardRepository
+	cv  CardVault
+	pcv CardVault
+}
+
+// Execute re-encrypts the cards numbers encrypted with the previous vault's key & replaces their tokens by the current vault's ones
+// It returns how many cards were rekeyed, it's run on startup before serving requests & does nothing without a previous key
+// Cards already encrypted with the current key are skipped, so an interrupted rotation is resumed on the next start
+func (c *RekeyCardsCommandImpl) Execute(ctx context.Context, params RekeyCardsParams) (int, error) {
+	var rekeyed int
+	if c.pcv == nil {
+		return rekeyed, nil
+	}
+	err := contextutils.ExecuteWithContextTimeout(ctx, 1*time.Minute, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "RekeyCardsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		cards, err := c.cr.GetVaultedCards(ctx)
+		if err != nil {
+			return err
+		}
+		for _, card := range cards {
+			if _, err = c.cv.Decrypt(ctx, card.Number, card.EncryptedNumber); err == nil {
+				continue
+			}
+			number, err := c.pcv.Decrypt(ctx, card.Number, card.EncryptedNumber)
+			if err != nil {
+				return err
+			}
+			encrypted, err := c.cv.Encrypt(ctx, number)
+			if err != nil {
+				return err
+			}
+			err = c.cr.VaultCard(ctx, card.Number, c.cv.Token(ctx, number), encrypted)
+			if err != nil {
+				return err
+			}
+			rekeyed++
+		}
+		return nil
+	})
+	return rekeyed, err
+}
+
+func NewRekeyCardsCommand(v Validator, cr CardRepository, cv CardVault, pcv CardVault) RekeyCardsCommand {
+	return &RekeyCardsCommandImpl{v: v, cr: cr, cv: cv, pcv: pcv}
+}
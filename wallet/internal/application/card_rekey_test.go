@@ -0,0 +1,98 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/escalopa/fingo/wallet/internal/adapters/vault"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/escalopa/fingo/wallet/internal/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRekeyCards_Execute(t *testing.T) {
+	ctx := context.Background()
+	previous, err := vault.NewVault(bytes.Repeat([]byte{1}, 32))
+	require.NoError(t, err)
+	current, err := vault.NewVault(bytes.Repeat([]byte{2}, 32))
+	require.NoError(t, err)
+
+	// One card is still encrypted with the previous key, the other one was already rekeyed
+	oldNumber, newNumber := "4000001234567899", "4000001234567881"
+	oldEncrypted, err := previous.Encrypt(ctx, oldNumber)
+	require.NoError(t, err)
+	newEncrypted, err := current.Encrypt(ctx, newNumber)
+	require.NoError(t, err)
+	cards := []core.Card{
+		{Number: previous.Token(ctx, oldNumber), EncryptedNumber: oldEncrypted},
+		{Number: current.Token(ctx, newNumber), EncryptedNumber: newEncrypted},
+	}
+
+	tests := []struct {
+		name  string
+		pcv   CardVault
+		stubs func(cr *mock.MockCardRepository)
+		check func(t *testing.T, rekeyed int, err error)
+	}{
+		{
+			name: "success",
+			pcv:  previous,
+			stubs: func(cr *mock.MockCardRepository) {
+				cr.EXPECT().GetVaultedCards(gomock.Any()).Return(cards, nil)
+				cr.EXPECT().VaultCard(gomock.Any(), cards[0].Number, current.Token(ctx, oldNumber), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, cardNumber string, token string, encrypted core.EncryptedCardNumber) error {
+						number, err := current.Decrypt(ctx, token, encrypted)
+						require.NoError(t, err)
+						require.Equal(t, oldNumber, number)
+						return nil
+					})
+			},
+			check: func(t *testing.T, rekeyed int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, rekeyed)
+			},
+		},
+		{
+			name:  "no previous key",
+			stubs: func(cr *mock.MockCardRepository) {},
+			check: func(t *testing.T, rekeyed int, err error) {
+				require.NoError(t, err)
+				require.Zero(t, rekeyed)
+			},
+		},
+		{
+			name: "card encrypted with an unknown key",
+			pcv:  current,
+			stubs: func(cr *mock.MockCardRepository) {
+				other, err := vault.NewVault(bytes.Repeat([]byte{3}, 32))
+				require.NoError(t, err)
+				encrypted, err := other.Encrypt(ctx, oldNumber)
+				require.NoError(t, err)
+				cr.EXPECT().GetVaultedCards(gomock.Any()).Return([]core.Card{{Number: other.Token(ctx, oldNumber), EncryptedNumber: encrypted}}, nil)
+			},
+			check: func(t *testing.T, rekeyed int, err error) {
+				require.Error(t, err)
+				require.Zero(t, rekeyed)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			v := mock.NewMockValidator(ctrl)
+			cr := mock.NewMockCardRepository(ctrl)
+			v.EXPECT().Validate(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			tt.stubs(cr)
+
+			cmd := NewRekeyCardsCommand(v, cr, current, tt.pcv)
+
+			rekeyed, err := cmd.Execute(ctx, RekeyCardsParams{})
+			tt.check(t, rekeyed, err)
+		})
+	}
+}
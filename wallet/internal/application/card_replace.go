@@ -0,0 +1,114 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type ReplaceCardParams struct {
+	CardNumber string `validate:"required,len=64"` // card token
+}
+
+var errorCardReplaced = errs.B().Code(errs.FailedPrecondition).Msg("card was already replaced").Err()
+
+type ReplaceCardCommand interface {
+	Execute(ctx context.Context, params ReplaceCardParams) (core.Card, error)
+}
+
+type ReplaceCardCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	ng  CardNumberGenerator
+	sh  SecretHasher
+	cv  CardVault
+	cvy int
+}
+
+// Execute issues a new card in place of a lost, blocked or expiring card & retires the replaced card
+// The new card keeps the replaced card's nickname, its limits & its standing orders
+func (c *ReplaceCardCommandImpl) Execute(ctx context.Context, params ReplaceCardParams) (core.Card, error) {
+	var card core.Card
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "ReplaceCardCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Get the card & its account, the caller must own them
+		oldCard, err := getOwnedCard(ctx, c.cr, innerID, params.CardNumber)
+		if err != nil {
+			return err
+		}
+		if oldCard.ReplacedBy != "" {
+			return errorCardReplaced
+		}
+		account, err := c.ar.GetAccount(ctx, oldCard.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Status == core.AccountStatusClosed {
+			return errorAccountClosed
+		}
+		// Issue the new card & retire the old one
+		// The new card keeps the old one's kind, spending cap & locked account
+		card, err = issueCard(ctx, c.ng, c.sh, c.cv, c.cvy,
+			core.CreateCardParams{
+				AccountID:         oldCard.AccountID,
+				Nickname:          oldCard.Nickname,
+				Kind:              oldCard.Kind,
+				SpendingCap:       oldCard.SpendingCap,
+				LockedToAccountID: oldCard.LockedToAccountID,
+			},
+			func(ctx context.Context, params core.CreateCardParams) error {
+				return c.cr.ReplaceCard(ctx, oldCard.Number, params)
+			},
+		)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return card, err
+}
+
+// getOwnedCard returns a card owned by the user, by its inner id
+func getOwnedCard(ctx context.Context, cr CardRepository, userID int64, cardNumber string) (core.Card, error) {
+	account, err := cr.GetCardAccount(ctx, cardNumber)
+	if err != nil {
+		return core.Card{}, err
+	}
+	if userID != account.OwnerID {
+		return core.Card{}, errorNotAccountOwner
+	}
+	return cr.GetCard(ctx, cardNumber)
+}
+
+func NewReplaceCardCommand(
+	v Validator,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	ng CardNumberGenerator,
+	sh SecretHasher,
+	cv CardVault,
+	cvy int,
+) ReplaceCardCommand {
+	return &ReplaceCardCommandImpl{v: v, ur: ur, ar: ar, cr: cr, ng: ng, sh: sh, cv: cv, cvy: cvy}
+}
@@ -0,0 +1,95 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/lordvidex/errs"
+)
+
+type RevealCardNumberParams struct {
+	CardNumber string `validate:"required,len=64"` // card token
+}
+
+var errorCardNotVaulted = errs.B().Code(errs.FailedPrecondition).Msg("card number is not encrypted yet, retry later").Err()
+
+type RevealCardNumberCommand interface {
+	Execute(ctx context.Context, params RevealCardNumberParams) (string, error)
+}
+
+type RevealCardNumberCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	cr CardRepository
+	cv CardVault
+}
+
+// Execute returns the full number of a card owned by the caller, every reveal is recorded before the number is decrypted
+func (c *RevealCardNumberCommandImpl) Execute(ctx context.Context, params RevealCardNumberParams) (string, error) {
+	var number string
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "RevealCardNumberCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		card, err := getOwnedCard(ctx, c.cr, innerID, params.CardNumber)
+		if err != nil {
+			return err
+		}
+		if card.EncryptedNumber.Ciphertext == nil {
+			return errorCardNotVaulted
+		}
+		// Record the reveal, no number is returned unless it's recorded
+		err = c.cr.CreateCardReveal(ctx, card.Number, innerID)
+		if err != nil {
+			return err
+		}
+		number, err = c.cv.Decrypt(ctx, card.Number, card.EncryptedNumber)
+		if err != nil {
+			return errs.B(err).Code(errs.Internal).Msg("failed to decrypt card number").Err()
+		}
+		return nil
+	})
+	return number, err
+}
+
+// cardToken returns the token referencing the card with the given number, empty numbers stay empty
+func cardToken(ctx context.Context, cv CardVault, number string) string {
+	if number == "" {
+		return ""
+	}
+	return cv.Token(ctx, number)
+}
+
+// getCardNumber returns the full number of the card referenced by the token
+func getCardNumber(ctx context.Context, cr CardRepository, cv CardVault, token string) (string, error) {
+	card, err := cr.GetCard(ctx, token)
+	if err != nil {
+		return "", err
+	}
+	if card.EncryptedNumber.Ciphertext == nil {
+		return "", errorCardNotVaulted
+	}
+	number, err := cv.Decrypt(ctx, token, card.EncryptedNumber)
+	if err != nil {
+		return "", errs.B(err).Code(errs.Internal).Msg("failed to decrypt card number").Err()
+	}
+	return number, nil
+}
+
+func NewRevealCardNumberCommand(v Validator, ur UserRepository, cr CardRepository, cv CardVault) RevealCardNumberCommand {
+	return &RevealCardNumberCommandImpl{v: v, ur: ur, cr: cr, cv: cv}
+}
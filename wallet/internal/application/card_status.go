@@ -0,0 +1,59 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type SetCardStatusParams struct {
+	CardNumber string          `validate:"required,len=64"` // card token
+	Status     core.CardStatus `validate:"required,oneof=active blocked lost"`
+}
+
+type SetCardStatusCommand interface {
+	Execute(ctx context.Context, params SetCardStatusParams) error
+}
+
+type SetCardStatusCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	cr CardRepository
+}
+
+// Execute blocks, unblocks or reports lost one of the caller's cards, lost cards can't be used again
+func (c *SetCardStatusCommandImpl) Execute(ctx context.Context, params SetCardStatusParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "SetCardStatusCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		card, err := getOwnedCard(ctx, c.cr, innerID, params.CardNumber)
+		if err != nil {
+			return err
+		}
+		if err = card.CheckStatusChange(params.Status); err != nil {
+			return errs.B(err).Code(errs.FailedPrecondition).Msg(err.Error()).Err()
+		}
+		return c.cr.SetCardStatus(ctx, card.Number, params.Status)
+	})
+}
+
+func NewSetCardStatusCommand(v Validator, ur UserRepository, cr CardRepository) SetCardStatusCommand {
+	return &SetCardStatusCommandImpl{v: v, ur: ur, cr: cr}
+}
@@ -0,0 +1,56 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+)
+
+type VaultCardsParams struct{}
+
+type VaultCardsCommand interface {
+	Execute(ctx context.Context, params VaultCardsParams) (int, error)
+}
+
+type VaultCardsCommandImpl struct {
+	v  Validator
+	cr CardRepository
+	cv CardVault
+}
+
+// Execute encrypts the numbers of the cards created before the vault & replaces them by their tokens
+// It returns how many cards were vaulted, it's run on startup before serving requests
+func (c *VaultCardsCommandImpl) Execute(ctx context.Context, params VaultCardsParams) (int, error) {
+	var vaulted int
+	err := contextutils.ExecuteWithContextTimeout(ctx, 1*time.Minute, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "VaultCardsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		numbers, err := c.cr.GetUnvaultedCards(ctx)
+		if err != nil {
+			return err
+		}
+		for _, number := range numbers {
+			encrypted, err := c.cv.Encrypt(ctx, number)
+			if err != nil {
+				return err
+			}
+			err = c.cr.VaultCard(ctx, number, c.cv.Token(ctx, number), encrypted)
+			if err != nil {
+				return err
+			}
+			vaulted++
+		}
+		return nil
+	})
+	return vaulted, err
+}
+
+func NewVaultCardsCommand(v Validator, cr CardRepository, cv CardVault) VaultCardsCommand {
+	return &VaultCardsCommandImpl{v: v, cr: cr, cv: cv}
+}
@@ -0,0 +1,54 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type ListCurrenciesParams struct{}
+
+type ListCurrenciesCommand interface {
+	Execute(ctx context.Context, params ListCurrenciesParams) ([]core.CurrencyInfo, error)
+}
+
+type ListCurrenciesCommandImpl struct {
+	v   Validator
+	cur CurrencyRepository
+}
+
+// Execute returns the currencies accounts can be opened in
+func (c *ListCurrenciesCommandImpl) Execute(ctx context.Context, params ListCurrenciesParams) ([]core.CurrencyInfo, error) {
+	var res []core.CurrencyInfo
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "ListCurrenciesCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		currencies, err := c.cur.GetCurrencies(ctx)
+		if err != nil {
+			return err
+		}
+		// Keep enabled currencies only
+		res = make([]core.CurrencyInfo, 0, len(currencies))
+		for _, currency := range currencies {
+			if currency.Enabled {
+				res = append(res, currency)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func NewListCurrenciesCommand(v Validator, cur CurrencyRepository) ListCurrenciesCommand {
+	return &ListCurrenciesCommandImpl{v: v, cur: cur}
+}
@@ -0,0 +1,135 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type AuthorizeHoldParams struct {
+	Amount   int64         `validate:"required,min=1"` // in minor units of `Currency`
+	Currency core.Currency `validate:"required"`
+	FromCard string        `validate:"required,number,credit_card"`
+	ToCard   string        `validate:"required,number,credit_card"`
+}
+
+type AuthorizeHoldCommand interface {
+	Execute(ctx context.Context, params AuthorizeHoldParams) (core.Hold, error)
+}
+
+type AuthorizeHoldCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	hr  HoldRepository
+	lmr LimitRepository
+	cv  CardVault
+	ht  time.Duration // holds time to live
+}
+
+// Execute reserves the amount on the card's account for a later capture by the recipient card's owner
+func (c *AuthorizeHoldCommandImpl) Execute(ctx context.Context, params AuthorizeHoldParams) (core.Hold, error) {
+	var hold core.Hold
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "AuthorizeHoldCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Reference the cards by their tokens
+		params.FromCard, params.ToCard = cardToken(ctx, c.cv, params.FromCard), cardToken(ctx, c.cv, params.ToCard)
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Get the cards' accounts
+		fromAccount, err := c.cr.GetCardAccount(ctx, params.FromCard)
+		if err != nil {
+			return err
+		}
+		// Check that the caller is the account from card owner
+		if innerID != fromAccount.OwnerID {
+			return errorNotAccountOwner
+		}
+		// Check that the card can be used
+		card, err := getUsableCard(ctx, c.cr, params.FromCard)
+		if err != nil {
+			return err
+		}
+		// Check that the amount is expressed in the card's account currency
+		if params.Currency != fromAccount.Currency {
+			return errs.B().Code(errs.InvalidArgument).
+				Msgf("amount currency mismatch, amount currency: %s, account currency: %s", params.Currency, fromAccount.Currency).
+				Err()
+		}
+		toAccount, err := c.cr.GetCardAccount(ctx, params.ToCard)
+		if err != nil {
+			return err
+		}
+		if toAccount.ID == fromAccount.ID {
+			return errs.B().Code(errs.InvalidArgument).Msg("cannot hold funds for the same account").Err()
+		}
+		if toAccount.Currency != fromAccount.Currency {
+			return errs.B().Code(errs.InvalidArgument).
+				Msgf("accounts currency mismatch, from currency: %s, to currency: %s", fromAccount.Currency, toAccount.Currency).
+				Err()
+		}
+		// Lock both accounts while checking their status & the available balance
+		unlock := c.l.Lock(ctx, fromAccount.ID, toAccount.ID)
+		defer unlock()
+		fromAccount, err = c.ar.GetAccount(ctx, fromAccount.ID)
+		if err != nil {
+			return err
+		}
+		toAccount, err = c.ar.GetAccount(ctx, toAccount.ID)
+		if err != nil {
+			return err
+		}
+		if err = checkCanSend(fromAccount); err != nil {
+			return err
+		}
+		if err = checkCanReceive(toAccount); err != nil {
+			return err
+		}
+		if fromAccount.AvailableBalance < params.Amount {
+			return errorNoSufficientFunds
+		}
+		// Holds are captured as transfers, their amounts count towards the transfer limits from their authorization
+		if err = checkSpendingLimits(ctx, c.lmr, core.TransactionTypeTransfer, fromAccount.ID, params.FromCard, params.Amount); err != nil {
+			return err
+		}
+		if err = checkCardDebit(ctx, c.cr, card, toAccount.ID, params.Amount); err != nil {
+			return err
+		}
+		hold, err = c.hr.CreateHold(ctx, core.CreateHoldParams{
+			AccountID:          fromAccount.ID,
+			RecipientAccountID: toAccount.ID,
+			Amount:             params.Amount,
+			ExpiresAt:          time.Now().UTC().Add(c.ht),
+			Card:               params.FromCard,
+		})
+		if err != nil {
+			return err
+		}
+		hold.Currency = fromAccount.Currency
+		return nil
+	})
+	return hold, err
+}
+
+func NewAuthorizeHoldCommand(v Validator, l Locker, ur UserRepository, ar AccountRepository, cr CardRepository, hr HoldRepository, lmr LimitRepository, cv CardVault, ht time.Duration) AuthorizeHoldCommand {
+	return &AuthorizeHoldCommandImpl{v: v, l: l, ur: ur, ar: ar, cr: cr, hr: hr, lmr: lmr, cv: cv, ht: ht}
+}
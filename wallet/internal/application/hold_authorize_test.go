@@ -0,0 +1,58 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/validator"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/escalopa/fingo/wallet/internal/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthorizeHold_Execute(t *testing.T) {
+	valid := "4000001234567899"
+	invalid := "4000001234567898" // wrong Luhn check digit
+
+	tests := []struct {
+		name   string
+		params AuthorizeHoldParams
+	}{
+		{
+			name:   "invalid from card checksum",
+			params: AuthorizeHoldParams{Amount: 1000, Currency: core.CurrencyUSD, FromCard: invalid, ToCard: valid},
+		},
+		{
+			name:   "invalid to card checksum",
+			params: AuthorizeHoldParams{Amount: 1000, Currency: core.CurrencyUSD, FromCard: valid, ToCard: invalid},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			// The cards are refused by the validator, before any of them is looked up
+			cmd := NewAuthorizeHoldCommand(
+				validator.NewValidator(),
+				mock.NewMockLocker(ctrl),
+				mock.NewMockUserRepository(ctrl),
+				mock.NewMockAccountRepository(ctrl),
+				mock.NewMockCardRepository(ctrl),
+				mock.NewMockHoldRepository(ctrl),
+				mock.NewMockLimitRepository(ctrl),
+				mock.NewMockCardVault(ctrl),
+				time.Hour,
+			)
+
+			ctx := contextutils.SetUserID(context.Background(), uuid.New().String())
+			_, err := cmd.Execute(ctx, tt.params)
+			require.Error(t, err)
+		})
+	}
+}
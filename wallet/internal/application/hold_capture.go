@@ -0,0 +1,123 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type CaptureHoldParams struct {
+	HoldID string `validate:"required,uuid"`
+	Amount int64  `validate:"min=0"` // in minor units of the hold's currency, zero captures the full amount
+}
+
+var errorHoldNotActive = errs.B().Code(errs.InvalidArgument).Msg("hold is no longer authorized, it was captured, voided or expired").Err()
+
+type CaptureHoldCommand interface {
+	Execute(ctx context.Context, params CaptureHoldParams) (core.Transaction, error)
+}
+
+type CaptureHoldCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	hr  HoldRepository
+	lmr LimitRepository
+}
+
+// Execute transfers the captured amount of the hold to its recipient & releases the rest
+// Only the recipient account's owner can capture a hold
+func (c *CaptureHoldCommandImpl) Execute(ctx context.Context, params CaptureHoldParams) (core.Transaction, error) {
+	var transaction core.Transaction
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CaptureHoldCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse hold id
+		holdID, err := uuid.Parse(params.HoldID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid hold id").Err()
+		}
+		hold, err := c.hr.GetHold(ctx, holdID)
+		if err != nil {
+			return err
+		}
+		// Check that the caller owns the recipient account
+		toAccount, err := c.ar.GetAccount(ctx, hold.RecipientAccountID)
+		if err != nil {
+			return err
+		}
+		if innerID != toAccount.OwnerID {
+			return errorNotAccountOwner
+		}
+		if !hold.IsActive(time.Now().UTC()) {
+			return errorHoldNotActive
+		}
+		amount := params.Amount
+		if amount == 0 {
+			amount = hold.Amount
+		}
+		if amount > hold.Amount {
+			return errs.B().Code(errs.InvalidArgument).
+				Msgf("capture amount %d exceeds the held amount %d", amount, hold.Amount).
+				Err()
+		}
+		// Lock both accounts for the transfer
+		unlock := c.l.Lock(ctx, hold.AccountID, hold.RecipientAccountID)
+		defer unlock()
+		// Read both accounts under the lock, they may have been frozen or closed since the authorization
+		fromAccount, err := c.ar.GetAccount(ctx, hold.AccountID)
+		if err != nil {
+			return err
+		}
+		toAccount, err = c.ar.GetAccount(ctx, toAccount.ID)
+		if err != nil {
+			return err
+		}
+		if err = checkCanSend(fromAccount); err != nil {
+			return err
+		}
+		if err = checkCanReceive(toAccount); err != nil {
+			return err
+		}
+		// Check the limits again as they may have been lowered since the authorization, the capture replaces the hold
+		// in the spending
+		limits, err := getSpendingLimits(ctx, c.lmr, core.TransactionTypeTransfer, fromAccount.ID, hold.Card)
+		if err != nil {
+			return err
+		}
+		limits.release(hold)
+		if err = limits.check(amount, 0); err != nil {
+			return err
+		}
+		transaction, err = c.hr.CaptureHold(ctx, hold.ID, amount)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return transaction, err
+}
+
+func NewCaptureHoldCommand(v Validator, l Locker, ur UserRepository, ar AccountRepository, hr HoldRepository, lmr LimitRepository) CaptureHoldCommand {
+	return &CaptureHoldCommandImpl{v: v, l: l, ur: ur, ar: ar, hr: hr, lmr: lmr}
+}
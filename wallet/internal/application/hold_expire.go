@@ -0,0 +1,44 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+)
+
+type ExpireHoldsParams struct{}
+
+type ExpireHoldsCommand interface {
+	Execute(ctx context.Context, params ExpireHoldsParams) (int64, error)
+}
+
+type ExpireHoldsCommandImpl struct {
+	v  Validator
+	hr HoldRepository
+}
+
+// Execute marks the holds past their expiry as expired and returns how many were expired
+func (c *ExpireHoldsCommandImpl) Execute(ctx context.Context, params ExpireHoldsParams) (int64, error) {
+	var expired int64
+	err := contextutils.ExecuteWithContextTimeout(ctx, 1*time.Minute, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "ExpireHoldsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		var err error
+		expired, err = c.hr.ExpireHolds(ctx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return expired, err
+}
+
+func NewExpireHoldsCommand(v Validator, hr HoldRepository) ExpireHoldsCommand {
+	return &ExpireHoldsCommandImpl{v: v, hr: hr}
+}
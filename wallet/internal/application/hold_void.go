@@ -0,0 +1,73 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type VoidHoldParams struct {
+	HoldID string `validate:"required,uuid"`
+}
+
+type VoidHoldCommand interface {
+	Execute(ctx context.Context, params VoidHoldParams) error
+}
+
+type VoidHoldCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	hr HoldRepository
+}
+
+// Execute releases the hold without moving money, only the recipient account's owner can void a hold
+func (c *VoidHoldCommandImpl) Execute(ctx context.Context, params VoidHoldParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "VoidHoldCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse hold id
+		holdID, err := uuid.Parse(params.HoldID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid hold id").Err()
+		}
+		hold, err := c.hr.GetHold(ctx, holdID)
+		if err != nil {
+			return err
+		}
+		// Check that the caller owns the recipient account
+		toAccount, err := c.ar.GetAccount(ctx, hold.RecipientAccountID)
+		if err != nil {
+			return err
+		}
+		if innerID != toAccount.OwnerID {
+			return errorNotAccountOwner
+		}
+		if !hold.IsActive(time.Now().UTC()) {
+			return errorHoldNotActive
+		}
+		return c.hr.VoidHold(ctx, hold.ID)
+	})
+}
+
+func NewVoidHoldCommand(v Validator, ur UserRepository, ar AccountRepository, hr HoldRepository) VoidHoldCommand {
+	return &VoidHoldCommandImpl{v: v, ur: ur, ar: ar, hr: hr}
+}
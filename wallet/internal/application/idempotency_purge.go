@@ -0,0 +1,49 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+)
+
+type PurgeIdempotencyKeysParams struct{}
+
+type PurgeIdempotencyKeysCommand interface {
+	Execute(ctx context.Context, params PurgeIdempotencyKeysParams) (int64, error)
+}
+
+type PurgeIdempotencyKeysCommandImpl struct {
+	v  Validator
+	tr TransactionRepository
+	ir time.Duration
+}
+
+// Execute clears the idempotency keys past their retention so that they can be used again, and returns how many were cleared
+// Keys are kept forever when no retention is set
+func (c *PurgeIdempotencyKeysCommandImpl) Execute(ctx context.Context, params PurgeIdempotencyKeysParams) (int64, error) {
+	var purged int64
+	if c.ir <= 0 {
+		return purged, nil
+	}
+	err := contextutils.ExecuteWithContextTimeout(ctx, 1*time.Minute, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "PurgeIdempotencyKeysCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		var err error
+		purged, err = c.tr.PurgeIdempotencyKeys(ctx, time.Now().UTC().Add(-c.ir))
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return purged, err
+}
+
+func NewPurgeIdempotencyKeysCommand(v Validator, tr TransactionRepository, ir time.Duration) PurgeIdempotencyKeysCommand {
+	return &PurgeIdempotencyKeysCommandImpl{v: v, tr: tr, ir: ir}
+}
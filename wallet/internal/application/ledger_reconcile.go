@@ -0,0 +1,45 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type ReconcileLedgerParams struct{}
+
+type ReconcileLedgerCommand interface {
+	Execute(ctx context.Context, params ReconcileLedgerParams) ([]core.LedgerMismatch, error)
+}
+
+type ReconcileLedgerCommandImpl struct {
+	v  Validator
+	lr LedgerRepository
+}
+
+func (c *ReconcileLedgerCommandImpl) Execute(ctx context.Context, params ReconcileLedgerParams) ([]core.LedgerMismatch, error) {
+	var mismatches []core.LedgerMismatch
+	err := contextutils.ExecuteWithContextTimeout(ctx, 1*time.Minute, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "ReconcileLedgerCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Flag accounts whose stored balance disagrees with their ledger
+		var err error
+		mismatches, err = c.lr.Reconcile(ctx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return mismatches, err
+}
+
+func NewReconcileLedgerCommand(v Validator, lr LedgerRepository) ReconcileLedgerCommand {
+	return &ReconcileLedgerCommandImpl{v: v, lr: lr}
+}
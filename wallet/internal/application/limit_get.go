@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type GetLimitsParams struct {
+	AccountID  int64  `validate:"omitempty,min=1"`  // limits set one of `AccountID` or `CardNumber`
+	CardNumber string `validate:"omitempty,len=64"` // card token
+}
+
+var errorLimitSubjectNotSet = errs.B().Code(errs.InvalidArgument).Msg("exactly one of the account id or card number must be set").Err()
+
+type GetLimitsCommand interface {
+	Execute(ctx context.Context, params GetLimitsParams) ([]core.SpendingLimit, error)
+}
+
+type GetLimitsCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	lmr LimitRepository
+	ops map[uuid.UUID]struct{}
+}
+
+// Execute returns the spending limits of an account or of a card, to its owner or to an operations user
+func (c *GetLimitsCommandImpl) Execute(ctx context.Context, params GetLimitsParams) ([]core.SpendingLimit, error) {
+	var limits []core.SpendingLimit
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetLimitsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Check that the caller owns the account or card, operations users can see any
+		if _, err = getLimitAccount(ctx, c.ur, c.ar, c.cr, c.ops, userID, params.AccountID, params.CardNumber); err != nil {
+			return err
+		}
+		if params.CardNumber != "" {
+			limits, err = c.lmr.GetCardLimits(ctx, params.CardNumber)
+		} else {
+			limits, err = c.lmr.GetAccountLimits(ctx, params.AccountID)
+		}
+		return err
+	})
+	return limits, err
+}
+
+// getLimitAccount returns the account limited by an account limit or by a card limit, the caller must own it
+// unless it's an operations user
+func getLimitAccount(
+	ctx context.Context,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	ops map[uuid.UUID]struct{},
+	userID uuid.UUID,
+	accountID int64,
+	cardNumber string,
+) (core.Account, error) {
+	if (accountID == 0) == (cardNumber == "") {
+		return core.Account{}, errorLimitSubjectNotSet
+	}
+	var account core.Account
+	var err error
+	if cardNumber != "" {
+		account, err = cr.GetCardAccount(ctx, cardNumber)
+	} else {
+		account, err = ar.GetAccount(ctx, accountID)
+	}
+	if err != nil {
+		return core.Account{}, err
+	}
+	if _, ok := ops[userID]; ok {
+		return account, nil
+	}
+	innerID, err := ur.GetUser(ctx, userID)
+	if err != nil {
+		return core.Account{}, err
+	}
+	if innerID != account.OwnerID {
+		return core.Account{}, errorNotAccountOwner
+	}
+	return account, nil
+}
+
+func NewGetLimitsCommand(
+	v Validator,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	lmr LimitRepository,
+	ops map[uuid.UUID]struct{},
+) GetLimitsCommand {
+	return &GetLimitsCommandImpl{v: v, ur: ur, ar: ar, cr: cr, lmr: lmr, ops: ops}
+}
@@ -0,0 +1,117 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type SetLimitParams struct {
+	AccountID      int64                `validate:"omitempty,min=1"`  // limits set one of `AccountID` or `CardNumber`
+	CardNumber     string               `validate:"omitempty,len=64"` // card token
+	Type           core.TransactionType `validate:"required,oneof=transfer deposit withdrawal"`
+	PerTransaction int64                `validate:"min=0"` // bounds are in minor units of the account's currency, zero is unlimited
+	Daily          int64                `validate:"min=0"`
+	Monthly        int64                `validate:"min=0"`
+}
+
+var errorLimitRaised = errs.B().Code(errs.Forbidden).Msg("only operations users can raise limits").Err()
+
+type SetLimitCommand interface {
+	Execute(ctx context.Context, params SetLimitParams) (core.SpendingLimit, error)
+}
+
+type SetLimitCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	lmr LimitRepository
+	ops map[uuid.UUID]struct{}
+}
+
+// Execute replaces the limit of a transaction type on an account or on a card
+// Owners can only lower their limits, operations users can set any limit on any account or card
+func (c *SetLimitCommandImpl) Execute(ctx context.Context, params SetLimitParams) (core.SpendingLimit, error) {
+	var limit core.SpendingLimit
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "SetLimitCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Check that the caller owns the account or card, operations users can set any
+		account, err := getLimitAccount(ctx, c.ur, c.ar, c.cr, c.ops, userID, params.AccountID, params.CardNumber)
+		if err != nil {
+			return err
+		}
+		limit = core.SpendingLimit{
+			AccountID:      params.AccountID,
+			CardNumber:     params.CardNumber,
+			Type:           params.Type,
+			PerTransaction: params.PerTransaction,
+			Daily:          params.Daily,
+			Monthly:        params.Monthly,
+		}
+		// Lock the account so that concurrent updates don't raise the limit in between
+		unlock := c.l.Lock(ctx, account.ID, -1)
+		defer unlock()
+		current, err := c.getLimit(ctx, limit)
+		if err != nil {
+			return err
+		}
+		if _, ok := c.ops[userID]; !ok && !limit.Lowers(current) {
+			return errorLimitRaised
+		}
+		if err = c.lmr.SetLimit(ctx, limit); err != nil {
+			return err
+		}
+		limit, err = c.getLimit(ctx, limit)
+		return err
+	})
+	return limit, err
+}
+
+// getLimit returns the stored limit of the same account or card & type, unset limits are unlimited
+func (c *SetLimitCommandImpl) getLimit(ctx context.Context, limit core.SpendingLimit) (core.SpendingLimit, error) {
+	var limits []core.SpendingLimit
+	var err error
+	if limit.CardNumber != "" {
+		limits, err = c.lmr.GetCardLimits(ctx, limit.CardNumber)
+	} else {
+		limits, err = c.lmr.GetAccountLimits(ctx, limit.AccountID)
+	}
+	if err != nil {
+		return core.SpendingLimit{}, err
+	}
+	for _, l := range limits {
+		if l.Type == limit.Type {
+			return l, nil
+		}
+	}
+	return core.SpendingLimit{AccountID: limit.AccountID, CardNumber: limit.CardNumber, Type: limit.Type}, nil
+}
+
+func NewSetLimitCommand(
+	v Validator,
+	l Locker,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	lmr LimitRepository,
+	ops map[uuid.UUID]struct{},
+) SetLimitCommand {
+	return &SetLimitCommandImpl{v: v, l: l, ur: ur, ar: ar, cr: cr, lmr: lmr, ops: ops}
+}
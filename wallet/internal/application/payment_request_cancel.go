@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type CancelPaymentRequestParams struct {
+	RequestID string `validate:"required,uuid"`
+}
+
+type CancelPaymentRequestCommand interface {
+	Execute(ctx context.Context, params CancelPaymentRequestParams) error
+}
+
+type CancelPaymentRequestCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	pr PaymentRequestRepository
+}
+
+// Execute withdraws the payment request, only the requester can cancel a request
+func (c *CancelPaymentRequestCommandImpl) Execute(ctx context.Context, params CancelPaymentRequestParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CancelPaymentRequestCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse request id
+		requestID, err := uuid.Parse(params.RequestID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid payment request id").Err()
+		}
+		request, err := c.pr.GetPaymentRequest(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		if innerID != request.RequesterID {
+			return errorNotPaymentRequestRequester
+		}
+		if !request.IsPending(time.Now().UTC()) {
+			return errorPaymentRequestNotPending
+		}
+		return c.pr.ClosePaymentRequest(ctx, request.ID, core.PaymentRequestStatusCancelled)
+	})
+}
+
+func NewCancelPaymentRequestCommand(v Validator, ur UserRepository, pr PaymentRequestRepository) CancelPaymentRequestCommand {
+	return &CancelPaymentRequestCommandImpl{v: v, ur: ur, pr: pr}
+}
@@ -0,0 +1,183 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+	"github.com/sirupsen/logrus"
+)
+
+type CreatePaymentRequestParams struct {
+	Amount        int64         `validate:"required,min=1"` // in minor units of `Currency`
+	Currency      core.Currency `validate:"required"`
+	PayerUsername string        `validate:"omitempty,alphanum"` // requests set one of `PayerUsername` or `PayerEmail`
+	PayerEmail    string        `validate:"omitempty,email"`
+	ToCard        string        `validate:"omitempty,number,credit_card"` // defaults to the requester's receiving account in `Currency`
+	Note          string        `validate:"max=256"`
+}
+
+var (
+	errorPayerNotSet      = errs.B().Code(errs.InvalidArgument).Msg("exactly one of the payer username or email must be set").Err()
+	errorPayerIsRequester = errs.B().Code(errs.InvalidArgument).Msg("cannot request money from yourself").Err()
+)
+
+type CreatePaymentRequestCommand interface {
+	Execute(ctx context.Context, params CreatePaymentRequestParams) (core.PaymentRequest, error)
+}
+
+type CreatePaymentRequestCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	pr  PaymentRequestRepository
+	ud  UserDirectory
+	mp  MessageProducer
+	cv  CardVault
+	prt time.Duration // payment requests time to live
+}
+
+// Execute asks the payer for money on behalf of the caller & notifies the payer by email
+// The payer must have a wallet, the money is received on `ToCard` or on the caller's receiving account in the currency
+func (c *CreatePaymentRequestCommandImpl) Execute(ctx context.Context, params CreatePaymentRequestParams) (core.PaymentRequest, error) {
+	var request core.PaymentRequest
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CreatePaymentRequestCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Reference the cards by their tokens
+		params.ToCard = cardToken(ctx, c.cv, params.ToCard)
+		if (params.PayerUsername == "") == (params.PayerEmail == "") {
+			return errorPayerNotSet
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Find the payer in the auth service & its wallet
+		payer, payerID, err := lookupPayer(ctx, c.ud, c.ur, userID, params.PayerUsername, params.PayerEmail)
+		if err != nil {
+			return err
+		}
+		// Get the account receiving the money
+		toAccount, err := getRequestAccount(ctx, c.ar, c.cr, userID, innerID, params.ToCard, params.Currency)
+		if err != nil {
+			return err
+		}
+		request, err = c.pr.CreatePaymentRequest(ctx, core.CreatePaymentRequestParams{
+			RequesterID: innerID,
+			PayerID:     payerID,
+			ToAccountID: toAccount.ID,
+			Amount:      params.Amount,
+			Currency:    params.Currency,
+			Note:        params.Note,
+			ExpiresAt:   time.Now().UTC().Add(c.prt),
+		})
+		if err != nil {
+			return err
+		}
+		notifyPayers(ctx, c.ud, c.mp, userID, []core.PaymentRequest{request}, []core.User{payer}, toAccount.MinorUnits)
+		return nil
+	})
+	return request, err
+}
+
+// lookupPayer finds the payer in the auth service by username or email & returns it with its inner id
+// The payer must have a wallet & can't be the requester
+func lookupPayer(ctx context.Context, ud UserDirectory, ur UserRepository, requesterID uuid.UUID, username, email string) (core.User, int64, error) {
+	payer, err := ud.LookupUser(ctx, core.LookupUserParams{Username: username, Email: email})
+	if err != nil {
+		return core.User{}, 0, err
+	}
+	if payer.ID == requesterID {
+		return core.User{}, 0, errorPayerIsRequester
+	}
+	payerID, err := ur.GetUser(ctx, payer.ID)
+	if err != nil {
+		if errErrs, ok := err.(*errs.Error); ok && errErrs.Code == errs.NotFound {
+			return core.User{}, 0, errs.B(err).Code(errs.NotFound).Msgf("payer %s has no wallet", payer.Username).Err()
+		}
+		return core.User{}, 0, err
+	}
+	return payer, payerID, nil
+}
+
+// getRequestAccount returns the account receiving requested money, the account of `toCard` or the requester's receiving account in `currency`
+func getRequestAccount(ctx context.Context, ar AccountRepository, cr CardRepository, userID uuid.UUID, innerID int64, toCard string, currency core.Currency) (core.Account, error) {
+	if toCard == "" {
+		return ar.GetReceivingAccount(ctx, userID, currency)
+	}
+	toAccount, err := cr.GetCardAccount(ctx, toCard)
+	if err != nil {
+		return core.Account{}, err
+	}
+	if innerID != toAccount.OwnerID {
+		return core.Account{}, errorNotAccountOwner
+	}
+	if err = checkCanReceive(toAccount); err != nil {
+		return core.Account{}, err
+	}
+	if currency != toAccount.Currency {
+		return core.Account{}, errs.B().Code(errs.InvalidArgument).
+			Msgf("amount currency mismatch, amount currency: %s, account currency: %s", currency, toAccount.Currency).
+			Err()
+	}
+	return toAccount, nil
+}
+
+// notifyPayers publishes the messages sending the payment requests to their payers' emails, `payers` are in the order of `requests`
+// The requests are stored, failing to notify a payer doesn't fail them since the payers list their incoming requests
+func notifyPayers(ctx context.Context, ud UserDirectory, mp MessageProducer, requesterID uuid.UUID, requests []core.PaymentRequest, payers []core.User, minorUnits int) {
+	requester, lookupErr := ud.LookupUser(ctx, core.LookupUserParams{UserID: requesterID})
+	for i, request := range requests {
+		err := lookupErr
+		if err == nil {
+			err = mp.SendPaymentRequestMessage(ctx, core.PaymentRequestMessage{
+				Name:          payers[i].FirstName,
+				Email:         payers[i].Email,
+				RequesterName: strings.TrimSpace(requester.FirstName + " " + requester.LastName),
+				Amount:        core.FormatAmount(request.Amount, minorUnits, request.Currency),
+				Note:          request.Note,
+				ExpiresAt:     request.ExpiresAt.Format(time.RFC1123),
+			})
+		}
+		if err != nil {
+			l, err2 := contextutils.GetLogger(ctx)
+			if err2 == nil {
+				l.WithFields(logrus.Fields{
+					"PaymentRequestID": request.ID,
+					"Error":            err.Error(),
+				}).Error("failed to send message for new payment request")
+			}
+		}
+	}
+}
+
+func NewCreatePaymentRequestCommand(
+	v Validator,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	pr PaymentRequestRepository,
+	ud UserDirectory,
+	mp MessageProducer,
+	cv CardVault,
+	prt time.Duration,
+) CreatePaymentRequestCommand {
+	return &CreatePaymentRequestCommandImpl{v: v, ur: ur, ar: ar, cr: cr, pr: pr, ud: ud, mp: mp, cv: cv, prt: prt}
+}
@@ -0,0 +1,68 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type DeclinePaymentRequestParams struct {
+	RequestID string `validate:"required,uuid"`
+}
+
+type DeclinePaymentRequestCommand interface {
+	Execute(ctx context.Context, params DeclinePaymentRequestParams) error
+}
+
+type DeclinePaymentRequestCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	pr PaymentRequestRepository
+}
+
+// Execute refuses the payment request without moving money, only the payer can decline a request
+func (c *DeclinePaymentRequestCommandImpl) Execute(ctx context.Context, params DeclinePaymentRequestParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "DeclinePaymentRequestCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse request id
+		requestID, err := uuid.Parse(params.RequestID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid payment request id").Err()
+		}
+		request, err := c.pr.GetPaymentRequest(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		if innerID != request.PayerID {
+			return errorNotPaymentRequestPayer
+		}
+		if !request.IsPending(time.Now().UTC()) {
+			return errorPaymentRequestNotPending
+		}
+		return c.pr.ClosePaymentRequest(ctx, request.ID, core.PaymentRequestStatusDeclined)
+	})
+}
+
+func NewDeclinePaymentRequestCommand(v Validator, ur UserRepository, pr PaymentRequestRepository) DeclinePaymentRequestCommand {
+	return &DeclinePaymentRequestCommandImpl{v: v, ur: ur, pr: pr}
+}
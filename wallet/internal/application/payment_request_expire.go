@@ -0,0 +1,44 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+)
+
+type ExpirePaymentRequestsParams struct{}
+
+type ExpirePaymentRequestsCommand interface {
+	Execute(ctx context.Context, params ExpirePaymentRequestsParams) (int64, error)
+}
+
+type ExpirePaymentRequestsCommandImpl struct {
+	v  Validator
+	pr PaymentRequestRepository
+}
+
+// Execute marks the pending payment requests past their expiry as expired and returns how many were expired
+func (c *ExpirePaymentRequestsCommandImpl) Execute(ctx context.Context, params ExpirePaymentRequestsParams) (int64, error) {
+	var expired int64
+	err := contextutils.ExecuteWithContextTimeout(ctx, 1*time.Minute, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "ExpirePaymentRequestsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		var err error
+		expired, err = c.pr.ExpirePaymentRequests(ctx)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return expired, err
+}
+
+func NewExpirePaymentRequestsCommand(v Validator, pr PaymentRequestRepository) ExpirePaymentRequestsCommand {
+	return &ExpirePaymentRequestsCommandImpl{v: v, pr: pr}
+}
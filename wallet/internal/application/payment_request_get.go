@@ -0,0 +1,60 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type GetPaymentRequestsParams struct {
+	Outgoing bool // lists the requests sent by the caller instead of the ones sent to the caller
+}
+
+type GetPaymentRequestsCommand interface {
+	Execute(ctx context.Context, params GetPaymentRequestsParams) ([]core.PaymentRequest, error)
+}
+
+type GetPaymentRequestsCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	pr PaymentRequestRepository
+}
+
+// Execute returns the payment requests sent to the caller, or sent by the caller when outgoing, newest first
+func (c *GetPaymentRequestsCommandImpl) Execute(ctx context.Context, params GetPaymentRequestsParams) ([]core.PaymentRequest, error) {
+	var requests []core.PaymentRequest
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetPaymentRequestsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if params.Outgoing {
+			requests, err = c.pr.GetOutgoingPaymentRequests(ctx, innerID)
+		} else {
+			requests, err = c.pr.GetIncomingPaymentRequests(ctx, innerID)
+		}
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return requests, err
+}
+
+func NewGetPaymentRequestsCommand(v Validator, ur UserRepository, pr PaymentRequestRepository) GetPaymentRequestsCommand {
+	return &GetPaymentRequestsCommandImpl{v: v, ur: ur, pr: pr}
+}
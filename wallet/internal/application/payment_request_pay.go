@@ -0,0 +1,142 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type PayPaymentRequestParams struct {
+	RequestID string `validate:"required,uuid"`
+	FromCard  string `validate:"required,number,credit_card"`
+}
+
+var (
+	errorNotPaymentRequestPayer     = errs.B().Code(errs.Forbidden).Msg("not the payment request's payer").Err()
+	errorNotPaymentRequestRequester = errs.B().Code(errs.Forbidden).Msg("not the payment request's requester").Err()
+	errorPaymentRequestNotPending   = errs.B().Code(errs.InvalidArgument).Msg("payment request is no longer pending, it was paid, declined, cancelled or expired").Err()
+)
+
+type PayPaymentRequestCommand interface {
+	Execute(ctx context.Context, params PayPaymentRequestParams) (core.Transaction, error)
+}
+
+type PayPaymentRequestCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	pr  PaymentRequestRepository
+	lmr LimitRepository
+	cv  CardVault
+}
+
+// Execute transfers the requested amount from the payer's card to the requester's account
+// Only the payer can pay a request, from a card in the request's currency
+func (c *PayPaymentRequestCommandImpl) Execute(ctx context.Context, params PayPaymentRequestParams) (core.Transaction, error) {
+	var transaction core.Transaction
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "PayPaymentRequestCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Reference the cards by their tokens
+		params.FromCard = cardToken(ctx, c.cv, params.FromCard)
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse request id
+		requestID, err := uuid.Parse(params.RequestID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid payment request id").Err()
+		}
+		request, err := c.pr.GetPaymentRequest(ctx, requestID)
+		if err != nil {
+			return err
+		}
+		if innerID != request.PayerID {
+			return errorNotPaymentRequestPayer
+		}
+		if !request.IsPending(time.Now().UTC()) {
+			return errorPaymentRequestNotPending
+		}
+		// Check that the caller owns the card & that it's in the request's currency
+		fromAccount, err := c.cr.GetCardAccount(ctx, params.FromCard)
+		if err != nil {
+			return err
+		}
+		if innerID != fromAccount.OwnerID {
+			return errorNotAccountOwner
+		}
+		// Check that the card can be used
+		card, err := getUsableCard(ctx, c.cr, params.FromCard)
+		if err != nil {
+			return err
+		}
+		if request.Currency != fromAccount.Currency {
+			return errs.B().Code(errs.InvalidArgument).
+				Msgf("currency mismatch, request currency: %s, account currency: %s", request.Currency, fromAccount.Currency).
+				Err()
+		}
+		// Lock both accounts for the transfer & check the available balance
+		unlock := c.l.Lock(ctx, fromAccount.ID, request.ToAccountID)
+		defer unlock()
+		fromAccount, err = c.ar.GetAccount(ctx, fromAccount.ID)
+		if err != nil {
+			return err
+		}
+		if err = checkCanSend(fromAccount); err != nil {
+			return err
+		}
+		toAccount, err := c.ar.GetAccount(ctx, request.ToAccountID)
+		if err != nil {
+			return err
+		}
+		if err = checkCanReceive(toAccount); err != nil {
+			return err
+		}
+		if fromAccount.AvailableBalance < request.Amount {
+			return errorNoSufficientFunds
+		}
+		if err = checkSpendingLimits(ctx, c.lmr, core.TransactionTypeTransfer, fromAccount.ID, params.FromCard, request.Amount); err != nil {
+			return err
+		}
+		if err = checkCardDebit(ctx, c.cr, card, toAccount.ID, request.Amount); err != nil {
+			return err
+		}
+		transaction, err = c.pr.PayPaymentRequest(ctx, request.ID, fromAccount.ID, params.FromCard)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return transaction, err
+}
+
+func NewPayPaymentRequestCommand(
+	v Validator,
+	l Locker,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	pr PaymentRequestRepository,
+	lmr LimitRepository,
+	cv CardVault,
+) PayPaymentRequestCommand {
+	return &PayPaymentRequestCommandImpl{v: v, l: l, ur: ur, ar: ar, cr: cr, pr: pr, lmr: lmr, cv: cv}
+}
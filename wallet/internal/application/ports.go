@@ -2,6 +2,7 @@ package application
 
 import (
 	"context"
+	"time"
 
 	"github.com/escalopa/fingo/wallet/internal/core"
 	"github.com/google/uuid"
@@ -10,35 +11,175 @@ import (
 type UserRepository interface {
 	CreateUser(ctx context.Context, uuid uuid.UUID) error
 	GetUser(ctx context.Context, uuid uuid.UUID) (int64, error)
+	GetUserExternalID(ctx context.Context, id int64) (uuid.UUID, error)
 }
 
 type AccountRepository interface {
 	CreateAccount(ctx context.Context, params core.CreateAccountParams) error
 	GetAccount(ctx context.Context, accountID int64) (core.Account, error)
+	GetAccountBalanceAt(ctx context.Context, accountID int64, at time.Time) (int64, error)
 	GetAccounts(ctx context.Context, userID int64) ([]core.Account, error)
-	DeleteAccount(ctx context.Context, accountID int64) error
+	GetReceivingAccount(ctx context.Context, userID uuid.UUID, currency core.Currency) (core.Account, error)
+	SetDefaultAccount(ctx context.Context, accountID int64) error
+	SetAccountStatus(ctx context.Context, accountID int64, status core.AccountStatus, byOps bool) error
+	CloseAccount(ctx context.Context, accountID int64, sweepToAccountID int64) (core.Transaction, error)
 }
 
 type CardRepository interface {
 	CreateCard(ctx context.Context, params core.CreateCardParams) error
 	GetCard(ctx context.Context, cardNumber string) (core.Card, error)
 	GetCardAccount(ctx context.Context, cardNumber string) (core.Account, error)
+	GetCardDebited(ctx context.Context, cardNumber string) (int64, error)
 	GetCards(ctx context.Context, accountID int64) ([]core.Card, error)
+	ReplaceCard(ctx context.Context, cardNumber string, params core.CreateCardParams) error
+	SetCardStatus(ctx context.Context, cardNumber string, status core.CardStatus) error
+	SetCardNickname(ctx context.Context, cardNumber string, nickname string) error
+	SetCardPin(ctx context.Context, cardNumber string, pinHash string) error
+	SetCardPinAttempts(ctx context.Context, cardNumber string, attempts core.CardPINAttempts) error
+	GetUnvaultedCards(ctx context.Context) ([]string, error)
+	GetVaultedCards(ctx context.Context) ([]core.Card, error)
+	VaultCard(ctx context.Context, cardNumber string, token string, encrypted core.EncryptedCardNumber) error
+	CreateCardReveal(ctx context.Context, cardNumber string, userID int64) error
 	DeleteCard(ctx context.Context, cardNumber string) error
 }
 
 type TransactionRepository interface {
-	Transfer(ctx context.Context, params core.CreateTransactionParams) error
-	Deposit(ctx context.Context, params core.CreateTransactionParams) error
-	Withdraw(ctx context.Context, params core.CreateTransactionParams) error
+	Transfer(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error)
+	BatchTransfer(ctx context.Context, params []core.CreateTransactionParams) ([]core.Transaction, error)
+	Deposit(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error)
+	Withdraw(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error)
 	GetTransaction(ctx context.Context, transactionID uuid.UUID) (core.Transaction, error)
+	GetTransactionByIdempotencyKey(ctx context.Context, key string) (core.Transaction, error)
 	GetTransactions(ctx context.Context, params core.GetTransactionsParams) ([]core.Transaction, error)
-	RollbackTransaction(ctx context.Context, transactionID uuid.UUID) error
+	ReverseTransaction(ctx context.Context, params core.ReverseTransactionParams) (core.Transaction, error)
+	SetTransactionLabels(ctx context.Context, params core.SetTransactionLabelsParams) error
+	GetSpendingByCategory(ctx context.Context, accountID int64, from, to time.Time) ([]core.CategorySpending, error)
+	PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error)
 }
 
-// CardNumberGenerator is an interface for generating card numbers
+// CurrencyRepository is the registry of the supported currencies
+type CurrencyRepository interface {
+	GetCurrency(ctx context.Context, code core.Currency) (core.CurrencyInfo, error)
+	GetCurrencies(ctx context.Context) ([]core.CurrencyInfo, error)
+}
+
+type QuoteRepository interface {
+	CreateQuote(ctx context.Context, params core.CreateQuoteParams) (core.Quote, error)
+	GetQuote(ctx context.Context, quoteID uuid.UUID) (core.Quote, error)
+}
+
+// HoldRepository reserves funds on accounts for later transfers
+type HoldRepository interface {
+	CreateHold(ctx context.Context, params core.CreateHoldParams) (core.Hold, error)
+	GetHold(ctx context.Context, holdID uuid.UUID) (core.Hold, error)
+	CaptureHold(ctx context.Context, holdID uuid.UUID, amount int64) (core.Transaction, error)
+	VoidHold(ctx context.Context, holdID uuid.UUID) error
+	ExpireHolds(ctx context.Context) (int64, error)
+}
+
+// StandingOrderRepository stores the scheduled transfers & the outcome of their runs
+type StandingOrderRepository interface {
+	CreateStandingOrder(ctx context.Context, params core.CreateStandingOrderParams) (core.StandingOrder, error)
+	GetStandingOrder(ctx context.Context, orderID uuid.UUID) (core.StandingOrder, error)
+	GetStandingOrders(ctx context.Context, userID int64) ([]core.StandingOrder, error)
+	UpdateStandingOrder(ctx context.Context, params core.UpdateStandingOrderParams) (core.StandingOrder, error)
+	CancelStandingOrder(ctx context.Context, orderID uuid.UUID) error
+	GetDueStandingOrders(ctx context.Context, now time.Time, limit int32) ([]core.StandingOrder, error)
+	RecordExecution(ctx context.Context, params core.RecordStandingOrderExecutionParams) (core.StandingOrderExecution, error)
+	GetExecutions(ctx context.Context, orderID uuid.UUID) ([]core.StandingOrderExecution, error)
+}
+
+// PaymentRequestRepository stores the money asked by users to each other
+type PaymentRequestRepository interface {
+	CreatePaymentRequest(ctx context.Context, params core.CreatePaymentRequestParams) (core.PaymentRequest, error)
+	GetPaymentRequest(ctx context.Context, requestID uuid.UUID) (core.PaymentRequest, error)
+	GetIncomingPaymentRequests(ctx context.Context, payerID int64) ([]core.PaymentRequest, error)
+	GetOutgoingPaymentRequests(ctx context.Context, requesterID int64) ([]core.PaymentRequest, error)
+	PayPaymentRequest(ctx context.Context, requestID uuid.UUID, fromAccountID int64, fromCard string) (core.Transaction, error)
+	ClosePaymentRequest(ctx context.Context, requestID uuid.UUID, status core.PaymentRequestStatus) error
+	ExpirePaymentRequests(ctx context.Context) (int64, error)
+}
+
+// SplitRepository stores the bills split between users, the shares are stored as payment requests
+type SplitRepository interface {
+	CreateSplit(ctx context.Context, params core.CreateSplitParams) (core.Split, error)
+	GetSplit(ctx context.Context, splitID uuid.UUID) (core.Split, error)
+	GetSplits(ctx context.Context, requesterID int64) ([]core.Split, error)
+}
+
+// LimitRepository stores the spending limits of accounts & cards
+type LimitRepository interface {
+	GetAccountLimits(ctx context.Context, accountID int64) ([]core.SpendingLimit, error)
+	GetCardLimits(ctx context.Context, cardNumber string) ([]core.SpendingLimit, error)
+	GetTransactionLimits(ctx context.Context, t core.TransactionType, accountID int64, cardNumber string) ([]core.SpendingLimit, error)
+	SetLimit(ctx context.Context, limit core.SpendingLimit) error
+	GetSpending(ctx context.Context, limit core.SpendingLimit, now time.Time) (core.Spending, error)
+}
+
+// BudgetRepository stores the monthly budgets of accounts & the thresholds their owners were alerted about
+type BudgetRepository interface {
+	SetBudget(ctx context.Context, params core.SetBudgetParams) (core.Budget, error)
+	DeleteBudget(ctx context.Context, accountID int64, category core.Category) error
+	GetAccountBudgets(ctx context.Context, accountID int64) ([]core.Budget, error)
+	GetBudgets(ctx context.Context, afterAccountID int64, limit int32) ([]core.Budget, error)
+	SetBudgetAlerted(ctx context.Context, accountID int64, category core.Category, month time.Time, threshold int) error
+}
+
+// PotRepository stores the pots of accounts & moves money between them & their accounts
+type PotRepository interface {
+	CreatePot(ctx context.Context, params core.CreatePotParams) (core.Pot, error)
+	GetPot(ctx context.Context, potID uuid.UUID) (core.Pot, error)
+	GetAccountPots(ctx context.Context, accountID int64) ([]core.Pot, error)
+	GetUserPots(ctx context.Context, userID int64) ([]core.Pot, error)
+	UpdatePot(ctx context.Context, params core.UpdatePotParams) (core.Pot, error)
+	DeletePot(ctx context.Context, potID uuid.UUID) error
+	MovePotMoney(ctx context.Context, params core.MovePotMoneyParams) (core.Transaction, error)
+}
+
+// LedgerRepository verifies the stored balances against the ledger postings
+type LedgerRepository interface {
+	Reconcile(ctx context.Context) ([]core.LedgerMismatch, error)
+}
+
+// ExchangeRateProvider provides the current exchange rates between currencies
+// The rate is a decimal string of `to` currency units per one `from` currency unit
+type ExchangeRateProvider interface {
+	GetRate(ctx context.Context, from, to core.Currency) (string, error)
+}
+
+// UserDirectory finds the users registered in the auth service & their contact details
+type UserDirectory interface {
+	LookupUser(ctx context.Context, params core.LookupUserParams) (core.User, error)
+}
+
+// CardNumberGenerator is an interface for generating card numbers & CVVs
 type CardNumberGenerator interface {
 	GenCardNumber(ctx context.Context) (string, error)
+	GenCVV(ctx context.Context) (string, error)
+}
+
+// SecretHasher hashes the cards secrets, only their hashes are stored
+type SecretHasher interface {
+	Hash(ctx context.Context, secret string) (string, error)
+	Compare(ctx context.Context, hash, secret string) bool
+}
+
+// CardVault tokenizes & encrypts card numbers, cards are stored & referenced by their tokens
+type CardVault interface {
+	Token(ctx context.Context, number string) string
+	Encrypt(ctx context.Context, number string) (core.EncryptedCardNumber, error)
+	Decrypt(ctx context.Context, token string, encrypted core.EncryptedCardNumber) (string, error)
+}
+
+// StatementRenderer renders account statements in the supported formats
+type StatementRenderer interface {
+	Render(ctx context.Context, statement core.Statement, format core.StatementFormat) ([]byte, error)
+}
+
+// MessageProducer publishes the notifications sent to users by the contact service
+type MessageProducer interface {
+	SendPaymentRequestMessage(ctx context.Context, params core.PaymentRequestMessage) error
+	SendBudgetAlertMessage(ctx context.Context, params core.BudgetAlertMessage) error
 }
 
 // SmsSender is an interface for sending sms about transactions completion
@@ -0,0 +1,84 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type CreatePotParams struct {
+	AccountID    int64     `validate:"required,min=1"`
+	Name         string    `validate:"required,max=32"`
+	TargetAmount int64     `validate:"min=0"` // in minor units of the account's currency, zero for no target
+	TargetDate   time.Time // zero for no target date
+}
+
+type CreatePotCommand interface {
+	Execute(ctx context.Context, params CreatePotParams) (core.Pot, error)
+}
+
+type CreatePotCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	ptr PotRepository
+}
+
+// Execute creates an empty pot in the caller's account, money is then moved into it with `MovePotMoney`
+func (c *CreatePotCommandImpl) Execute(ctx context.Context, params CreatePotParams) (core.Pot, error) {
+	var pot core.Pot
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CreatePotCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		account, err := getHistoryAccount(ctx, c.ur, c.ar, params.AccountID)
+		if err != nil {
+			return err
+		}
+		if account.Status == core.AccountStatusClosed {
+			return errorAccountClosed
+		}
+		pot, err = c.ptr.CreatePot(ctx, core.CreatePotParams{
+			AccountID:    account.ID,
+			Name:         params.Name,
+			TargetAmount: params.TargetAmount,
+			TargetDate:   params.TargetDate,
+		})
+		if err != nil {
+			return err
+		}
+		pot.Currency = account.Currency
+		return nil
+	})
+	return pot, err
+}
+
+// getPotAccount returns the pot & its account, the caller must own the account
+func getPotAccount(ctx context.Context, ur UserRepository, ar AccountRepository, ptr PotRepository, id string) (core.Pot, core.Account, error) {
+	potID, err := uuid.Parse(id)
+	if err != nil {
+		return core.Pot{}, core.Account{}, errs.B().Code(errs.InvalidArgument).Msg("invalid pot id").Err()
+	}
+	pot, err := ptr.GetPot(ctx, potID)
+	if err != nil {
+		return core.Pot{}, core.Account{}, err
+	}
+	account, err := getHistoryAccount(ctx, ur, ar, pot.AccountID)
+	if err != nil {
+		return core.Pot{}, core.Account{}, err
+	}
+	pot.Currency = account.Currency
+	return pot, account, nil
+}
+
+func NewCreatePotCommand(v Validator, ur UserRepository, ar AccountRepository, ptr PotRepository) CreatePotCommand {
+	return &CreatePotCommandImpl{v: v, ur: ur, ar: ar, ptr: ptr}
+}
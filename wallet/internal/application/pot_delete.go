@@ -0,0 +1,49 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+)
+
+type DeletePotParams struct {
+	PotID string `validate:"required,uuid"`
+}
+
+type DeletePotCommand interface {
+	Execute(ctx context.Context, params DeletePotParams) error
+}
+
+type DeletePotCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	ptr PotRepository
+}
+
+// Execute deletes an empty pot of the caller, its moves are kept in the account's history
+func (c *DeletePotCommandImpl) Execute(ctx context.Context, params DeletePotParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "DeletePotCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		pot, account, err := getPotAccount(ctx, c.ur, c.ar, c.ptr, params.PotID)
+		if err != nil {
+			return err
+		}
+		// Don't delete the pot while money moves into it
+		unlock := c.l.Lock(ctx, account.ID)
+		defer unlock()
+		return c.ptr.DeletePot(ctx, pot.ID)
+	})
+}
+
+func NewDeletePotCommand(v Validator, l Locker, ur UserRepository, ar AccountRepository, ptr PotRepository) DeletePotCommand {
+	return &DeletePotCommandImpl{v: v, l: l, ur: ur, ar: ar, ptr: ptr}
+}
@@ -0,0 +1,87 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type MovePotMoneyParams struct {
+	PotID  string       `validate:"required,uuid"`
+	Amount int64        `validate:"required,min=1"` // in minor units of the account's currency
+	Move   core.PotMove `validate:"required,oneof=deposit withdraw"`
+}
+
+var errorNoSufficientPotFunds = errs.B().Code(errs.InvalidArgument).Msg("no sufficient pot balance to perform the move").Err()
+
+type MovePotMoneyCommand interface {
+	Execute(ctx context.Context, params MovePotMoneyParams) (core.Transaction, error)
+}
+
+type MovePotMoneyCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	ptr PotRepository
+}
+
+// Execute moves money instantly between the caller's account & one of its pots, the move is recorded as a pot transaction
+// Depositing into a pot spends from the account's available balance, withdrawing from it requires the account to receive money
+func (c *MovePotMoneyCommandImpl) Execute(ctx context.Context, params MovePotMoneyParams) (core.Transaction, error) {
+	var transaction core.Transaction
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "MovePotMoneyCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		pot, account, err := getPotAccount(ctx, c.ur, c.ar, c.ptr, params.PotID)
+		if err != nil {
+			return err
+		}
+		// Lock the account while checking the balances
+		unlock := c.l.Lock(ctx, account.ID)
+		defer unlock()
+		account, err = c.ar.GetAccount(ctx, account.ID)
+		if err != nil {
+			return err
+		}
+		pot, err = c.ptr.GetPot(ctx, pot.ID)
+		if err != nil {
+			return err
+		}
+		if params.Move == core.PotMoveDeposit {
+			if err = checkCanSend(account); err != nil {
+				return err
+			}
+			if account.AvailableBalance < params.Amount {
+				return errorNoSufficientFunds
+			}
+		} else {
+			if err = checkCanReceive(account); err != nil {
+				return err
+			}
+			if pot.Balance < params.Amount {
+				return errorNoSufficientPotFunds
+			}
+		}
+		transaction, err = c.ptr.MovePotMoney(ctx, core.MovePotMoneyParams{
+			PotID:     pot.ID,
+			AccountID: account.ID,
+			Amount:    params.Amount,
+			Move:      params.Move,
+		})
+		return err
+	})
+	return transaction, err
+}
+
+func NewMovePotMoneyCommand(v Validator, l Locker, ur UserRepository, ar AccountRepository, ptr PotRepository) MovePotMoneyCommand {
+	return &MovePotMoneyCommandImpl{v: v, l: l, ur: ur, ar: ar, ptr: ptr}
+}
@@ -0,0 +1,61 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type UpdatePotParams struct {
+	PotID        string    `validate:"required,uuid"`
+	Name         string    `validate:"required,max=32"`
+	TargetAmount int64     `validate:"min=0"` // in minor units of the account's currency, zero for no target
+	TargetDate   time.Time // zero for no target date
+}
+
+type UpdatePotCommand interface {
+	Execute(ctx context.Context, params UpdatePotParams) (core.Pot, error)
+}
+
+type UpdatePotCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	ptr PotRepository
+}
+
+// Execute renames a pot of the caller & replaces its target
+func (c *UpdatePotCommandImpl) Execute(ctx context.Context, params UpdatePotParams) (core.Pot, error) {
+	var pot core.Pot
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "UpdatePotCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		current, account, err := getPotAccount(ctx, c.ur, c.ar, c.ptr, params.PotID)
+		if err != nil {
+			return err
+		}
+		pot, err = c.ptr.UpdatePot(ctx, core.UpdatePotParams{
+			ID:           current.ID,
+			Name:         params.Name,
+			TargetAmount: params.TargetAmount,
+			TargetDate:   params.TargetDate,
+		})
+		if err != nil {
+			return err
+		}
+		pot.Currency = account.Currency
+		return nil
+	})
+	return pot, err
+}
+
+func NewUpdatePotCommand(v Validator, ur UserRepository, ar AccountRepository, ptr PotRepository) UpdatePotCommand {
+	return &UpdatePotCommandImpl{v: v, ur: ur, ar: ar, ptr: ptr}
+}
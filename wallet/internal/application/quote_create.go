@@ -0,0 +1,131 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type CreateQuoteParams struct {
+	Amount   int64         `validate:"required,min=1"` // in minor units of `Currency`
+	Currency core.Currency `validate:"required"`
+	FromCard string        `validate:"required,number,credit_card"`
+	// One of `ToCard`, `ToUsername` or `ToEmail` is set
+	ToCard     string        `validate:"omitempty,number,credit_card"`
+	ToUsername string        `validate:"omitempty,alphanum"`
+	ToEmail    string        `validate:"omitempty,email"`
+	ToCurrency core.Currency `validate:"required_without=ToCard"` // recipient's account currency when addressed by username or email
+}
+
+type CreateQuoteCommand interface {
+	Execute(ctx context.Context, params CreateQuoteParams) (core.Quote, error)
+}
+
+type CreateQuoteCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	qr  QuoteRepository
+	erp ExchangeRateProvider
+	ud  UserDirectory
+	cv  CardVault
+	qt  time.Duration // quotes time to live
+}
+
+func (c *CreateQuoteCommandImpl) Execute(ctx context.Context, params CreateQuoteParams) (core.Quote, error) {
+	var quote core.Quote
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CreateQuoteCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Reference the cards by their tokens
+		params.FromCard, params.ToCard = cardToken(ctx, c.cv, params.FromCard), cardToken(ctx, c.cv, params.ToCard)
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Get the cards' accounts
+		fromAccount, err := c.cr.GetCardAccount(ctx, params.FromCard)
+		if err != nil {
+			return err
+		}
+		// Check that the caller is the account from card owner
+		if innerID != fromAccount.OwnerID {
+			return errorNotAccountOwner
+		}
+		if params.Currency != fromAccount.Currency {
+			return errs.B().Code(errs.InvalidArgument).
+				Msgf("amount currency mismatch, amount currency: %s, account currency: %s", params.Currency, fromAccount.Currency).
+				Err()
+		}
+		toAccount, err := getRecipientAccount(ctx, c.ud, c.ar, c.cr, recipient{
+			Card:     params.ToCard,
+			Username: params.ToUsername,
+			Email:    params.ToEmail,
+			Currency: params.ToCurrency,
+		}, params.Currency)
+		if err != nil {
+			return err
+		}
+		if toAccount.Currency == fromAccount.Currency {
+			return errs.B().Code(errs.InvalidArgument).Msg("accounts have the same currency, no quote is needed").Err()
+		}
+		// Convert the amount with the current rate
+		rate, err := c.erp.GetRate(ctx, fromAccount.Currency, toAccount.Currency)
+		if err != nil {
+			return err
+		}
+		toAmount, ok := core.ConvertAmount(params.Amount, rate, fromAccount.MinorUnits, toAccount.MinorUnits)
+		if !ok {
+			return errs.B().Code(errs.Internal).Msgf("invalid exchange rate %s", rate).Err()
+		}
+		if toAmount < 1 {
+			return errs.B().Code(errs.InvalidArgument).Msg("amount is too small to be converted").Err()
+		}
+		// Lock the rate
+		quote, err = c.qr.CreateQuote(ctx, core.CreateQuoteParams{
+			UserID:        innerID,
+			FromAccountID: fromAccount.ID,
+			ToAccountID:   toAccount.ID,
+			Rate:          rate,
+			Amount:        params.Amount,
+			ToAmount:      toAmount,
+			ExpiresAt:     time.Now().UTC().Add(c.qt),
+		})
+		if err != nil {
+			return err
+		}
+		quote.Currency = fromAccount.Currency
+		quote.ToCurrency = toAccount.Currency
+		return nil
+	})
+	return quote, err
+}
+
+func NewCreateQuoteCommand(
+	v Validator,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	qr QuoteRepository,
+	erp ExchangeRateProvider,
+	ud UserDirectory,
+	cv CardVault,
+	qt time.Duration,
+) CreateQuoteCommand {
+	return &CreateQuoteCommandImpl{v: v, ur: ur, ar: ar, cr: cr, qr: qr, erp: erp, ud: ud, cv: cv, qt: qt}
+}
@@ -0,0 +1,63 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type GetSpendingSummaryParams struct {
+	AccountID int64     `validate:"required,min=1"`
+	From      time.Time `validate:"required"`              // inclusive
+	To        time.Time `validate:"required,gtfield=From"` // exclusive
+}
+
+var errorSpendingPeriodTooLong = errs.B().Code(errs.InvalidArgument).Msg("spending summary period can't exceed 366 days").Err()
+
+type GetSpendingSummaryCommand interface {
+	Execute(ctx context.Context, params GetSpendingSummaryParams) (core.SpendingSummary, error)
+}
+
+type GetSpendingSummaryCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	tr TransactionRepository
+}
+
+// Execute returns what the caller's account spent by category over the period, net of the refunds & reversals
+// Outgoing transfers & withdrawals are spending, each in the account's category or else in the suggested one
+func (c *GetSpendingSummaryCommandImpl) Execute(ctx context.Context, params GetSpendingSummaryParams) (core.SpendingSummary, error) {
+	var summary core.SpendingSummary
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetSpendingSummaryCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		if params.To.Sub(params.From) > statementMaxPeriod {
+			return errorSpendingPeriodTooLong
+		}
+		account, err := getHistoryAccount(ctx, c.ur, c.ar, params.AccountID)
+		if err != nil {
+			return err
+		}
+		from, to := params.From.UTC(), params.To.UTC()
+		categories, err := c.tr.GetSpendingByCategory(ctx, account.ID, from, to)
+		if err != nil {
+			return err
+		}
+		summary = core.NewSpendingSummary(account, from, to, categories)
+		return nil
+	})
+	return summary, err
+}
+
+func NewGetSpendingSummaryCommand(v Validator, ur UserRepository, ar AccountRepository, tr TransactionRepository) GetSpendingSummaryCommand {
+	return &GetSpendingSummaryCommandImpl{v: v, ur: ur, ar: ar, tr: tr}
+}
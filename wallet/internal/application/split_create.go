@@ -0,0 +1,141 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type SplitParticipantParams struct {
+	Username    string `validate:"omitempty,alphanum"` // participants set one of `Username` or `Email`
+	Email       string `validate:"omitempty,email"`
+	BasisPoints int64  `validate:"min=0,max=10000"` // share on percentage splits, 1 basis point is 0.01%
+	Amount      int64  `validate:"min=0"`           // share on amount splits, in minor units of the split's currency
+}
+
+type CreateSplitParams struct {
+	Amount           int64                    `validate:"required,min=1"` // in minor units of `Currency`
+	Currency         core.Currency            `validate:"required"`
+	Method           core.SplitMethod         `validate:"required,oneof=equal percentage amount"`
+	Participants     []SplitParticipantParams `validate:"required,min=1,max=50,dive"`
+	IncludeRequester bool                     // the requester takes a share & keeps what isn't requested
+	ToCard           string                   `validate:"omitempty,number,credit_card"` // defaults to the requester's receiving account in `Currency`
+	Note             string                   `validate:"max=256"`
+}
+
+var errorSplitParticipantRepeated = errs.B().Code(errs.InvalidArgument).Msg("split participants must be distinct users").Err()
+
+type CreateSplitCommand interface {
+	Execute(ctx context.Context, params CreateSplitParams) (core.Split, error)
+}
+
+type CreateSplitCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	spr SplitRepository
+	ud  UserDirectory
+	mp  MessageProducer
+	cv  CardVault
+	prt time.Duration // payment requests time to live
+}
+
+// Execute splits a bill between the participants on behalf of the caller & notifies each participant by email
+// Each share is a payment request to the participant, the money is received on `ToCard` or on the caller's receiving account in the currency
+func (c *CreateSplitCommandImpl) Execute(ctx context.Context, params CreateSplitParams) (core.Split, error) {
+	var split core.Split
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CreateSplitCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Reference the cards by their tokens
+		params.ToCard = cardToken(ctx, c.cv, params.ToCard)
+		weights := make([]int64, len(params.Participants))
+		for i, p := range params.Participants {
+			if (p.Username == "") == (p.Email == "") {
+				return errorPayerNotSet
+			}
+			switch params.Method {
+			case core.SplitMethodPercentage:
+				weights[i] = p.BasisPoints
+			case core.SplitMethodAmount:
+				weights[i] = p.Amount
+			}
+		}
+		amounts, err := core.SplitShares(params.Amount, params.Method, weights, params.IncludeRequester)
+		if err != nil {
+			return errs.B(err).Code(errs.InvalidArgument).Msgf("invalid split: %s", err).Err()
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Find the participants in the auth service & their wallets
+		shares := make([]core.SplitShare, len(params.Participants))
+		payers := make(map[int64]core.User, len(params.Participants))
+		for i, p := range params.Participants {
+			payer, payerID, err := lookupPayer(ctx, c.ud, c.ur, userID, p.Username, p.Email)
+			if err != nil {
+				return err
+			}
+			if _, ok := payers[payerID]; ok {
+				return errorSplitParticipantRepeated
+			}
+			payers[payerID] = payer
+			shares[i] = core.SplitShare{PayerID: payerID, Amount: amounts[i]}
+		}
+		// Get the account receiving the money
+		toAccount, err := getRequestAccount(ctx, c.ar, c.cr, userID, innerID, params.ToCard, params.Currency)
+		if err != nil {
+			return err
+		}
+		split, err = c.spr.CreateSplit(ctx, core.CreateSplitParams{
+			RequesterID: innerID,
+			ToAccountID: toAccount.ID,
+			Amount:      params.Amount,
+			Currency:    params.Currency,
+			Method:      params.Method,
+			Note:        params.Note,
+			Shares:      shares,
+			ExpiresAt:   time.Now().UTC().Add(c.prt),
+		})
+		if err != nil {
+			return err
+		}
+		notified := make([]core.User, len(split.Shares))
+		for i, share := range split.Shares {
+			notified[i] = payers[share.PayerID]
+		}
+		notifyPayers(ctx, c.ud, c.mp, userID, split.Shares, notified, toAccount.MinorUnits)
+		return nil
+	})
+	return split, err
+}
+
+func NewCreateSplitCommand(
+	v Validator,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	spr SplitRepository,
+	ud UserDirectory,
+	mp MessageProducer,
+	cv CardVault,
+	prt time.Duration,
+) CreateSplitCommand {
+	return &CreateSplitCommandImpl{v: v, ur: ur, ar: ar, cr: cr, spr: spr, ud: ud, mp: mp, cv: cv, prt: prt}
+}
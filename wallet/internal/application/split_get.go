@@ -0,0 +1,110 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type GetSplitParams struct {
+	SplitID string `validate:"required,uuid"`
+}
+
+type GetSplitCommand interface {
+	Execute(ctx context.Context, params GetSplitParams) (core.Split, error)
+}
+
+type GetSplitCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	spr SplitRepository
+}
+
+// Execute returns a split with its shares, only to its requester & participants
+func (c *GetSplitCommandImpl) Execute(ctx context.Context, params GetSplitParams) (core.Split, error) {
+	var split core.Split
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetSplitCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse split id
+		splitID, err := uuid.Parse(params.SplitID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid split id").Err()
+		}
+		split, err = c.spr.GetSplit(ctx, splitID)
+		if err != nil {
+			return err
+		}
+		if _, ok := split.ShareOf(innerID); !ok && innerID != split.RequesterID {
+			return errorNotSplitParticipant
+		}
+		return nil
+	})
+	return split, err
+}
+
+func NewGetSplitCommand(v Validator, ur UserRepository, spr SplitRepository) GetSplitCommand {
+	return &GetSplitCommandImpl{v: v, ur: ur, spr: spr}
+}
+
+type GetSplitsParams struct{}
+
+type GetSplitsCommand interface {
+	Execute(ctx context.Context, params GetSplitsParams) ([]core.Split, error)
+}
+
+type GetSplitsCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	spr SplitRepository
+}
+
+// Execute returns the splits created by the caller, newest first
+func (c *GetSplitsCommandImpl) Execute(ctx context.Context, params GetSplitsParams) ([]core.Split, error) {
+	var splits []core.Split
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetSplitsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		splits, err = c.spr.GetSplits(ctx, innerID)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return splits, err
+}
+
+func NewGetSplitsCommand(v Validator, ur UserRepository, spr SplitRepository) GetSplitsCommand {
+	return &GetSplitsCommandImpl{v: v, ur: ur, spr: spr}
+}
@@ -0,0 +1,76 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type SettleSplitParams struct {
+	SplitID  string `validate:"required,uuid"`
+	FromCard string `validate:"required,number,credit_card"`
+}
+
+var errorNotSplitParticipant = errs.B().Code(errs.Forbidden).Msg("not a participant of the split").Err()
+
+type SettleSplitCommand interface {
+	Execute(ctx context.Context, params SettleSplitParams) (core.Transaction, error)
+}
+
+type SettleSplitCommandImpl struct {
+	v   Validator
+	ur  UserRepository
+	spr SplitRepository
+	ppr PayPaymentRequestCommand
+}
+
+// Execute pays the caller's share of the split from `FromCard`, through the payment request of the share
+func (c *SettleSplitCommandImpl) Execute(ctx context.Context, params SettleSplitParams) (core.Transaction, error) {
+	var transaction core.Transaction
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "SettleSplitCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse split id
+		splitID, err := uuid.Parse(params.SplitID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid split id").Err()
+		}
+		split, err := c.spr.GetSplit(ctx, splitID)
+		if err != nil {
+			return err
+		}
+		share, ok := split.ShareOf(innerID)
+		if !ok {
+			return errorNotSplitParticipant
+		}
+		transaction, err = c.ppr.Execute(ctx, PayPaymentRequestParams{RequestID: share.ID.String(), FromCard: params.FromCard})
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return transaction, err
+}
+
+func NewSettleSplitCommand(v Validator, ur UserRepository, spr SplitRepository, ppr PayPaymentRequestCommand) SettleSplitCommand {
+	return &SettleSplitCommandImpl{v: v, ur: ur, spr: spr, ppr: ppr}
+}
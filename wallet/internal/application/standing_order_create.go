@@ -0,0 +1,149 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type CreateStandingOrderParams struct {
+	FromCard   string        `validate:"required,number,credit_card"`
+	ToCard     string        `validate:"required,number,credit_card"`
+	Amount     int64         `validate:"required,min=1"` // in minor units of `Currency`
+	Currency   core.Currency `validate:"required"`
+	Cron       string        `validate:"omitempty,max=128"` // either `Cron` or `DayOfMonth` must be set
+	DayOfMonth int           `validate:"min=0,max=31"`
+	EndDate    time.Time     // zero for no end date
+}
+
+var (
+	errorNotStandingOrderOwner = errs.B().Code(errs.Forbidden).Msg("not standing order owner").Err()
+	errorStandingOrderNoRun    = errs.B().Code(errs.InvalidArgument).Msg("schedule has no run before the end date").Err()
+)
+
+type CreateStandingOrderCommand interface {
+	Execute(ctx context.Context, params CreateStandingOrderParams) (core.StandingOrder, error)
+}
+
+type CreateStandingOrderCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	cr CardRepository
+	sr StandingOrderRepository
+	cv CardVault
+}
+
+// Execute schedules a transfer from the caller's card to the recipient card, repeated until the end date
+// Both cards must be of the same currency since no exchange rate can be locked ahead of the runs
+func (c *CreateStandingOrderCommandImpl) Execute(ctx context.Context, params CreateStandingOrderParams) (core.StandingOrder, error) {
+	var order core.StandingOrder
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "CreateStandingOrderCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Reference the cards by their tokens
+		params.FromCard, params.ToCard = cardToken(ctx, c.cv, params.FromCard), cardToken(ctx, c.cv, params.ToCard)
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Check the cards the money moves between
+		err = checkStandingOrderCards(ctx, c.cr, innerID, params.FromCard, params.ToCard, params.Currency)
+		if err != nil {
+			return err
+		}
+		schedule, nextRunAt, err := nextStandingOrderRun(params.Cron, params.DayOfMonth, params.EndDate)
+		if err != nil {
+			return err
+		}
+		order, err = c.sr.CreateStandingOrder(ctx, core.CreateStandingOrderParams{
+			UserID:    innerID,
+			FromCard:  params.FromCard,
+			ToCard:    params.ToCard,
+			Amount:    params.Amount,
+			Currency:  params.Currency,
+			Schedule:  schedule,
+			EndDate:   params.EndDate.UTC(),
+			NextRunAt: nextRunAt,
+		})
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return core.StandingOrder{}, err
+	}
+	return order, nil
+}
+
+func NewCreateStandingOrderCommand(
+	v Validator,
+	ur UserRepository,
+	cr CardRepository,
+	sr StandingOrderRepository,
+	cv CardVault,
+) CreateStandingOrderCommand {
+	return &CreateStandingOrderCommandImpl{v: v, ur: ur, cr: cr, sr: sr, cv: cv}
+}
+
+// checkStandingOrderCards checks that the user owns `fromCard`, that the amount is in its currency
+// and that `toCard` is another account of the same currency
+func checkStandingOrderCards(ctx context.Context, cr CardRepository, userID int64, fromCard, toCard string, currency core.Currency) error {
+	fromAccount, err := cr.GetCardAccount(ctx, fromCard)
+	if err != nil {
+		return err
+	}
+	if userID != fromAccount.OwnerID {
+		return errorNotAccountOwner
+	}
+	// Check that the card can be used
+	if _, err = getUsableCard(ctx, cr, fromCard); err != nil {
+		return err
+	}
+	if currency != fromAccount.Currency {
+		return errs.B().Code(errs.InvalidArgument).
+			Msgf("amount currency mismatch, amount currency: %s, account currency: %s", currency, fromAccount.Currency).
+			Err()
+	}
+	toAccount, err := cr.GetCardAccount(ctx, toCard)
+	if err != nil {
+		return err
+	}
+	if toAccount.ID == fromAccount.ID {
+		return errs.B().Code(errs.InvalidArgument).Msg("cannot transfer to the same account").Err()
+	}
+	if toAccount.Currency != fromAccount.Currency {
+		return errs.B().Code(errs.InvalidArgument).
+			Msgf("standing orders between different currencies aren't supported, from currency: %s, to currency: %s", fromAccount.Currency, toAccount.Currency).
+			Err()
+	}
+	return nil
+}
+
+// nextStandingOrderRun parses the schedule and returns its first run from now on, before the end date
+func nextStandingOrderRun(cron string, dayOfMonth int, endDate time.Time) (core.Schedule, time.Time, error) {
+	schedule, err := core.ParseSchedule(cron, dayOfMonth)
+	if err != nil {
+		return core.Schedule{}, time.Time{}, errs.B(err).Code(errs.InvalidArgument).Msgf("invalid schedule: %s", err).Err()
+	}
+	now := time.Now().UTC()
+	next, ok := core.StandingOrder{Schedule: schedule, EndDate: endDate.UTC()}.NextRun(now, now)
+	if !ok {
+		return core.Schedule{}, time.Time{}, errorStandingOrderNoRun
+	}
+	return schedule, next, nil
+}
@@ -0,0 +1,72 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type DeleteStandingOrderParams struct {
+	StandingOrderID string `validate:"required,uuid"`
+}
+
+type DeleteStandingOrderCommand interface {
+	Execute(ctx context.Context, params DeleteStandingOrderParams) error
+}
+
+type DeleteStandingOrderCommandImpl struct {
+	v  Validator
+	l  Locker
+	ur UserRepository
+	sr StandingOrderRepository
+}
+
+// Execute cancels an active standing order of the caller, its execution history is kept
+func (c *DeleteStandingOrderCommandImpl) Execute(ctx context.Context, params DeleteStandingOrderParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "DeleteStandingOrderCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse standing order id
+		orderID, err := uuid.Parse(params.StandingOrderID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid standing order id").Err()
+		}
+		// Don't cancel an order while one of its runs is executed
+		unlock := c.l.Lock(ctx, orderID.String())
+		defer unlock()
+		order, err := c.sr.GetStandingOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if innerID != order.UserID {
+			return errorNotStandingOrderOwner
+		}
+		err = c.sr.CancelStandingOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func NewDeleteStandingOrderCommand(v Validator, l Locker, ur UserRepository, sr StandingOrderRepository) DeleteStandingOrderCommand {
+	return &DeleteStandingOrderCommandImpl{v: v, l: l, ur: ur, sr: sr}
+}
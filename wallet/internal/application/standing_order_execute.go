@@ -0,0 +1,170 @@
+package application
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+const (
+	// standingOrdersBatchSize is the max number of due standing orders executed on each run of the job
+	standingOrdersBatchSize = 100
+	// failureReasonMaxLength is the size of the executions failure_reason column
+	failureReasonMaxLength = 256
+)
+
+type ExecuteStandingOrdersParams struct{}
+
+type ExecuteStandingOrdersCommand interface {
+	Execute(ctx context.Context, params ExecuteStandingOrdersParams) (int, error)
+}
+
+type ExecuteStandingOrdersCommandImpl struct {
+	v  Validator
+	l  Locker
+	cr CardRepository
+	sr StandingOrderRepository
+	cv CardVault
+	ct CreateTransactionCommand
+}
+
+// Execute runs the due standing orders as transfers made by their owners and returns how many runs were recorded
+// Runs failing for a reason the owner must fix, e.g. insufficient funds, are recorded as failed and the order moves on,
+// runs failing on internal errors are retried on the next call
+func (c *ExecuteStandingOrdersCommandImpl) Execute(ctx context.Context, params ExecuteStandingOrdersParams) (int, error) {
+	var executed int
+	err := contextutils.ExecuteWithContextTimeout(ctx, 1*time.Minute, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "ExecuteStandingOrdersCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		orders, err := c.sr.GetDueStandingOrders(ctx, now, standingOrdersBatchSize)
+		if err != nil {
+			return err
+		}
+		// Keep executing the other orders when one fails, the first error is returned
+		var firstErr error
+		for _, order := range orders {
+			recorded, err := c.execute(ctx, order, now)
+			if err != nil && firstErr == nil {
+				firstErr = err
+			}
+			if recorded {
+				executed++
+			}
+		}
+		return firstErr
+	})
+	return executed, err
+}
+
+// execute runs one due standing order and records its outcome
+func (c *ExecuteStandingOrdersCommandImpl) execute(ctx context.Context, order core.StandingOrder, now time.Time) (bool, error) {
+	// Lock the order so that it's not updated, cancelled or executed twice concurrently
+	unlock := c.l.Lock(ctx, order.ID.String())
+	defer unlock()
+	// Skip the run if it was already executed or the order was changed meanwhile
+	current, err := c.sr.GetStandingOrder(ctx, order.ID)
+	if err != nil {
+		return false, err
+	}
+	if current.Status != core.StandingOrderStatusActive || !current.NextRunAt.Equal(order.NextRunAt) {
+		return false, nil
+	}
+	order.Amount = current.Amount
+	order.Schedule = current.Schedule
+	order.EndDate = current.EndDate
+	// Transfer as the order's owner, the key derived from the run makes retries after a crash replay the transfer
+	execution := core.RecordStandingOrderExecutionParams{
+		StandingOrderID: order.ID,
+		ScheduledAt:     order.NextRunAt,
+		Status:          core.StandingOrderExecutionStatusSucceeded,
+	}
+	transaction, err := c.transfer(ctx, order)
+	if err != nil {
+		if !isPermanentError(err) {
+			return false, err
+		}
+		execution.Status = core.StandingOrderExecutionStatusFailed
+		execution.FailureReason = failureReason(err)
+	}
+	execution.TransactionID = transaction.ID
+	// A zero next run completes the order
+	execution.NextRunAt, _ = order.NextRun(order.NextRunAt, now)
+	_, err = c.sr.RecordExecution(ctx, execution)
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *ExecuteStandingOrdersCommandImpl) transfer(ctx context.Context, order core.StandingOrder) (core.Transaction, error) {
+	fromAccount, err := c.cr.GetCardAccount(ctx, order.FromCard)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	// The order references its cards by their tokens, the transfer is made with their numbers
+	fromCard, err := getCardNumber(ctx, c.cr, c.cv, order.FromCard)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	toCard, err := getCardNumber(ctx, c.cr, c.cv, order.ToCard)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	ctx = contextutils.SetUserID(ctx, order.UserExternalID.String())
+	return c.ct.Execute(ctx, CreateTransactionParams{
+		Amount:         order.Amount,
+		Currency:       fromAccount.Currency,
+		Type:           core.TransactionTypeTransfer,
+		FromCard:       fromCard,
+		ToCard:         toCard,
+		IdempotencyKey: uuid.NewSHA1(order.ID, []byte(order.NextRunAt.UTC().Format(time.RFC3339))).String(),
+	})
+}
+
+// isPermanentError reports whether retrying the request can't succeed until the caller changes something
+func isPermanentError(err error) bool {
+	errErrs, ok := err.(*errs.Error)
+	if !ok {
+		return false
+	}
+	switch errErrs.Code {
+	case errs.InvalidArgument, errs.NotFound, errs.Forbidden, errs.FailedPrecondition:
+		return true
+	default:
+		return false
+	}
+}
+
+// failureReason returns the user facing message of the error, cut to fit the executions history
+func failureReason(err error) string {
+	reason := err.Error()
+	if errErrs, ok := err.(*errs.Error); ok {
+		reason = strings.Join(errErrs.Msg, ": ")
+	}
+	if len(reason) > failureReasonMaxLength {
+		reason = reason[:failureReasonMaxLength]
+	}
+	return reason
+}
+
+func NewExecuteStandingOrdersCommand(
+	v Validator,
+	l Locker,
+	cr CardRepository,
+	sr StandingOrderRepository,
+	cv CardVault,
+	ct CreateTransactionCommand,
+) ExecuteStandingOrdersCommand {
+	return &ExecuteStandingOrdersCommandImpl{v: v, l: l, cr: cr, sr: sr, cv: cv, ct: ct}
+}
@@ -0,0 +1,55 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type GetStandingOrdersParams struct{}
+
+type GetStandingOrdersCommand interface {
+	Execute(ctx context.Context, params GetStandingOrdersParams) ([]core.StandingOrder, error)
+}
+
+type GetStandingOrdersCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	sr StandingOrderRepository
+}
+
+// Execute returns all the standing orders of the caller, completed & cancelled ones included
+func (c *GetStandingOrdersCommandImpl) Execute(ctx context.Context, params GetStandingOrdersParams) ([]core.StandingOrder, error) {
+	var orders []core.StandingOrder
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetStandingOrdersCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Get user standing orders
+		orders, err = c.sr.GetStandingOrders(ctx, innerID)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return orders, err
+}
+
+func NewGetStandingOrdersCommand(v Validator, ur UserRepository, sr StandingOrderRepository) GetStandingOrdersCommand {
+	return &GetStandingOrdersCommandImpl{v: v, ur: ur, sr: sr}
+}
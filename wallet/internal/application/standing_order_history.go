@@ -0,0 +1,70 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type GetStandingOrderExecutionsParams struct {
+	StandingOrderID string `validate:"required,uuid"`
+}
+
+type GetStandingOrderExecutionsCommand interface {
+	Execute(ctx context.Context, params GetStandingOrderExecutionsParams) ([]core.StandingOrderExecution, error)
+}
+
+type GetStandingOrderExecutionsCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	sr StandingOrderRepository
+}
+
+// Execute returns the runs of a standing order of the caller with their outcome, the latest run first
+func (c *GetStandingOrderExecutionsCommandImpl) Execute(ctx context.Context, params GetStandingOrderExecutionsParams) ([]core.StandingOrderExecution, error) {
+	var executions []core.StandingOrderExecution
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetStandingOrderExecutionsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Get user external id
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse standing order id
+		orderID, err := uuid.Parse(params.StandingOrderID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid standing order id").Err()
+		}
+		order, err := c.sr.GetStandingOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if innerID != order.UserID {
+			return errorNotStandingOrderOwner
+		}
+		executions, err = c.sr.GetExecutions(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	return executions, err
+}
+
+func NewGetStandingOrderExecutionsCommand(v Validator, ur UserRepository, sr StandingOrderRepository) GetStandingOrderExecutionsCommand {
+	return &GetStandingOrderExecutionsCommandImpl{v: v, ur: ur, sr: sr}
+}
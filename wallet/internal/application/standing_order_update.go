@@ -0,0 +1,106 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type UpdateStandingOrderParams struct {
+	StandingOrderID string        `validate:"required,uuid"`
+	Amount          int64         `validate:"required,min=1"` // in minor units of `Currency`
+	Currency        core.Currency `validate:"required"`
+	Cron            string        `validate:"omitempty,max=128"` // either `Cron` or `DayOfMonth` must be set
+	DayOfMonth      int           `validate:"min=0,max=31"`
+	EndDate         time.Time     // zero for no end date
+}
+
+type UpdateStandingOrderCommand interface {
+	Execute(ctx context.Context, params UpdateStandingOrderParams) (core.StandingOrder, error)
+}
+
+type UpdateStandingOrderCommandImpl struct {
+	v  Validator
+	l  Locker
+	ur UserRepository
+	cr CardRepository
+	sr StandingOrderRepository
+}
+
+// Execute replaces the amount, schedule & end date of an active standing order of the caller
+// The order is rescheduled to the first run of the new schedule from now on
+func (c *UpdateStandingOrderCommandImpl) Execute(ctx context.Context, params UpdateStandingOrderParams) (core.StandingOrder, error) {
+	var order core.StandingOrder
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "UpdateStandingOrderCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse standing order id
+		orderID, err := uuid.Parse(params.StandingOrderID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid standing order id").Err()
+		}
+		// Don't reschedule an order while one of its runs is executed
+		unlock := c.l.Lock(ctx, orderID.String())
+		defer unlock()
+		order, err = c.sr.GetStandingOrder(ctx, orderID)
+		if err != nil {
+			return err
+		}
+		if innerID != order.UserID {
+			return errorNotStandingOrderOwner
+		}
+		// Check the cards again in case they changed hands or were deleted
+		err = checkStandingOrderCards(ctx, c.cr, innerID, order.FromCard, order.ToCard, params.Currency)
+		if err != nil {
+			return err
+		}
+		schedule, nextRunAt, err := nextStandingOrderRun(params.Cron, params.DayOfMonth, params.EndDate)
+		if err != nil {
+			return err
+		}
+		order, err = c.sr.UpdateStandingOrder(ctx, core.UpdateStandingOrderParams{
+			ID:        orderID,
+			Amount:    params.Amount,
+			Schedule:  schedule,
+			EndDate:   params.EndDate.UTC(),
+			NextRunAt: nextRunAt,
+		})
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return core.StandingOrder{}, err
+	}
+	return order, nil
+}
+
+func NewUpdateStandingOrderCommand(
+	v Validator,
+	l Locker,
+	ur UserRepository,
+	cr CardRepository,
+	sr StandingOrderRepository,
+) UpdateStandingOrderCommand {
+	return &UpdateStandingOrderCommandImpl{v: v, l: l, ur: ur, cr: cr, sr: sr}
+}
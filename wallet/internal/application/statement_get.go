@@ -0,0 +1,105 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type GetStatementParams struct {
+	AccountID int64                `validate:"required,min=1"`
+	From      time.Time            `validate:"required"`              // inclusive
+	To        time.Time            `validate:"required,gtfield=From"` // exclusive
+	Format    core.StatementFormat `validate:"required,oneof=csv ofx pdf"`
+}
+
+// statementMaxPeriod is the longest period covered by a statement
+const statementMaxPeriod = 366 * 24 * time.Hour
+
+var (
+	errorStatementPeriodTooLong = errs.B().Code(errs.InvalidArgument).Msg("statement period can't exceed 366 days").Err()
+	errorStatementInFuture      = errs.B().Code(errs.InvalidArgument).Msg("statement period must start in the past").Err()
+)
+
+type GetStatementCommand interface {
+	Execute(ctx context.Context, params GetStatementParams) (core.StatementFile, error)
+}
+
+type GetStatementCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	tr TransactionRepository
+	sr StatementRenderer
+}
+
+// Execute renders the statement of the caller's account over the period, periods ending in the future end now
+// The opening balance is the account's balance right before the period, each transaction comes with the running balance
+func (c *GetStatementCommandImpl) Execute(ctx context.Context, params GetStatementParams) (core.StatementFile, error) {
+	var file core.StatementFile
+	err := contextutils.ExecuteWithContextTimeout(ctx, 30*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "GetStatementCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		if params.To.Sub(params.From) > statementMaxPeriod {
+			return errorStatementPeriodTooLong
+		}
+		now := time.Now().UTC()
+		if !params.From.Before(now) {
+			return errorStatementInFuture
+		}
+		from, to := params.From.UTC(), params.To.UTC()
+		if to.After(now) {
+			to = now
+		}
+		account, err := getHistoryAccount(ctx, c.ur, c.ar, params.AccountID)
+		if err != nil {
+			return err
+		}
+		opening, err := c.ar.GetAccountBalanceAt(ctx, account.ID, from)
+		if err != nil {
+			return err
+		}
+		// Read the period's transactions newest first, then reverse them to compute the running balance
+		var transactions []core.Transaction
+		filters := TransactionHistoryFilters{AccountID: account.ID, From: from, To: to}
+		for after := (core.TransactionCursor{}); ; {
+			page, err := getTransactionPage(ctx, c.tr, filters, after, historyStreamPageSize)
+			if err != nil {
+				return err
+			}
+			transactions = append(transactions, page.Transactions...)
+			if page.Next.IsZero() {
+				break
+			}
+			after = page.Next
+		}
+		for i, j := 0, len(transactions)-1; i < j; i, j = i+1, j-1 {
+			transactions[i], transactions[j] = transactions[j], transactions[i]
+		}
+		statement := core.NewStatement(account, from, to, opening, transactions)
+		statement.CreatedAt = now
+		content, err := c.sr.Render(ctx, statement, params.Format)
+		if err != nil {
+			return errs.B(err).Code(errs.Internal).Msg("failed to render statement").Err()
+		}
+		file = core.StatementFile{
+			Name:        statement.FileName(params.Format),
+			ContentType: params.Format.ContentType(),
+			Content:     content,
+		}
+		return nil
+	})
+	return file, err
+}
+
+func NewGetStatementCommand(v Validator, ur UserRepository, ar AccountRepository, tr TransactionRepository, sr StatementRenderer) GetStatementCommand {
+	return &GetStatementCommandImpl{v: v, ur: ur, ar: ar, tr: tr, sr: sr}
+}
@@ -0,0 +1,236 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
+)
+
+type BatchTransferItemParams struct {
+	ToCard    string `validate:"required,number,credit_card"`
+	Amount    int64  `validate:"required,min=1"` // in minor units of the batch's `Currency`
+	Reference string `validate:"max=256"`        // stored as the reason of the transfer
+}
+
+type BatchTransferParams struct {
+	FromCard string                    `validate:"required,number,credit_card"`
+	Currency core.Currency             `validate:"required"`
+	Mode     core.BatchMode            `validate:"required,oneof=all_or_nothing best_effort"`
+	Items    []BatchTransferItemParams `validate:"required,min=1,max=1000,dive"`
+}
+
+var (
+	errorBatchSameAccount   = errs.B().Code(errs.InvalidArgument).Msg("cannot transfer to the same account").Err()
+	errorSingleUseCardBatch = errs.B().Code(errs.FailedPrecondition).Msg("single-use cards can't pay batches").Err()
+)
+
+type BatchTransferCommand interface {
+	Execute(ctx context.Context, params BatchTransferParams) (core.BatchTransfer, error)
+}
+
+type BatchTransferCommandImpl struct {
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	tr  TransactionRepository
+	lmr LimitRepository
+	cv  CardVault
+}
+
+// Execute transfers money from the caller's card to each item's card, in the items order & in the card's currency only
+// All the involved accounts are locked at once in the locker's order, so that batches never deadlock with concurrent transfers
+// Items failing validation, exceeding the remaining available balance or the transfer limits fail,
+// in all-or-nothing mode the others are then skipped
+func (c *BatchTransferCommandImpl) Execute(ctx context.Context, params BatchTransferParams) (core.BatchTransfer, error) {
+	batch := core.BatchTransfer{Mode: params.Mode}
+	err := contextutils.ExecuteWithContextTimeout(ctx, 30*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "BatchTransferCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Reference the cards by their tokens
+		params.FromCard = cardToken(ctx, c.cv, params.FromCard)
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Check that the caller owns the card & that it's in the batch's currency
+		fromAccount, err := c.cr.GetCardAccount(ctx, params.FromCard)
+		if err != nil {
+			return err
+		}
+		if innerID != fromAccount.OwnerID {
+			return errorNotAccountOwner
+		}
+		// Check that the card can be used, single-use cards are blocked by the first transfer
+		card, err := getUsableCard(ctx, c.cr, params.FromCard)
+		if err != nil {
+			return err
+		}
+		if card.Kind == core.CardKindSingleUse {
+			return errorSingleUseCardBatch
+		}
+		if params.Currency != fromAccount.Currency {
+			return errs.B().Code(errs.InvalidArgument).
+				Msgf("amount currency mismatch, amount currency: %s, account currency: %s", params.Currency, fromAccount.Currency).
+				Err()
+		}
+		// Get the recipients' accounts, invalid items fail without failing the batch
+		batch.Items = make([]core.BatchTransferItem, len(params.Items))
+		toAccountIDs := make([]int64, len(params.Items))
+		keys := make([]any, 0, len(params.Items))
+		for i, item := range params.Items {
+			item.ToCard = cardToken(ctx, c.cv, item.ToCard)
+			batch.Items[i] = core.BatchTransferItem{ToCard: item.ToCard, Amount: item.Amount, Reference: item.Reference}
+			toAccount, err := c.batchRecipient(ctx, fromAccount, item)
+			if err != nil {
+				failBatchItem(&batch.Items[i], err)
+				continue
+			}
+			toAccountIDs[i] = toAccount.ID
+			keys = append(keys, toAccount.ID)
+		}
+		// Lock the source & all the recipients' accounts, then check the available balance
+		unlock := c.l.Lock(ctx, fromAccount.ID, keys...)
+		defer unlock()
+		fromAccount, err = c.ar.GetAccount(ctx, fromAccount.ID)
+		if err != nil {
+			return err
+		}
+		if err = checkCanSend(fromAccount); err != nil {
+			return err
+		}
+		// Re-read the recipients' accounts under the lock too, they may have been frozen or closed meanwhile
+		for i := range params.Items {
+			if batch.Items[i].Status == core.BatchItemStatusFailed {
+				continue
+			}
+			toAccount, err := c.ar.GetAccount(ctx, toAccountIDs[i])
+			if err != nil {
+				return err
+			}
+			if err = checkCanReceive(toAccount); err != nil {
+				failBatchItem(&batch.Items[i], err)
+			}
+		}
+		limits, err := getSpendingLimits(ctx, c.lmr, core.TransactionTypeTransfer, fromAccount.ID, params.FromCard)
+		if err != nil {
+			return err
+		}
+		var debited int64 // total already debited with the card, checked against its spending cap
+		if card.SpendingCap > 0 {
+			debited, err = c.cr.GetCardDebited(ctx, card.Number)
+			if err != nil {
+				return err
+			}
+		}
+		available := fromAccount.AvailableBalance
+		var pending int64 // amount of the items to transfer, counted in the limits of the next items
+		var transfers []core.CreateTransactionParams
+		var transferred []int // items of `transfers`
+		for i, item := range params.Items {
+			if batch.Items[i].Status == core.BatchItemStatusFailed {
+				continue
+			}
+			if item.Amount > available {
+				failBatchItem(&batch.Items[i], errorNoSufficientFunds)
+				continue
+			}
+			if err := limits.check(item.Amount, pending); err != nil {
+				failBatchItem(&batch.Items[i], err)
+				continue
+			}
+			if err := card.CheckDebit(toAccountIDs[i], item.Amount, debited+pending); err != nil {
+				failBatchItem(&batch.Items[i], err)
+				continue
+			}
+			available -= item.Amount
+			pending += item.Amount
+			transfers = append(transfers, core.CreateTransactionParams{
+				Amount:        item.Amount,
+				FromAccountID: fromAccount.ID,
+				ToAccountID:   toAccountIDs[i],
+				Reason:        item.Reference,
+				Card:          params.FromCard,
+			})
+			transferred = append(transferred, i)
+		}
+		if params.Mode == core.BatchModeAllOrNothing {
+			if len(transferred) < len(params.Items) {
+				for _, i := range transferred {
+					batch.Items[i].Status = core.BatchItemStatusSkipped
+				}
+				return nil
+			}
+			transactions, err := c.tr.BatchTransfer(ctx, transfers)
+			if err != nil {
+				return err
+			}
+			for j, i := range transferred {
+				batch.Items[i].Status = core.BatchItemStatusSucceeded
+				batch.Items[i].Transaction = transactions[j]
+			}
+			return nil
+		}
+		for j, i := range transferred {
+			transaction, err := c.tr.Transfer(ctx, transfers[j])
+			if err != nil {
+				failBatchItem(&batch.Items[i], err)
+				continue
+			}
+			batch.Items[i].Status = core.BatchItemStatusSucceeded
+			batch.Items[i].Transaction = transaction
+		}
+		return nil
+	})
+	return batch, err
+}
+
+// batchRecipient returns the account of the item's card, it must be another account in the batch's currency
+func (c *BatchTransferCommandImpl) batchRecipient(ctx context.Context, fromAccount core.Account, item BatchTransferItemParams) (core.Account, error) {
+	toAccount, err := c.cr.GetCardAccount(ctx, item.ToCard)
+	if err != nil {
+		return core.Account{}, err
+	}
+	if toAccount.ID == fromAccount.ID {
+		return core.Account{}, errorBatchSameAccount
+	}
+	if toAccount.Currency != fromAccount.Currency {
+		return core.Account{}, errs.B().Code(errs.InvalidArgument).
+			Msgf("accounts currency mismatch, from currency: %s, to currency: %s, batches don't support cross-currency transfers", fromAccount.Currency, toAccount.Currency).
+			Err()
+	}
+	return toAccount, nil
+}
+
+func failBatchItem(item *core.BatchTransferItem, err error) {
+	item.Status = core.BatchItemStatusFailed
+	item.Error = err.Error()
+}
+
+func NewBatchTransferCommand(
+	v Validator,
+	l Locker,
+	ur UserRepository,
+	ar AccountRepository,
+	cr CardRepository,
+	tr TransactionRepository,
+	lmr LimitRepository,
+	cv CardVault,
+) BatchTransferCommand {
+	return &BatchTransferCommandImpl{v: v, l: l, ur: ur, ar: ar, cr: cr, tr: tr, lmr: lmr, cv: cv}
+}
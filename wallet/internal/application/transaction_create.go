@@ -7,41 +7,75 @@ import (
 	"github.com/escalopa/fingo/pkg/contextutils"
 	"github.com/escalopa/fingo/pkg/tracer"
 	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
 	"github.com/lordvidex/errs"
 )
 
+// minTransactionAmount is the smallest amount of a transaction in major units of its currency, e.g. 10 USD or 10 JPY
+const minTransactionAmount = 10
+
 type CreateTransactionParams struct {
-	Amount   float64              `validate:"required,min=10"`
-	Type     core.TransactionType `validate:"required"`
-	FromCard string               `validate:"required,number"`
-	ToCard   string               `validate:"omitempty,number"`
+	Amount         int64                `validate:"required,min=1"` // in minor units of `Currency`
+	Currency       core.Currency        `validate:"required"`
+	Type           core.TransactionType `validate:"required"`
+	FromCard       string               `validate:"required,number,credit_card"` // digits ending with a Luhn check digit
+	ToCard         string               `validate:"omitempty,number,credit_card"`
+	ToUsername     string               `validate:"omitempty,alphanum"` // transfers set one of `ToCard`, `ToUsername` or `ToEmail`
+	ToEmail        string               `validate:"omitempty,email"`
+	ToCurrency     core.Currency        // recipient's account currency when addressed by username or email, defaults to `Currency`
+	IdempotencyKey string               `validate:"omitempty,uuid"`
+	QuoteID        string               `validate:"omitempty,uuid"`         // required on cross-currency transfers
+	CVV            string               `validate:"omitempty,len=3,number"` // checked against the card's CVV when set
+	PIN            string               `validate:"omitempty,len=4,number"` // required on withdrawals from cards with a PIN
+	Note           string               `validate:"max=256"`                // sender's reference on transfers, seen by both accounts
 }
 
 var (
 	errorNoSufficientFunds = errs.B().Code(errs.InvalidArgument).Msg("no sufficient balance to perform transaction").Err()
+	errorQuoteMismatch     = errs.B().Code(errs.InvalidArgument).Msg("quote was created for a different transfer").Err()
+	errorQuoteUsed         = errs.B().Code(errs.InvalidArgument).Msg("quote already used by another transfer").Err()
+	errorQuoteExpired      = errs.B().Code(errs.InvalidArgument).Msg("quote expired, create a new one").Err()
+	errorRecipientNotSet   = errs.B().Code(errs.InvalidArgument).Msg("exactly one of the recipient card number, username or email must be set").Err()
+	errorInvalidCVV        = errs.B().Code(errs.InvalidArgument).Msg("invalid card CVV").Err()
+	errorPINRequired       = errs.B().Code(errs.InvalidArgument).Msg("card PIN is required to withdraw").Err()
+
+	errorSendingAccountFrozen   = errs.B().Code(errs.FailedPrecondition).Msg("sending account is frozen, it can only receive money").Err()
+	errorSendingAccountClosed   = errs.B().Code(errs.FailedPrecondition).Msg("sending account is closed").Err()
+	errorReceivingAccountClosed = errs.B().Code(errs.FailedPrecondition).Msg("receiving account is closed").Err()
 )
 
 type CreateTransactionCommand interface {
-	Execute(ctx context.Context, params CreateTransactionParams) error
+	Execute(ctx context.Context, params CreateTransactionParams) (core.Transaction, error)
 }
 
 type CreateTransactionCommandImpl struct {
-	v  Validator
-	l  Locker
-	ur UserRepository
-	ar AccountRepository
-	cr CardRepository
-	tr TransactionRepository
+	v   Validator
+	l   Locker
+	ur  UserRepository
+	ar  AccountRepository
+	cr  CardRepository
+	tr  TransactionRepository
+	qr  QuoteRepository
+	lmr LimitRepository
+	ud  UserDirectory
+	sh  SecretHasher
+	cv  CardVault
+	ir  time.Duration // idempotency keys retention
+	pma int           // card PIN max failed attempts in a row
+	pl  time.Duration // card PIN lockout
 }
 
-func (c *CreateTransactionCommandImpl) Execute(ctx context.Context, params CreateTransactionParams) error {
-	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+func (c *CreateTransactionCommandImpl) Execute(ctx context.Context, params CreateTransactionParams) (core.Transaction, error) {
+	var transaction core.Transaction
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
 		ctx, span := tracer.Tracer().Start(ctx, "CreateTransactionCommand.Execute")
 		defer span.End()
 		// Validate params
 		if err := c.v.Validate(ctx, params); err != nil {
 			return err
 		}
+		// Reference the cards by their tokens
+		params.FromCard, params.ToCard = cardToken(ctx, c.cv, params.FromCard), cardToken(ctx, c.cv, params.ToCard)
 		// Parse userID from context
 		userID, err := contextutils.GetUserID(ctx)
 		if err != nil {
@@ -61,27 +95,48 @@ func (c *CreateTransactionCommandImpl) Execute(ctx context.Context, params Creat
 		if innerID != fromAccount.OwnerID {
 			return errorNotAccountOwner
 		}
+		// Check that the card can be used & its CVV when given
+		card, err := getUsableCard(ctx, c.cr, params.FromCard)
+		if err != nil {
+			return err
+		}
+		if params.CVV != "" && !c.sh.Compare(ctx, card.CVVHash, params.CVV) {
+			return errorInvalidCVV
+		}
+		// Check that the amount is expressed in the card's account currency
+		if params.Currency != fromAccount.Currency {
+			return errs.B().Code(errs.InvalidArgument).
+				Msgf("amount currency mismatch, amount currency: %s, account currency: %s", params.Currency, fromAccount.Currency).
+				Err()
+		}
+		if minAmount := core.MinorAmount(minTransactionAmount, fromAccount.MinorUnits); params.Amount < minAmount {
+			return errs.B().Code(errs.InvalidArgument).
+				Msgf("amount must be at least %s", core.FormatAmount(minAmount, fromAccount.MinorUnits, fromAccount.Currency)).
+				Err()
+		}
 		// Set the toAccountID it the transaction is
 		switch params.Type {
 		case core.TransactionTypeTransfer:
-			// Check if the `from account` has enough balance to preform the transaction
-			if fromAccount.Balance < params.Amount {
-				return errorNoSufficientFunds
-			}
-			if params.ToCard == "" {
-				return errs.B().Code(errs.InvalidArgument).Msg("transfer card receiver not set").Err()
-			}
-			// Get card's account from db
-			toAccount, err := c.cr.GetCardAccount(ctx, params.ToCard)
+			// Get the recipient's account from db
+			toAccount, err := getRecipientAccount(ctx, c.ud, c.ar, c.cr, recipient{
+				Card:     params.ToCard,
+				Username: params.ToUsername,
+				Email:    params.ToEmail,
+				Currency: params.ToCurrency,
+			}, params.Currency)
 			if err != nil {
 				return err
 			}
-			// Check that the `from account` & `to account` have the same currency type
-			if toAccount.Currency != fromAccount.Currency {
+			// Check that transfers between different currencies come with a quote locking the exchange rate
+			exchange := toAccount.Currency != fromAccount.Currency
+			if exchange && params.QuoteID == "" {
 				return errs.B().Code(errs.InvalidArgument).
-					Msgf("accounts currency mismatch, from currency: %s, to currency: %s", fromAccount.Currency, toAccount.Currency).
+					Msgf("accounts currency mismatch, from currency: %s, to currency: %s, create a quote first", fromAccount.Currency, toAccount.Currency).
 					Err()
 			}
+			if !exchange && params.QuoteID != "" {
+				return errs.B().Code(errs.InvalidArgument).Msg("quote can only be used on cross-currency transfers").Err()
+			}
 			// Check that the `from account` & `to account` are not the same
 			if toAccount.ID == fromAccount.ID {
 				return errs.B().Code(errs.InvalidArgument).Msg("cannot transfer to the same account").Err()
@@ -89,36 +144,129 @@ func (c *CreateTransactionCommandImpl) Execute(ctx context.Context, params Creat
 			// Lock both `from account` & `to account` for transaction
 			unlock := c.l.Lock(ctx, fromAccount.ID, toAccount.ID)
 			defer unlock()
-			err = c.tr.Transfer(ctx, core.CreateTransactionParams{
-				Amount:        params.Amount,
-				FromAccountID: fromAccount.ID,
-				ToAccountID:   toAccount.ID,
-			})
+			// Return the original transaction if this request is a retry
+			var replayed bool
+			transaction, replayed, err = c.replay(ctx, params, fromAccount.ID, toAccount.ID)
+			if err != nil || replayed {
+				return err
+			}
+			// Re-read both accounts under the lock, they may have been frozen or closed meanwhile
+			fromAccount, err = c.ar.GetAccount(ctx, fromAccount.ID)
+			if err != nil {
+				return err
+			}
+			toAccount, err = c.ar.GetAccount(ctx, toAccount.ID)
+			if err != nil {
+				return err
+			}
+			if err = checkCanSend(fromAccount); err != nil {
+				return err
+			}
+			if err = checkCanReceive(toAccount); err != nil {
+				return err
+			}
+			// Check the available balance read under the lock, so that concurrent debits can't overdraw the account
+			if fromAccount.AvailableBalance < params.Amount {
+				return errorNoSufficientFunds
+			}
+			transferParams := core.CreateTransactionParams{
+				Amount:         params.Amount,
+				FromAccountID:  fromAccount.ID,
+				ToAccountID:    toAccount.ID,
+				IdempotencyKey: params.IdempotencyKey,
+				Reason:         params.Note,
+				Card:           params.FromCard,
+			}
+			// Credit the converted amount with the quote's rate
+			if exchange {
+				quote, err := c.quote(ctx, params, innerID, fromAccount.ID, toAccount.ID)
+				if err != nil {
+					return err
+				}
+				transferParams.ToAmount = quote.ToAmount
+				transferParams.ExchangeRate = quote.Rate
+				transferParams.QuoteID = quote.ID
+			}
+			if err = checkSpendingLimits(ctx, c.lmr, params.Type, fromAccount.ID, params.FromCard, params.Amount); err != nil {
+				return err
+			}
+			if err = checkCardDebit(ctx, c.cr, card, toAccount.ID, params.Amount); err != nil {
+				return err
+			}
+			transaction, err = c.tr.Transfer(ctx, transferParams)
 			if err != nil {
 				return err
 			}
 		case core.TransactionTypeDeposit:
 			unlock := c.l.Lock(ctx, fromAccount.ID, -1)
 			defer unlock()
-			err = c.tr.Deposit(ctx, core.CreateTransactionParams{
-				Amount:        params.Amount,
-				FromAccountID: 0,
-				ToAccountID:   fromAccount.ID,
+			// Return the original transaction if this request is a retry
+			var replayed bool
+			transaction, replayed, err = c.replay(ctx, params, 0, fromAccount.ID)
+			if err != nil || replayed {
+				return err
+			}
+			fromAccount, err = c.ar.GetAccount(ctx, fromAccount.ID)
+			if err != nil {
+				return err
+			}
+			if err = checkCanReceive(fromAccount); err != nil {
+				return err
+			}
+			if err = checkSpendingLimits(ctx, c.lmr, params.Type, fromAccount.ID, params.FromCard, params.Amount); err != nil {
+				return err
+			}
+			transaction, err = c.tr.Deposit(ctx, core.CreateTransactionParams{
+				Amount:         params.Amount,
+				FromAccountID:  0,
+				ToAccountID:    fromAccount.ID,
+				IdempotencyKey: params.IdempotencyKey,
+				Card:           params.FromCard,
 			})
 			if err != nil {
 				return err
 			}
 		case core.TransactionTypeWithdrawal:
+			// Withdrawals from cards with a PIN require it
+			if card.HasPIN() {
+				if params.PIN == "" {
+					return errorPINRequired
+				}
+				if err = verifyCardPIN(ctx, c.l, c.cr, c.sh, card.Number, params.PIN, c.pma, c.pl); err != nil {
+					return err
+				}
+			}
 			unlock := c.l.Lock(ctx, fromAccount.ID, -1)
 			defer unlock()
-			// Check if the `from account` has enough balance to preform the transaction
-			if fromAccount.Balance < params.Amount {
+			// Return the original transaction if this request is a retry
+			var replayed bool
+			transaction, replayed, err = c.replay(ctx, params, fromAccount.ID, 0)
+			if err != nil || replayed {
+				return err
+			}
+			fromAccount, err = c.ar.GetAccount(ctx, fromAccount.ID)
+			if err != nil {
+				return err
+			}
+			if err = checkCanSend(fromAccount); err != nil {
+				return err
+			}
+			// Check the available balance read under the lock, so that concurrent debits can't overdraw the account
+			if fromAccount.AvailableBalance < params.Amount {
 				return errorNoSufficientFunds
 			}
-			err = c.tr.Withdraw(ctx, core.CreateTransactionParams{
-				Amount:        params.Amount,
-				FromAccountID: fromAccount.ID,
-				ToAccountID:   0,
+			if err = checkSpendingLimits(ctx, c.lmr, params.Type, fromAccount.ID, params.FromCard, params.Amount); err != nil {
+				return err
+			}
+			if err = checkCardDebit(ctx, c.cr, card, 0, params.Amount); err != nil {
+				return err
+			}
+			transaction, err = c.tr.Withdraw(ctx, core.CreateTransactionParams{
+				Amount:         params.Amount,
+				FromAccountID:  fromAccount.ID,
+				ToAccountID:    0,
+				IdempotencyKey: params.IdempotencyKey,
+				Card:           params.FromCard,
 			})
 			if err != nil {
 				return err
@@ -128,6 +276,191 @@ func (c *CreateTransactionCommandImpl) Execute(ctx context.Context, params Creat
 		}
 		return nil
 	})
+	return transaction, err
+}
+
+// replay looks up a transaction previously created with the request's idempotency key
+// replayed is true when such transaction exists, in that case it is returned and no money must be moved
+// The transaction must match the request's fingerprint, its accounts, currency, amount & type, zero accounts are the
+// missing side of deposits & withdrawals
+func (c *CreateTransactionCommandImpl) replay(ctx context.Context, params CreateTransactionParams, fromAccountID, toAccountID int64) (t core.Transaction, replayed bool, err error) {
+	if params.IdempotencyKey == "" {
+		return core.Transaction{}, false, nil
+	}
+	transaction, err := c.tr.GetTransactionByIdempotencyKey(ctx, params.IdempotencyKey)
+	if err != nil {
+		if errErrs, ok := err.(*errs.Error); ok && errErrs.Code == errs.NotFound {
+			return core.Transaction{}, false, nil
+		}
+		return core.Transaction{}, false, err
+	}
+	// Check that the key was used for the same request
+	if transaction.FromAccountID != fromAccountID || transaction.ToAccountID != toAccountID ||
+		transaction.Currency != params.Currency || transaction.Amount != params.Amount || transaction.Type != params.Type {
+		return core.Transaction{}, false, errorIdempotencyKeyReused
+	}
+	if c.ir > 0 && time.Since(transaction.CreatedAt) > c.ir {
+		return core.Transaction{}, false, errorIdempotencyKeyExpired
+	}
+	return transaction, true, nil
+}
+
+// quote returns the transfer's quote after checking that it was created by the caller for the same transfer and is still usable
+func (c *CreateTransactionCommandImpl) quote(ctx context.Context, params CreateTransactionParams, userID, fromAccountID, toAccountID int64) (core.Quote, error) {
+	quoteID, _ := uuid.Parse(params.QuoteID)
+	quote, err := c.qr.GetQuote(ctx, quoteID)
+	if err != nil {
+		return core.Quote{}, err
+	}
+	if quote.UserID != userID || quote.FromAccountID != fromAccountID || quote.ToAccountID != toAccountID || quote.Amount != params.Amount {
+		return core.Quote{}, errorQuoteMismatch
+	}
+	if quote.IsUsed() {
+		return core.Quote{}, errorQuoteUsed
+	}
+	if quote.IsExpired(time.Now()) {
+		return core.Quote{}, errorQuoteExpired
+	}
+	return quote, nil
+}
+
+// recipient addresses the recipient of a transfer, by card number or by the username or email of its owner
+type recipient struct {
+	Card     string
+	Username string
+	Email    string
+	Currency core.Currency // the recipient's account currency when addressed by username or email
+}
+
+// getRecipientAccount returns the account receiving a transfer, recipients addressed by username or email receive it
+// on their default account in the currency, or else their oldest one, so that the sender never sees their card number
+func getRecipientAccount(ctx context.Context, ud UserDirectory, ar AccountRepository, cr CardRepository, to recipient, currency core.Currency) (core.Account, error) {
+	var set int
+	for _, v := range []string{to.Card, to.Username, to.Email} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return core.Account{}, errorRecipientNotSet
+	}
+	if to.Card != "" {
+		return cr.GetCardAccount(ctx, to.Card)
+	}
+	// Find the recipient's external id in the auth service
+	user, err := ud.LookupUser(ctx, core.LookupUserParams{Username: to.Username, Email: to.Email})
+	if err != nil {
+		return core.Account{}, err
+	}
+	if to.Currency != "" {
+		currency = to.Currency
+	}
+	return ar.GetReceivingAccount(ctx, user.ID, currency)
+}
+
+// getUsableCard returns the card used to move money, it must be active & not expired
+func getUsableCard(ctx context.Context, cr CardRepository, cardNumber string) (core.Card, error) {
+	card, err := cr.GetCard(ctx, cardNumber)
+	if err != nil {
+		return core.Card{}, err
+	}
+	if err = card.CheckUsable(time.Now()); err != nil {
+		return core.Card{}, errs.B(err).Code(errs.FailedPrecondition).Msg(err.Error()).Err()
+	}
+	return card, nil
+}
+
+// checkCardDebit checks that the card can debit `amount` to the account `toAccountID`, zero on withdrawals
+// The card must be locked to that account if it's locked to one, & stay under its spending cap
+func checkCardDebit(ctx context.Context, cr CardRepository, card core.Card, toAccountID, amount int64) error {
+	var debited int64
+	if card.SpendingCap > 0 {
+		var err error
+		debited, err = cr.GetCardDebited(ctx, card.Number)
+		if err != nil {
+			return err
+		}
+	}
+	if err := card.CheckDebit(toAccountID, amount, debited); err != nil {
+		return errs.B(err).Code(errs.FailedPrecondition).Msg(err.Error()).Err()
+	}
+	return nil
+}
+
+// checkCanSend returns an error unless money can be moved out of the account
+func checkCanSend(account core.Account) error {
+	switch {
+	case account.Status == core.AccountStatusClosed:
+		return errorSendingAccountClosed
+	case !account.CanSend():
+		return errorSendingAccountFrozen
+	}
+	return nil
+}
+
+// checkCanReceive returns an error unless money can be moved into the account
+func checkCanReceive(account core.Account) error {
+	if !account.CanReceive() {
+		return errorReceivingAccountClosed
+	}
+	return nil
+}
+
+// spendingLimits are the limits of a transaction type applying to an account & to the card used, with what was already spent
+type spendingLimits struct {
+	limits []core.SpendingLimit
+	spent  []core.Spending
+	now    time.Time // when the spending was read
+}
+
+// getSpendingLimits must be called with the account locked, so that the spending doesn't change before the transaction is created
+func getSpendingLimits(ctx context.Context, lmr LimitRepository, t core.TransactionType, accountID int64, card string) (spendingLimits, error) {
+	limits, err := lmr.GetTransactionLimits(ctx, t, accountID, card)
+	if err != nil {
+		return spendingLimits{}, err
+	}
+	now := time.Now().UTC()
+	result := spendingLimits{limits: limits, spent: make([]core.Spending, len(limits)), now: now}
+	for i, limit := range limits {
+		result.spent[i], err = lmr.GetSpending(ctx, limit, now)
+		if err != nil {
+			return spendingLimits{}, err
+		}
+	}
+	return result, nil
+}
+
+// check returns an InvalidArgument error when the amount exceeds one of the limits, `pending` is the amount
+// of the transactions not created yet that count towards the same limits
+func (s spendingLimits) check(amount, pending int64) error {
+	for i, limit := range s.limits {
+		spent := core.Spending{Day: s.spent[i].Day + pending, Month: s.spent[i].Month + pending}
+		if err := limit.Check(amount, spent); err != nil {
+			return errs.B(err).Code(errs.InvalidArgument).Msg(err.Error()).Err()
+		}
+	}
+	return nil
+}
+
+// release removes an authorized hold from the spending of the periods it was authorized in, before checking its capture
+func (s spendingLimits) release(hold core.Hold) {
+	for i := range s.spent {
+		if !hold.CreatedAt.Before(core.StartOfDay(s.now)) {
+			s.spent[i].Day -= hold.Amount
+		}
+		if !hold.CreatedAt.Before(core.StartOfMonth(s.now)) {
+			s.spent[i].Month -= hold.Amount
+		}
+	}
+}
+
+// checkSpendingLimits checks a single transaction against the limits of its type on the account & on the card used
+func checkSpendingLimits(ctx context.Context, lmr LimitRepository, t core.TransactionType, accountID int64, card string, amount int64) error {
+	limits, err := getSpendingLimits(ctx, lmr, t, accountID, card)
+	if err != nil {
+		return err
+	}
+	return limits.check(amount, 0)
 }
 
 func NewCreateTransactionCommand(
@@ -137,6 +470,14 @@ func NewCreateTransactionCommand(
 	ar AccountRepository,
 	cr CardRepository,
 	tr TransactionRepository,
+	qr QuoteRepository,
+	lmr LimitRepository,
+	ud UserDirectory,
+	sh SecretHasher,
+	cv CardVault,
+	ir time.Duration,
+	pma int,
+	pl time.Duration,
 ) CreateTransactionCommand {
-	return &CreateTransactionCommandImpl{v: v, l: l, ur: ur, ar: ar, cr: cr, tr: tr}
+	return &CreateTransactionCommandImpl{v: v, l: l, ur: ur, ar: ar, cr: cr, tr: tr, qr: qr, lmr: lmr, ud: ud, sh: sh, cv: cv, ir: ir, pma: pma, pl: pl}
 }
@@ -0,0 +1,88 @@
+package application
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/escalopa/fingo/wallet/internal/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateTransaction_replay(t *testing.T) {
+	key := uuid.NewString()
+	params := CreateTransactionParams{Amount: 1000, Currency: core.CurrencyUSD, Type: core.TransactionTypeTransfer, IdempotencyKey: key}
+	stored := core.Transaction{
+		ID:            uuid.New(),
+		Amount:        params.Amount,
+		Currency:      params.Currency,
+		Type:          params.Type,
+		FromAccountID: 1,
+		ToAccountID:   2,
+		CreatedAt:     time.Now(),
+	}
+
+	tests := []struct {
+		name     string
+		stored   func(t core.Transaction) core.Transaction
+		replayed bool
+		err      error
+	}{
+		{
+			name:     "same request",
+			stored:   func(t core.Transaction) core.Transaction { return t },
+			replayed: true,
+		},
+		{
+			name:   "other recipient",
+			stored: func(t core.Transaction) core.Transaction { t.ToAccountID = 3; return t },
+			err:    errorIdempotencyKeyReused,
+		},
+		{
+			name:   "other sender",
+			stored: func(t core.Transaction) core.Transaction { t.FromAccountID = 3; return t },
+			err:    errorIdempotencyKeyReused,
+		},
+		{
+			name:   "other currency",
+			stored: func(t core.Transaction) core.Transaction { t.Currency = core.CurrencyEUR; return t },
+			err:    errorIdempotencyKeyReused,
+		},
+		{
+			name:   "other amount",
+			stored: func(t core.Transaction) core.Transaction { t.Amount++; return t },
+			err:    errorIdempotencyKeyReused,
+		},
+		{
+			name:   "other type",
+			stored: func(t core.Transaction) core.Transaction { t.Type = core.TransactionTypeWithdrawal; return t },
+			err:    errorIdempotencyKeyReused,
+		},
+		{
+			name:   "expired key",
+			stored: func(t core.Transaction) core.Transaction { t.CreatedAt = time.Now().Add(-2 * time.Hour); return t },
+			err:    errorIdempotencyKeyExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tr := mock.NewMockTransactionRepository(ctrl)
+			tr.EXPECT().GetTransactionByIdempotencyKey(gomock.Any(), key).Return(tt.stored(stored), nil)
+
+			c := &CreateTransactionCommandImpl{tr: tr, ir: time.Hour}
+			transaction, replayed, err := c.replay(context.Background(), params, stored.FromAccountID, stored.ToAccountID)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.replayed, replayed)
+			if tt.replayed {
+				require.Equal(t, stored.ID, transaction.ID)
+			}
+		})
+	}
+}
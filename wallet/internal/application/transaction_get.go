@@ -2,24 +2,39 @@ package application
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/escalopa/fingo/pkg/contextutils"
 	"github.com/escalopa/fingo/pkg/tracer"
 	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/lordvidex/errs"
 )
 
+// TransactionHistoryFilters select the transactions of an account's history
+type TransactionHistoryFilters struct {
+	AccountID       int64                     `validate:"required,min=1"`
+	MinAmount       int64                     `validate:"omitempty,min=1"`
+	MaxAmount       int64                     `validate:"omitempty,min=1"`
+	TransactionType core.TransactionType      `validate:"omitempty"`
+	From            time.Time                 // inclusive, zero is unbounded
+	To              time.Time                 `validate:"omitempty,gtfield=From"` // exclusive, zero is unbounded
+	Direction       core.TransactionDirection `validate:"omitempty,oneof=incoming outgoing"`
+	Counterparty    string                    `validate:"max=64"` // part of the other account's name
+	Category        core.Category             `validate:"omitempty,oneof=income cash refunds housing transport groceries dining bills health shopping entertainment savings transfers other"`
+	Tag             string                    `validate:"omitempty,max=32"` // case-insensitive
+}
+
 type GetTransactionHistoryParams struct {
-	AccountID       int64                `validate:"required,min=1"`
-	Offset          int32                `validate:"min=0"`
-	Limit           int32                `validate:"min=1,max=100"`
-	MinAmount       float64              `validate:"omitempty,min=10"`
-	MaxAmount       float64              `validate:"omitempty,min=10"`
-	TransactionType core.TransactionType `validate:"omitempty"`
+	TransactionHistoryFilters
+	Cursor string `validate:"max=64"` // returned with the previous page, empty for the first page
+	Limit  int32  `validate:"min=1,max=100"`
 }
 
+var errorInvalidCursor = errs.B().Code(errs.InvalidArgument).Msg("invalid history cursor").Err()
+
 type GetTransactionHistoryCommand interface {
-	Execute(ctx context.Context, params GetTransactionHistoryParams) ([]core.Transaction, error)
+	Execute(ctx context.Context, params GetTransactionHistoryParams) (core.TransactionPage, error)
 }
 
 type GetTransactionHistoryCommandImpl struct {
@@ -29,8 +44,10 @@ type GetTransactionHistoryCommandImpl struct {
 	tr TransactionRepository
 }
 
-func (c *GetTransactionHistoryCommandImpl) Execute(ctx context.Context, params GetTransactionHistoryParams) ([]core.Transaction, error) {
-	var transactions []core.Transaction
+// Execute returns a page of the caller's account history, newest first, pages are keyed by the cursor of their last transaction
+// so that transactions created while paging never shift the next pages
+func (c *GetTransactionHistoryCommandImpl) Execute(ctx context.Context, params GetTransactionHistoryParams) (core.TransactionPage, error) {
+	var page core.TransactionPage
 	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
 		ctx, span := tracer.Tracer().Start(ctx, "GetTransactionHistory.Execute")
 		defer span.End()
@@ -38,38 +55,69 @@ func (c *GetTransactionHistoryCommandImpl) Execute(ctx context.Context, params G
 		if err := c.v.Validate(ctx, params); err != nil {
 			return err
 		}
-		// Get user external id
-		userID, err := contextutils.GetUserID(ctx)
+		after, err := core.ParseTransactionCursor(params.Cursor)
 		if err != nil {
-			return err
-		}
-		innerID, err := c.ur.GetUser(ctx, userID)
-		if err != nil {
-			return err
+			return errorInvalidCursor
 		}
-		account, err := c.ar.GetAccount(ctx, params.AccountID)
-		if err != nil {
+		if _, err = getHistoryAccount(ctx, c.ur, c.ar, params.AccountID); err != nil {
 			return err
 		}
-		// Check if the caller is the account's owner
-		if innerID != account.OwnerID {
-			return errorNotAccountOwner
-		}
-		// Get user transactions
-		transactions, err = c.tr.GetTransactions(ctx, core.GetTransactionsParams{
-			AccountID: params.AccountID,
-			Offset:    params.Offset,
-			Limit:     params.Limit,
-			Type:      params.TransactionType,
-			MinAmount: params.MinAmount,
-			MaxAmount: params.MaxAmount,
-		})
+		page, err = getTransactionPage(ctx, c.tr, params.TransactionHistoryFilters, after, params.Limit)
 		if err != nil {
 			return err
 		}
 		return nil
 	})
-	return transactions, err
+	return page, err
+}
+
+// getHistoryAccount returns the account whose history is read, the caller must own it
+func getHistoryAccount(ctx context.Context, ur UserRepository, ar AccountRepository, accountID int64) (core.Account, error) {
+	// Get user external id
+	userID, err := contextutils.GetUserID(ctx)
+	if err != nil {
+		return core.Account{}, err
+	}
+	innerID, err := ur.GetUser(ctx, userID)
+	if err != nil {
+		return core.Account{}, err
+	}
+	account, err := ar.GetAccount(ctx, accountID)
+	if err != nil {
+		return core.Account{}, err
+	}
+	// Check if the caller is the account's owner
+	if innerID != account.OwnerID {
+		return core.Account{}, errorNotAccountOwner
+	}
+	return account, nil
+}
+
+// getTransactionPage returns up to `limit` transactions after the cursor, with the cursor of the next page if there's one
+func getTransactionPage(ctx context.Context, tr TransactionRepository, filters TransactionHistoryFilters, after core.TransactionCursor, limit int32) (core.TransactionPage, error) {
+	// Get one more transaction than asked to know if there's a next page
+	transactions, err := tr.GetTransactions(ctx, core.GetTransactionsParams{
+		AccountID:    filters.AccountID,
+		After:        after,
+		Limit:        limit + 1,
+		Type:         filters.TransactionType,
+		MinAmount:    filters.MinAmount,
+		MaxAmount:    filters.MaxAmount,
+		From:         filters.From.UTC(),
+		To:           filters.To.UTC(),
+		Direction:    filters.Direction,
+		Counterparty: filters.Counterparty,
+		Category:     filters.Category,
+		Tag:          strings.ToLower(filters.Tag),
+	})
+	if err != nil {
+		return core.TransactionPage{}, err
+	}
+	if len(transactions) <= int(limit) {
+		return core.TransactionPage{Transactions: transactions}, nil
+	}
+	transactions = transactions[:limit]
+	return core.TransactionPage{Transactions: transactions, Next: transactions[limit-1].Cursor()}, nil
 }
 
 func NewGetTransactionHistoryCommand(v Validator, ur UserRepository, ar AccountRepository, tr TransactionRepository) GetTransactionHistoryCommand {
@@ -0,0 +1,75 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type SetTransactionLabelsParams struct {
+	TransactionID string        `validate:"required,uuid"`
+	AccountID     int64         `validate:"required,min=1"` // the caller's account involved in the transaction
+	Category      core.Category `validate:"omitempty,oneof=income cash refunds housing transport groceries dining bills health shopping entertainment savings transfers other"`
+	Tags          []string      `validate:"max=10,dive,min=1,max=32,alphanumunicode"` // case-insensitive
+}
+
+var errorTransactionNotInAccount = errs.B().Code(errs.InvalidArgument).Msg("transaction doesn't involve the account").Err()
+
+type SetTransactionLabelsCommand interface {
+	Execute(ctx context.Context, params SetTransactionLabelsParams) error
+}
+
+type SetTransactionLabelsCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	tr TransactionRepository
+}
+
+// Execute replaces the category & the tags of a transaction in the caller's account history, only the account's owner sees them
+// An empty category goes back to the category suggested by the rules, empty tags remove all the tags
+func (c *SetTransactionLabelsCommandImpl) Execute(ctx context.Context, params SetTransactionLabelsParams) error {
+	return contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "SetTransactionLabelsCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		account, err := getHistoryAccount(ctx, c.ur, c.ar, params.AccountID)
+		if err != nil {
+			return err
+		}
+		// Parse transaction id
+		transactionID, err := uuid.Parse(params.TransactionID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid transaction id").Err()
+		}
+		transaction, err := c.tr.GetTransaction(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+		if transaction.FromAccountID != account.ID && transaction.ToAccountID != account.ID {
+			return errorTransactionNotInAccount
+		}
+		err = c.tr.SetTransactionLabels(ctx, core.SetTransactionLabelsParams{
+			TransactionID: transaction.ID,
+			AccountID:     account.ID,
+			Category:      params.Category,
+			Tags:          core.NormalizeTags(params.Tags),
+		})
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+}
+
+func NewSetTransactionLabelsCommand(v Validator, ur UserRepository, ar AccountRepository, tr TransactionRepository) SetTransactionLabelsCommand {
+	return &SetTransactionLabelsCommandImpl{v: v, ur: ur, ar: ar, tr: tr}
+}
@@ -0,0 +1,99 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type RefundTransactionParams struct {
+	TransactionID  string `validate:"required,uuid"`
+	Amount         int64  `validate:"min=0"` // in minor units of the transfer's source currency, zero refunds the remaining amount
+	IdempotencyKey string `validate:"omitempty,uuid"`
+	Reason         string `validate:"max=256"`
+}
+
+type RefundTransactionCommand interface {
+	Execute(ctx context.Context, params RefundTransactionParams) (core.Transaction, error)
+}
+
+type RefundTransactionCommandImpl struct {
+	v  Validator
+	l  Locker
+	ur UserRepository
+	ar AccountRepository
+	tr TransactionRepository
+	ir time.Duration // idempotency keys retention
+}
+
+// Execute sends back part or all of a received transfer to its sender
+// Only the recipient account's owner can refund a transfer, several partial refunds can be made up to the transferred amount
+func (c *RefundTransactionCommandImpl) Execute(ctx context.Context, params RefundTransactionParams) (core.Transaction, error) {
+	var refund core.Transaction
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "RefundTransactionCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Get inner user id
+		innerID, err := c.ur.GetUser(ctx, userID)
+		if err != nil {
+			return err
+		}
+		// Parse transaction id
+		transactionID, err := uuid.Parse(params.TransactionID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid transaction id").Err()
+		}
+		transaction, err := c.tr.GetTransaction(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+		// Check if the transaction type is transfer
+		if transaction.Type != core.TransactionTypeTransfer {
+			return errs.B().Code(errs.InvalidArgument).Msg("can't refund a non transfer transaction").Err()
+		}
+		toAccount, err := c.ar.GetAccount(ctx, transaction.ToAccountID)
+		if err != nil {
+			return err
+		}
+		// Check if the caller received the transfer
+		if innerID != toAccount.OwnerID {
+			return errorNotAccountOwner
+		}
+		refund, err = reverseTransaction(ctx, c.l, c.ar, c.tr, c.ir, transaction, core.ReverseTransactionParams{
+			TransactionID:  transactionID,
+			Amount:         params.Amount,
+			IdempotencyKey: params.IdempotencyKey,
+			Reason:         params.Reason,
+		}, true)
+		return err
+	})
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	return refund, nil
+}
+
+func NewRefundTransactionCommand(
+	v Validator,
+	l Locker,
+	ur UserRepository,
+	ar AccountRepository,
+	tr TransactionRepository,
+	ir time.Duration,
+) RefundTransactionCommand {
+	return &RefundTransactionCommandImpl{v: v, l: l, ur: ur, ar: ar, tr: tr, ir: ir}
+}
@@ -0,0 +1,197 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
+
+type ReverseTransactionParams struct {
+	TransactionID  string `validate:"required,uuid"`
+	Amount         int64  `validate:"min=0"` // in minor units of the transaction's source currency, zero reverses the remaining amount
+	IdempotencyKey string `validate:"omitempty,uuid"`
+	Reason         string `validate:"required,max=256"`
+}
+
+var (
+	errorNotOpsUser          = errs.B().Code(errs.Forbidden).Msg("only operations users can reverse transactions").Err()
+	errorReversalUnsupported = errs.B().Code(errs.InvalidArgument).Msg("reversals can't be reversed").Err()
+	errorPotMoveReversal     = errs.B().Code(errs.InvalidArgument).Msg("pot moves can't be reversed, move the money back instead").Err()
+	errorReversalExceeded    = errs.B().Code(errs.InvalidArgument).Msg("amount exceeds the transaction's refundable amount").Err()
+	errorFullyReversed       = errs.B().Code(errs.InvalidArgument).Msg("transaction already fully reversed").Err()
+)
+
+type ReverseTransactionCommand interface {
+	Execute(ctx context.Context, params ReverseTransactionParams) (core.Transaction, error)
+}
+
+type ReverseTransactionCommandImpl struct {
+	v   Validator
+	l   Locker
+	ar  AccountRepository
+	tr  TransactionRepository
+	ir  time.Duration // idempotency keys retention
+	ops map[uuid.UUID]struct{}
+}
+
+// Execute reverses part or all of any transaction, reversals excluded, with a linked reversal transaction
+// Only operations users can reverse transactions, and they must give a reason
+func (c *ReverseTransactionCommandImpl) Execute(ctx context.Context, params ReverseTransactionParams) (core.Transaction, error) {
+	var reversal core.Transaction
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		ctx, span := tracer.Tracer().Start(ctx, "ReverseTransactionCommand.Execute")
+		defer span.End()
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		// Parse userID from context
+		userID, err := contextutils.GetUserID(ctx)
+		if err != nil {
+			return err
+		}
+		// Check that the caller is an operations user
+		if _, ok := c.ops[userID]; !ok {
+			return errorNotOpsUser
+		}
+		// Parse transaction id
+		transactionID, err := uuid.Parse(params.TransactionID)
+		if err != nil {
+			return errs.B().Code(errs.InvalidArgument).Msg("invalid transaction id").Err()
+		}
+		transaction, err := c.tr.GetTransaction(ctx, transactionID)
+		if err != nil {
+			return err
+		}
+		reversal, err = reverseTransaction(ctx, c.l, c.ar, c.tr, c.ir, transaction, core.ReverseTransactionParams{
+			TransactionID:  transactionID,
+			Amount:         params.Amount,
+			IdempotencyKey: params.IdempotencyKey,
+			Reason:         params.Reason,
+		}, false)
+		return err
+	})
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	return reversal, nil
+}
+
+func NewReverseTransactionCommand(
+	v Validator,
+	l Locker,
+	ar AccountRepository,
+	tr TransactionRepository,
+	ir time.Duration,
+	ops map[uuid.UUID]struct{},
+) ReverseTransactionCommand {
+	return &ReverseTransactionCommandImpl{v: v, l: l, ar: ar, tr: tr, ir: ir, ops: ops}
+}
+
+// reverseTransaction locks the accounts of the transaction and reverses the requested amount of it
+// A zero amount reverses what is left of the transaction, and retries with the same idempotency key return the original reversal
+// checkStatus checks that the money can move back between the accounts, operations reversals move it regardless
+func reverseTransaction(
+	ctx context.Context,
+	l Locker,
+	ar AccountRepository,
+	tr TransactionRepository,
+	ir time.Duration,
+	transaction core.Transaction,
+	params core.ReverseTransactionParams,
+	checkStatus bool,
+) (core.Transaction, error) {
+	if transaction.Type == core.TransactionTypeReversal {
+		return core.Transaction{}, errorReversalUnsupported
+	}
+	if transaction.Type == core.TransactionTypePot {
+		return core.Transaction{}, errorPotMoveReversal
+	}
+	// Lock the accounts the money moves between
+	var unlock func()
+	switch transaction.Type {
+	case core.TransactionTypeTransfer:
+		unlock = l.Lock(ctx, transaction.FromAccountID, transaction.ToAccountID)
+	case core.TransactionTypeDeposit:
+		unlock = l.Lock(ctx, transaction.ToAccountID)
+	default:
+		unlock = l.Lock(ctx, transaction.FromAccountID)
+	}
+	defer unlock()
+	// Return the original reversal if this request is a retry
+	if params.IdempotencyKey != "" {
+		reversal, err := tr.GetTransactionByIdempotencyKey(ctx, params.IdempotencyKey)
+		if err == nil {
+			// Check that the key reversed the same transaction by the same amount, the reversal's `ToAmount` is in
+			// the transaction's source currency & a zero amount reverses what was left, so it matches any amount
+			if reversal.Type != core.TransactionTypeReversal || reversal.ReversedTransactionID != transaction.ID {
+				return core.Transaction{}, errorIdempotencyKeyReused
+			}
+			if params.Amount != 0 && reversal.ToAmount != params.Amount {
+				return core.Transaction{}, errorIdempotencyKeyReused
+			}
+			if ir > 0 && time.Since(reversal.CreatedAt) > ir {
+				return core.Transaction{}, errorIdempotencyKeyExpired
+			}
+			return reversal, nil
+		}
+		if errErrs, ok := err.(*errs.Error); !ok || errErrs.Code != errs.NotFound {
+			return core.Transaction{}, err
+		}
+	}
+	// Reload the transaction now that no other reversal can run concurrently
+	transaction, err := tr.GetTransaction(ctx, transaction.ID)
+	if err != nil {
+		return core.Transaction{}, err
+	}
+	refundable := transaction.RefundableAmount()
+	if refundable == 0 {
+		return core.Transaction{}, errorFullyReversed
+	}
+	if params.Amount == 0 {
+		params.Amount = refundable
+	}
+	if params.Amount > refundable {
+		return core.Transaction{}, errorReversalExceeded
+	}
+	// Check that the money can move back, on the accounts read under the lock as they may have been frozen or closed
+	if checkStatus {
+		fromAccount, err := ar.GetAccount(ctx, transaction.FromAccountID)
+		if err != nil {
+			return core.Transaction{}, err
+		}
+		toAccount, err := ar.GetAccount(ctx, transaction.ToAccountID)
+		if err != nil {
+			return core.Transaction{}, err
+		}
+		if err = checkCanSend(toAccount); err != nil {
+			return core.Transaction{}, err
+		}
+		if err = checkCanReceive(fromAccount); err != nil {
+			return core.Transaction{}, err
+		}
+	}
+	// Check that the account the money is taken back from can afford it
+	var debitAccountID, debit int64
+	switch transaction.Type {
+	case core.TransactionTypeTransfer:
+		debitAccountID, debit = transaction.ToAccountID, transaction.ReversalToAmount(params.Amount)
+	case core.TransactionTypeDeposit:
+		debitAccountID, debit = transaction.ToAccountID, params.Amount
+	}
+	if debitAccountID != 0 {
+		account, err := ar.GetAccount(ctx, debitAccountID)
+		if err != nil {
+			return core.Transaction{}, err
+		}
+		if account.AvailableBalance < debit {
+			return core.Transaction{}, errorNoSufficientFunds
+		}
+	}
+	return tr.ReverseTransaction(ctx, params)
+}
@@ -0,0 +1,71 @@
+package application
+
+import (
+	"context"
+	"time"
+
+	"github.com/escalopa/fingo/pkg/contextutils"
+	"github.com/escalopa/fingo/pkg/tracer"
+	"github.com/escalopa/fingo/wallet/internal/core"
+)
+
+type StreamTransactionHistoryParams struct {
+	TransactionHistoryFilters
+}
+
+// historyStreamPageSize is how many transactions are read from the database at once while streaming a history
+const historyStreamPageSize = 100
+
+type StreamTransactionHistoryCommand interface {
+	Execute(ctx context.Context, params StreamTransactionHistoryParams, send func(core.Transaction) error) error
+}
+
+type StreamTransactionHistoryCommandImpl struct {
+	v  Validator
+	ur UserRepository
+	ar AccountRepository
+	tr TransactionRepository
+}
+
+// Execute sends the whole history of the caller's account matching the filters, newest first, to export long histories
+// The history is read page by page, each page within its own timeout, until the last page or until `send` fails
+func (c *StreamTransactionHistoryCommandImpl) Execute(ctx context.Context, params StreamTransactionHistoryParams, send func(core.Transaction) error) error {
+	ctx, span := tracer.Tracer().Start(ctx, "StreamTransactionHistoryCommand.Execute")
+	defer span.End()
+	err := contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+		// Validate params
+		if err := c.v.Validate(ctx, params); err != nil {
+			return err
+		}
+		_, err := getHistoryAccount(ctx, c.ur, c.ar, params.AccountID)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	var after core.TransactionCursor
+	for {
+		var page core.TransactionPage
+		err = contextutils.ExecuteWithContextTimeout(ctx, 5*time.Second, func() error {
+			var err error
+			page, err = getTransactionPage(ctx, c.tr, params.TransactionHistoryFilters, after, historyStreamPageSize)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		for _, transaction := range page.Transactions {
+			if err = send(transaction); err != nil {
+				return err
+			}
+		}
+		if page.Next.IsZero() {
+			return nil
+		}
+		after = page.Next
+	}
+}
+
+func NewStreamTransactionHistoryCommand(v Validator, ur UserRepository, ar AccountRepository, tr TransactionRepository) StreamTransactionHistoryCommand {
+	return &StreamTransactionHistoryCommandImpl{v: v, ur: ur, ar: ar, tr: tr}
+}
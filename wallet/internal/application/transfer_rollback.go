@@ -12,7 +12,8 @@ import (
 )
 
 type TransferRollbackParams struct {
-	TransactionID string `validate:"required,uuid"`
+	TransactionID  string `validate:"required,uuid"`
+	IdempotencyKey string `validate:"omitempty,uuid"`
 }
 
 type TransferRollbackCommand interface {
@@ -25,6 +26,7 @@ type TransferRollbackCommandImpl struct {
 	ur UserRepository
 	ar AccountRepository
 	tr TransactionRepository
+	ir time.Duration // idempotency keys retention
 }
 
 func (c *TransferRollbackCommandImpl) Execute(ctx context.Context, params TransferRollbackParams) error {
@@ -56,26 +58,22 @@ func (c *TransferRollbackCommandImpl) Execute(ctx context.Context, params Transf
 			return err
 		}
 		// Check if the transaction type is transfer
-		if transaction.Type.String() != core.TransactionTypeTransfer.String() {
+		if transaction.Type != core.TransactionTypeTransfer {
 			return errs.B().Code(errs.InvalidArgument).Msg("can't rollback a non transfer transaction").Err()
 		}
-		// Check if the transaction is already rolled back
-		if transaction.IsRolledBack {
-			return errs.B().Code(errs.InvalidArgument).Msg("transaction already rolled back").Err()
-		}
 		fromAccount, err := c.ar.GetAccount(ctx, transaction.FromAccountID)
 		if err != nil {
 			return err
 		}
 		// Check if the transaction creator is the same as caller
-		if innerID != fromAccount.ID {
+		if innerID != fromAccount.OwnerID {
 			return errorNotAccountOwner
 		}
-		// Lock accounts
-		unlock := c.l.Lock(ctx, transaction.FromAccountID, transaction.ToAccountID)
-		defer unlock()
-		// Rollback transaction
-		err = c.tr.RollbackTransaction(ctx, transactionID)
+		// Reverse what is left of the transfer
+		_, err = reverseTransaction(ctx, c.l, c.ar, c.tr, c.ir, transaction, core.ReverseTransactionParams{
+			TransactionID:  transactionID,
+			IdempotencyKey: params.IdempotencyKey,
+		}, true)
 		if err != nil {
 			return err
 		}
@@ -89,6 +87,7 @@ func NewTransferRollbackCommand(
 	ur UserRepository,
 	ar AccountRepository,
 	tr TransactionRepository,
+	ir time.Duration,
 ) TransferRollbackCommand {
-	return &TransferRollbackCommandImpl{v: v, l: l, ur: ur, ar: ar, tr: tr}
+	return &TransferRollbackCommandImpl{v: v, l: l, ur: ur, ar: ar, tr: tr, ir: ir}
 }
@@ -1,6 +1,11 @@
 package application
 
-import "github.com/lordvidex/errs"
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lordvidex/errs"
+)
 
 type UseCases struct {
 	v   Validator
@@ -8,9 +13,34 @@ type UseCases struct {
 	ur  UserRepository
 	ar  AccountRepository
 	cr  CardRepository
+	cur CurrencyRepository
 	tr  TransactionRepository
+	lr  LedgerRepository
+	hr  HoldRepository
+	sr  StandingOrderRepository
+	pr  PaymentRequestRepository
+	spr SplitRepository
+	lmr LimitRepository
+	br  BudgetRepository
+	ptr PotRepository
+	qr  QuoteRepository
 	ss  SmsSender
+	erp ExchangeRateProvider
 	cng CardNumberGenerator
+	sh  SecretHasher
+	cv  CardVault
+	pcv CardVault // vault of the key being rotated out, nil when no rotation is pending
+	str StatementRenderer
+	ud  UserDirectory
+	mp  MessageProducer
+	ir  time.Duration          // idempotency keys retention
+	qt  time.Duration          // quotes time to live
+	ht  time.Duration          // holds time to live
+	prt time.Duration          // payment requests time to live
+	cvy int                    // cards validity in years
+	pma int                    // cards PIN max failed attempts in a row
+	pl  time.Duration          // cards PIN lockout
+	ops map[uuid.UUID]struct{} // operations users allowed to reverse any transaction, to raise limits & to freeze accounts
 
 	command
 	query
@@ -18,6 +48,9 @@ type UseCases struct {
 
 var (
 	errorNotAccountOwner = errs.B().Code(errs.Forbidden).Msg("failed to delete account, not account owner").Err()
+
+	errorIdempotencyKeyReused  = errs.B().Code(errs.AlreadyExists).Msg("idempotency key already used for a different request").Err()
+	errorIdempotencyKeyExpired = errs.B().Code(errs.InvalidArgument).Msg("idempotency key expired, retry with a new key").Err()
 )
 
 type UseCasesOption func(*UseCases)
@@ -28,18 +61,72 @@ func NewUseCases(opts ...UseCasesOption) *UseCases {
 		opt(uc)
 	}
 	uc.command = command{
-		CreateWallet:      NewCreateWalletCommand(uc.v, uc.ur),
-		CreateAccount:     NewCreateAccountCommand(uc.v, uc.ur, uc.ar),
-		DeleteAccount:     NewDeleteAccountCommand(uc.v, uc.ur, uc.ar),
-		CreateCard:        NewCreateCardCommand(uc.v, uc.ur, uc.ar, uc.cr, uc.cng),
-		DeleteCard:        NewDeleteCardCommand(uc.v, uc.ur, uc.ar, uc.cr),
-		CreateTransaction: NewCreateTransactionCommand(uc.v, uc.l, uc.ur, uc.ar, uc.cr, uc.tr),
-		TransferRollback:  NewTransferRollbackCommand(uc.v, uc.l, uc.ur, uc.ar, uc.tr),
+		CreateWallet:          NewCreateWalletCommand(uc.v, uc.ur),
+		CreateAccount:         NewCreateAccountCommand(uc.v, uc.ur, uc.ar, uc.cur),
+		CloseAccount:          NewCloseAccountCommand(uc.v, uc.l, uc.ur, uc.ar),
+		FreezeAccount:         NewFreezeAccountCommand(uc.v, uc.l, uc.ur, uc.ar, uc.ops),
+		UnfreezeAccount:       NewUnfreezeAccountCommand(uc.v, uc.l, uc.ur, uc.ar, uc.ops),
+		SetDefaultAccount:     NewSetDefaultAccountCommand(uc.v, uc.ur, uc.ar),
+		CreateCard:            NewCreateCardCommand(uc.v, uc.ur, uc.ar, uc.cr, uc.cng, uc.sh, uc.cv, uc.cvy),
+		ReplaceCard:           NewReplaceCardCommand(uc.v, uc.ur, uc.ar, uc.cr, uc.cng, uc.sh, uc.cv, uc.cvy),
+		SetCardStatus:         NewSetCardStatusCommand(uc.v, uc.ur, uc.cr),
+		SetCardNickname:       NewSetCardNicknameCommand(uc.v, uc.ur, uc.cr),
+		SetCardPin:            NewSetCardPinCommand(uc.v, uc.l, uc.ur, uc.cr, uc.sh, uc.pma, uc.pl),
+		VerifyCardPin:         NewVerifyCardPinCommand(uc.v, uc.l, uc.ur, uc.cr, uc.sh, uc.pma, uc.pl),
+		RevealCardNumber:      NewRevealCardNumberCommand(uc.v, uc.ur, uc.cr, uc.cv),
+		VaultCards:            NewVaultCardsCommand(uc.v, uc.cr, uc.cv),
+		RekeyCards:            NewRekeyCardsCommand(uc.v, uc.cr, uc.cv, uc.pcv),
+		DeleteCard:            NewDeleteCardCommand(uc.v, uc.ur, uc.ar, uc.cr),
+		CreateTransaction:     NewCreateTransactionCommand(uc.v, uc.l, uc.ur, uc.ar, uc.cr, uc.tr, uc.qr, uc.lmr, uc.ud, uc.sh, uc.cv, uc.ir, uc.pma, uc.pl),
+		BatchTransfer:         NewBatchTransferCommand(uc.v, uc.l, uc.ur, uc.ar, uc.cr, uc.tr, uc.lmr, uc.cv),
+		TransferRollback:      NewTransferRollbackCommand(uc.v, uc.l, uc.ur, uc.ar, uc.tr, uc.ir),
+		RefundTransaction:     NewRefundTransactionCommand(uc.v, uc.l, uc.ur, uc.ar, uc.tr, uc.ir),
+		ReverseTransaction:    NewReverseTransactionCommand(uc.v, uc.l, uc.ar, uc.tr, uc.ir, uc.ops),
+		PurgeIdempotencyKeys:  NewPurgeIdempotencyKeysCommand(uc.v, uc.tr, uc.ir),
+		ReconcileLedger:       NewReconcileLedgerCommand(uc.v, uc.lr),
+		CreateQuote:           NewCreateQuoteCommand(uc.v, uc.ur, uc.ar, uc.cr, uc.qr, uc.erp, uc.ud, uc.cv, uc.qt),
+		AuthorizeHold:         NewAuthorizeHoldCommand(uc.v, uc.l, uc.ur, uc.ar, uc.cr, uc.hr, uc.lmr, uc.cv, uc.ht),
+		CaptureHold:           NewCaptureHoldCommand(uc.v, uc.l, uc.ur, uc.ar, uc.hr, uc.lmr),
+		VoidHold:              NewVoidHoldCommand(uc.v, uc.ur, uc.ar, uc.hr),
+		ExpireHolds:           NewExpireHoldsCommand(uc.v, uc.hr),
+		CreateStandingOrder:   NewCreateStandingOrderCommand(uc.v, uc.ur, uc.cr, uc.sr, uc.cv),
+		UpdateStandingOrder:   NewUpdateStandingOrderCommand(uc.v, uc.l, uc.ur, uc.cr, uc.sr),
+		DeleteStandingOrder:   NewDeleteStandingOrderCommand(uc.v, uc.l, uc.ur, uc.sr),
+		CreatePaymentRequest:  NewCreatePaymentRequestCommand(uc.v, uc.ur, uc.ar, uc.cr, uc.pr, uc.ud, uc.mp, uc.cv, uc.prt),
+		PayPaymentRequest:     NewPayPaymentRequestCommand(uc.v, uc.l, uc.ur, uc.ar, uc.cr, uc.pr, uc.lmr, uc.cv),
+		DeclinePaymentRequest: NewDeclinePaymentRequestCommand(uc.v, uc.ur, uc.pr),
+		CancelPaymentRequest:  NewCancelPaymentRequestCommand(uc.v, uc.ur, uc.pr),
+		ExpirePaymentRequests: NewExpirePaymentRequestsCommand(uc.v, uc.pr),
+		SetLimit:              NewSetLimitCommand(uc.v, uc.l, uc.ur, uc.ar, uc.cr, uc.lmr, uc.ops),
+		SetTransactionLabels:  NewSetTransactionLabelsCommand(uc.v, uc.ur, uc.ar, uc.tr),
+		SetBudget:             NewSetBudgetCommand(uc.v, uc.ur, uc.ar, uc.br),
+		DeleteBudget:          NewDeleteBudgetCommand(uc.v, uc.ur, uc.ar, uc.br),
+		CheckBudgets:          NewCheckBudgetsCommand(uc.v, uc.ur, uc.ar, uc.tr, uc.br, uc.ud, uc.mp),
+		CreatePot:             NewCreatePotCommand(uc.v, uc.ur, uc.ar, uc.ptr),
+		UpdatePot:             NewUpdatePotCommand(uc.v, uc.ur, uc.ar, uc.ptr),
+		DeletePot:             NewDeletePotCommand(uc.v, uc.l, uc.ur, uc.ar, uc.ptr),
+		MovePotMoney:          NewMovePotMoneyCommand(uc.v, uc.l, uc.ur, uc.ar, uc.ptr),
 	}
+	// Standing orders are executed through the same transaction logic as the users' transfers
+	uc.command.ExecuteStandingOrders = NewExecuteStandingOrdersCommand(uc.v, uc.l, uc.cr, uc.sr, uc.cv, uc.command.CreateTransaction)
+	// Split shares are payment requests, settling a share pays its request
+	uc.command.CreateSplit = NewCreateSplitCommand(uc.v, uc.ur, uc.ar, uc.cr, uc.spr, uc.ud, uc.mp, uc.cv, uc.prt)
+	uc.command.SettleSplit = NewSettleSplitCommand(uc.v, uc.ur, uc.spr, uc.command.PayPaymentRequest)
 	uc.query = query{
-		GetAccounts:           NewGetAccountsCommand(uc.v, uc.ur, uc.ar),
-		GetCards:              NewGetCardsCommand(uc.v, uc.ur, uc.ar, uc.cr),
-		GetTransactionHistory: NewGetTransactionHistoryCommand(uc.v, uc.ur, uc.ar, uc.tr),
+		GetAccounts:                NewGetAccountsCommand(uc.v, uc.ur, uc.ar, uc.ptr),
+		GetCards:                   NewGetCardsCommand(uc.v, uc.ur, uc.ar, uc.cr),
+		GetTransactionHistory:      NewGetTransactionHistoryCommand(uc.v, uc.ur, uc.ar, uc.tr),
+		StreamTransactionHistory:   NewStreamTransactionHistoryCommand(uc.v, uc.ur, uc.ar, uc.tr),
+		GetStatement:               NewGetStatementCommand(uc.v, uc.ur, uc.ar, uc.tr, uc.str),
+		GetSpendingSummary:         NewGetSpendingSummaryCommand(uc.v, uc.ur, uc.ar, uc.tr),
+		ListCurrencies:             NewListCurrenciesCommand(uc.v, uc.cur),
+		GetStandingOrders:          NewGetStandingOrdersCommand(uc.v, uc.ur, uc.sr),
+		GetStandingOrderExecutions: NewGetStandingOrderExecutionsCommand(uc.v, uc.ur, uc.sr),
+		GetPaymentRequests:         NewGetPaymentRequestsCommand(uc.v, uc.ur, uc.pr),
+		GetSplit:                   NewGetSplitCommand(uc.v, uc.ur, uc.spr),
+		GetSplits:                  NewGetSplitsCommand(uc.v, uc.ur, uc.spr),
+		GetLimits:                  NewGetLimitsCommand(uc.v, uc.ur, uc.ar, uc.cr, uc.lmr, uc.ops),
+		GetBudgets:                 NewGetBudgetsCommand(uc.v, uc.ur, uc.ar, uc.tr, uc.br),
 	}
 	return uc
 }
@@ -80,30 +167,240 @@ func WithCardRepository(cr CardRepository) UseCasesOption {
 	}
 }
 
+func WithCurrencyRepository(cur CurrencyRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.cur = cur
+	}
+}
+
 func WithTransactionRepository(tr TransactionRepository) UseCasesOption {
 	return func(uc *UseCases) {
 		uc.tr = tr
 	}
 }
 
+func WithLedgerRepository(lr LedgerRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.lr = lr
+	}
+}
+
+func WithHoldRepository(hr HoldRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.hr = hr
+	}
+}
+
+func WithStandingOrderRepository(sr StandingOrderRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.sr = sr
+	}
+}
+
+func WithPaymentRequestRepository(pr PaymentRequestRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.pr = pr
+	}
+}
+
+func WithSplitRepository(spr SplitRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.spr = spr
+	}
+}
+
+func WithLimitRepository(lmr LimitRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.lmr = lmr
+	}
+}
+
+func WithBudgetRepository(br BudgetRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.br = br
+	}
+}
+
+func WithPotRepository(ptr PotRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.ptr = ptr
+	}
+}
+
+func WithQuoteRepository(qr QuoteRepository) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.qr = qr
+	}
+}
+
+func WithExchangeRateProvider(erp ExchangeRateProvider) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.erp = erp
+	}
+}
+
 func WithCardNumberGenerator(cng CardNumberGenerator) UseCasesOption {
 	return func(uc *UseCases) {
 		uc.cng = cng
 	}
 }
 
+func WithSecretHasher(sh SecretHasher) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.sh = sh
+	}
+}
+
+func WithCardVault(cv CardVault) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.cv = cv
+	}
+}
+
+// WithPreviousCardVault sets the vault of the key being rotated out, the cards it encrypted are rekeyed on startup
+func WithPreviousCardVault(pcv CardVault) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.pcv = pcv
+	}
+}
+
+func WithStatementRenderer(str StatementRenderer) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.str = str
+	}
+}
+
+func WithUserDirectory(ud UserDirectory) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.ud = ud
+	}
+}
+
+func WithMessageProducer(mp MessageProducer) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.mp = mp
+	}
+}
+
+// WithIdempotencyRetention sets how long an idempotency key replays its original result
+// A zero duration keeps the keys valid forever
+func WithIdempotencyRetention(ir time.Duration) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.ir = ir
+	}
+}
+
+// WithQuoteTTL sets how long a quote locks its exchange rate
+func WithQuoteTTL(qt time.Duration) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.qt = qt
+	}
+}
+
+// WithHoldTTL sets how long an authorized hold reserves funds before it expires
+func WithHoldTTL(ht time.Duration) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.ht = ht
+	}
+}
+
+// WithPaymentRequestTTL sets how long a payment request can be paid before it expires
+func WithPaymentRequestTTL(prt time.Duration) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.prt = prt
+	}
+}
+
+// WithCardValidity sets for how many years the issued cards can be used, cards expire at the end of a month
+func WithCardValidity(years int) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.cvy = years
+	}
+}
+
+// WithCardPINLockout sets after how many failed attempts in a row a card's PIN is locked & for how long
+func WithCardPINLockout(maxAttempts int, lockout time.Duration) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.pma = maxAttempts
+		uc.pl = lockout
+	}
+}
+
+// WithOpsUsers sets the operations users, by their external ids, allowed to reverse any transaction, to raise limits
+// & to freeze any account
+func WithOpsUsers(ids []uuid.UUID) UseCasesOption {
+	return func(uc *UseCases) {
+		uc.ops = make(map[uuid.UUID]struct{}, len(ids))
+		for _, id := range ids {
+			uc.ops[id] = struct{}{}
+		}
+	}
+}
+
 type command struct {
-	CreateWallet      CreateWalletCommand
-	CreateAccount     CreateAccountCommand
-	DeleteAccount     DeleteAccountCommand
-	CreateCard        CreateCardCommand
-	DeleteCard        DeleteCardCommand
-	CreateTransaction CreateTransactionCommand
-	TransferRollback  TransferRollbackCommand
+	CreateWallet          CreateWalletCommand
+	CreateAccount         CreateAccountCommand
+	CloseAccount          CloseAccountCommand
+	FreezeAccount         FreezeAccountCommand
+	UnfreezeAccount       UnfreezeAccountCommand
+	SetDefaultAccount     SetDefaultAccountCommand
+	CreateCard            CreateCardCommand
+	ReplaceCard           ReplaceCardCommand
+	SetCardStatus         SetCardStatusCommand
+	SetCardNickname       SetCardNicknameCommand
+	SetCardPin            SetCardPinCommand
+	VerifyCardPin         VerifyCardPinCommand
+	RevealCardNumber      RevealCardNumberCommand
+	VaultCards            VaultCardsCommand
+	RekeyCards            RekeyCardsCommand
+	DeleteCard            DeleteCardCommand
+	CreateTransaction     CreateTransactionCommand
+	BatchTransfer         BatchTransferCommand
+	TransferRollback      TransferRollbackCommand
+	RefundTransaction     RefundTransactionCommand
+	ReverseTransaction    ReverseTransactionCommand
+	PurgeIdempotencyKeys  PurgeIdempotencyKeysCommand
+	ReconcileLedger       ReconcileLedgerCommand
+	CreateQuote           CreateQuoteCommand
+	AuthorizeHold         AuthorizeHoldCommand
+	CaptureHold           CaptureHoldCommand
+	VoidHold              VoidHoldCommand
+	ExpireHolds           ExpireHoldsCommand
+	CreateStandingOrder   CreateStandingOrderCommand
+	UpdateStandingOrder   UpdateStandingOrderCommand
+	DeleteStandingOrder   DeleteStandingOrderCommand
+	ExecuteStandingOrders ExecuteStandingOrdersCommand
+	CreatePaymentRequest  CreatePaymentRequestCommand
+	PayPaymentRequest     PayPaymentRequestCommand
+	DeclinePaymentRequest DeclinePaymentRequestCommand
+	CancelPaymentRequest  CancelPaymentRequestCommand
+	ExpirePaymentRequests ExpirePaymentRequestsCommand
+	CreateSplit           CreateSplitCommand
+	SettleSplit           SettleSplitCommand
+	SetLimit              SetLimitCommand
+	SetTransactionLabels  SetTransactionLabelsCommand
+	SetBudget             SetBudgetCommand
+	DeleteBudget          DeleteBudgetCommand
+	CheckBudgets          CheckBudgetsCommand
+	CreatePot             CreatePotCommand
+	UpdatePot             UpdatePotCommand
+	DeletePot             DeletePotCommand
+	MovePotMoney          MovePotMoneyCommand
 }
 
 type query struct {
-	GetAccounts           GetAccountsCommand
-	GetCards              GetCardsCommand
-	GetTransactionHistory GetTransactionHistoryCommand
+	GetAccounts                GetAccountsCommand
+	GetCards                   GetCardsCommand
+	GetTransactionHistory      GetTransactionHistoryCommand
+	StreamTransactionHistory   StreamTransactionHistoryCommand
+	GetStatement               GetStatementCommand
+	GetSpendingSummary         GetSpendingSummaryCommand
+	ListCurrencies             ListCurrenciesCommand
+	GetStandingOrders          GetStandingOrdersCommand
+	GetStandingOrderExecutions GetStandingOrderExecutionsCommand
+	GetPaymentRequests         GetPaymentRequestsCommand
+	GetSplit                   GetSplitCommand
+	GetSplits                  GetSplitsCommand
+	GetLimits                  GetLimitsCommand
+	GetBudgets                 GetBudgetsCommand
 }
@@ -1,36 +1,15 @@
 package core
 
-type Currency string
+import "time"
+
+type AccountStatus string
 
 const (
-	CurrencyUSD Currency = "USD"
-	CurrencyEUR Currency = "EUR"
-	CurrencyGBP Currency = "GBP"
-	CurrencyRUB Currency = "RUB"
-	CurrencyEGP Currency = "EGP"
+	AccountStatusActive AccountStatus = "active"
+	AccountStatusFrozen AccountStatus = "frozen" // receives money but doesn't send any
+	AccountStatusClosed AccountStatus = "closed" // neither sends nor receives money, kept for its history
 )
 
-func (c Currency) String() string {
-	return string(c)
-}
-
-func ParseCurrency(currency string) Currency {
-	switch currency {
-	case CurrencyUSD.String():
-		return CurrencyUSD
-	case CurrencyEUR.String():
-		return CurrencyEUR
-	case CurrencyGBP.String():
-		return CurrencyGBP
-	case CurrencyRUB.String():
-		return CurrencyRUB
-	case CurrencyEGP.String():
-		return CurrencyEGP
-	default:
-		return ""
-	}
-}
-
 type CreateAccountParams struct {
 	UserID   int64
 	Name     string
@@ -41,16 +20,28 @@ type Account struct {
 	ID       int64    `json:"id"`
 	OwnerID  int64    `json:"user_id"`
 	Name     string   `json:"name"`
-	Balance  float64  `json:"balance"`
+	Balance  int64    `json:"balance"` // ledger balance, in the currency's minor units
 	Currency Currency `json:"currency"`
+	// Balance minus the funds reserved by authorized holds
+	AvailableBalance int64 `json:"available_balance"`
+	// Number of digits after the decimal separator of the currency
+	MinorUnits int `json:"minor_units"`
+	// Receives the payments addressed to the owner in the account's currency
+	IsDefault bool          `json:"is_default"`
+	Status    AccountStatus `json:"status"`
+	// Frozen by an operations user, only operations users can unfreeze it
+	FrozenByOps bool      `json:"frozen_by_ops"`
+	ClosedAt    time.Time `json:"closed_at"` // zero unless closed
+	// Money set aside within the account, not counted in `Balance`
+	Pots []Pot `json:"pots"`
 }
 
-type CreateCardParams struct {
-	AccountID int64
-	Number    string
+// CanSend reports whether money can be moved out of the account
+func (a Account) CanSend() bool {
+	return a.Status == AccountStatusActive
 }
 
-type Card struct {
-	AccountID int64  `json:"account_id"`
-	Number    string `json:"number"`
+// CanReceive reports whether money can be moved into the account
+func (a Account) CanReceive() bool {
+	return a.Status != AccountStatusClosed
 }
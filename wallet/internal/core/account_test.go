@@ -6,53 +6,21 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func TestParseCurrency(t *testing.T) {
-
+func TestAccount_Status(t *testing.T) {
 	tests := []struct {
-		name     string
-		currency string
-		want     Currency
+		status     AccountStatus
+		canSend    bool
+		canReceive bool
 	}{
-		{
-			name:     "USD",
-			currency: "USD",
-			want:     CurrencyUSD,
-		},
-		{
-			name:     "EUR",
-			currency: "EUR",
-			want:     CurrencyEUR,
-		},
-		{
-			name:     "GBP",
-			currency: "GBP",
-			want:     CurrencyGBP,
-		},
-		{
-			name:     "RUB",
-			currency: "RUB",
-			want:     CurrencyRUB,
-		},
-		{
-			name:     "EGP",
-			currency: "EGP",
-			want:     CurrencyEGP,
-		},
-		{
-			name:     "empty",
-			currency: "",
-			want:     "",
-		},
-		{
-			name:     "unknown",
-			currency: "unknown",
-			want:     "",
-		},
+		{status: AccountStatusActive, canSend: true, canReceive: true},
+		{status: AccountStatusFrozen, canSend: false, canReceive: true},
+		{status: AccountStatusClosed, canSend: false, canReceive: false},
 	}
-
 	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			require.Equal(t, tt.want, ParseCurrency(tt.currency))
+		t.Run(string(tt.status), func(t *testing.T) {
+			account := Account{Status: tt.status}
+			require.Equal(t, tt.canSend, account.CanSend())
+			require.Equal(t, tt.canReceive, account.CanReceive())
 		})
 	}
 }
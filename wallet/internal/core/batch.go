@@ -0,0 +1,43 @@
+package core
+
+type BatchMode string
+
+const (
+	BatchModeAllOrNothing BatchMode = "all_or_nothing" // no item is transferred unless all of them can be
+	BatchModeBestEffort   BatchMode = "best_effort"    // every valid item is transferred, in order, the others fail
+)
+
+type BatchItemStatus string
+
+const (
+	BatchItemStatusSucceeded BatchItemStatus = "succeeded"
+	BatchItemStatusFailed    BatchItemStatus = "failed"
+	BatchItemStatusSkipped   BatchItemStatus = "skipped" // not transferred since another item of an all-or-nothing batch failed
+)
+
+// BatchTransferItem is one transfer of a batch from the batch's source account
+type BatchTransferItem struct {
+	ToCard      string          `json:"to_card"`
+	Amount      int64           `json:"amount"` // in the source account's currency minor units
+	Reference   string          `json:"reference"`
+	Status      BatchItemStatus `json:"status"`
+	Transaction Transaction     `json:"transaction"` // set on succeeded items
+	Error       string          `json:"error"`       // set on failed items
+}
+
+// BatchTransfer is the outcome of a batch of transfers from one account, items are in the order they were given
+type BatchTransfer struct {
+	Mode  BatchMode           `json:"mode"`
+	Items []BatchTransferItem `json:"items"`
+}
+
+// Succeeded returns the number of transferred items & their total amount
+func (b BatchTransfer) Succeeded() (count int, amount int64) {
+	for _, item := range b.Items {
+		if item.Status == BatchItemStatusSucceeded {
+			count++
+			amount += item.Amount
+		}
+	}
+	return count, amount
+}
@@ -0,0 +1,22 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchTransfer_Succeeded(t *testing.T) {
+	batch := BatchTransfer{
+		Mode: BatchModeBestEffort,
+		Items: []BatchTransferItem{
+			{Amount: 100, Status: BatchItemStatusSucceeded},
+			{Amount: 200, Status: BatchItemStatusFailed},
+			{Amount: 300, Status: BatchItemStatusSucceeded},
+			{Amount: 400, Status: BatchItemStatusSkipped},
+		},
+	}
+	count, amount := batch.Succeeded()
+	require.Equal(t, 2, count)
+	require.Equal(t, int64(400), amount)
+}
@@ -0,0 +1,97 @@
+package core
+
+import "time"
+
+// BudgetThresholds are the percents of a budget's amount whose crossing is notified to the account's owner, once a month each
+var BudgetThresholds = []int{80, 100}
+
+// Budget caps what an account plans to spend each month, in the account's currency minor units
+// Budgets without a category cover the account's whole spending, the others the spending within their category
+type Budget struct {
+	AccountID int64    `json:"account_id"`
+	Category  Category `json:"category"` // empty for the whole spending
+	Amount    int64    `json:"amount"`
+	// Highest threshold the owner was alerted about within `AlertedMonth`
+	AlertedMonth     time.Time `json:"alerted_month"` // start of the month in UTC, zero when never alerted
+	AlertedThreshold int       `json:"alerted_threshold"`
+	UpdatedAt        time.Time `json:"updated_at"`
+}
+
+type SetBudgetParams struct {
+	AccountID int64
+	Category  Category
+	Amount    int64
+}
+
+// Spent returns what the budget covers among the account's spending by category
+func (b Budget) Spent(spending []CategorySpending) int64 {
+	var spent int64
+	for _, s := range spending {
+		if b.Category == "" || b.Category == s.Category {
+			spent += s.Amount
+		}
+	}
+	return spent
+}
+
+// ReachedThreshold returns the highest of `BudgetThresholds` reached by the spent amount, zero when none
+func (b Budget) ReachedThreshold(spent int64) int {
+	reached := 0
+	for _, t := range BudgetThresholds {
+		if spent*100 >= b.Amount*int64(t) {
+			reached = t
+		}
+	}
+	return reached
+}
+
+// ThresholdToAlert returns the threshold reached within the month the owner wasn't alerted about yet, zero when none
+// Only the highest reached threshold is alerted, so that a single transaction crossing both thresholds alerts once
+func (b Budget) ThresholdToAlert(month time.Time, spent int64) int {
+	alerted := 0
+	if b.AlertedMonth.Equal(month) {
+		alerted = b.AlertedThreshold
+	}
+	if reached := b.ReachedThreshold(spent); reached > alerted {
+		return reached
+	}
+	return 0
+}
+
+// BudgetStatus is the month-to-date spending within a budget
+type BudgetStatus struct {
+	Budget    Budget    `json:"budget"`
+	Currency  Currency  `json:"currency"`
+	Month     time.Time `json:"month"` // start of the month in UTC
+	Spent     int64     `json:"spent"`
+	Remaining int64     `json:"remaining"` // zero once the budget is exceeded
+	Percent   int       `json:"percent"`   // of the budget's amount spent, rounded down, can exceed 100
+}
+
+// NewBudgetStatus returns the status of the budget given the account's month-to-date spending by category
+func NewBudgetStatus(b Budget, currency Currency, month time.Time, spending []CategorySpending) BudgetStatus {
+	spent := b.Spent(spending)
+	status := BudgetStatus{
+		Budget:   b,
+		Currency: currency,
+		Month:    month,
+		Spent:    spent,
+		Percent:  int(spent * 100 / b.Amount),
+	}
+	if spent < b.Amount {
+		status.Remaining = b.Amount - spent
+	}
+	return status
+}
+
+// BudgetAlertMessage notifies the account's owner that the month's spending crossed a threshold of a budget
+type BudgetAlertMessage struct {
+	Name        string `json:"name"`  // owner's name
+	Email       string `json:"email"` // owner's email
+	AccountName string `json:"account_name"`
+	Category    string `json:"category"`  // empty for the whole spending
+	Threshold   int    `json:"threshold"` // percent of the budget
+	Spent       string `json:"spent"`     // formatted in the account's currency major units
+	Budget      string `json:"budget"`    // formatted in the account's currency major units
+	Month       string `json:"month"`     // e.g. "March 2023"
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget_Spent(t *testing.T) {
+	spending := []CategorySpending{
+		{Category: CategoryGroceries, Amount: 300, Count: 2},
+		{Category: CategoryDining, Amount: 200, Count: 1},
+	}
+	require.Equal(t, int64(500), Budget{Amount: 1000}.Spent(spending))
+	require.Equal(t, int64(200), Budget{Category: CategoryDining, Amount: 1000}.Spent(spending))
+	require.Zero(t, Budget{Category: CategoryHousing, Amount: 1000}.Spent(spending))
+}
+
+func TestBudget_ThresholdToAlert(t *testing.T) {
+	month := StartOfMonth(time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name   string
+		budget Budget
+		spent  int64
+		want   int
+	}{
+		{
+			name:   "below the thresholds",
+			budget: Budget{Amount: 1000},
+			spent:  799,
+			want:   0,
+		},
+		{
+			name:   "80% reached",
+			budget: Budget{Amount: 1000},
+			spent:  800,
+			want:   80,
+		},
+		{
+			name:   "80% already alerted",
+			budget: Budget{Amount: 1000, AlertedMonth: month, AlertedThreshold: 80},
+			spent:  900,
+			want:   0,
+		},
+		{
+			name:   "100% reached after 80% alerted",
+			budget: Budget{Amount: 1000, AlertedMonth: month, AlertedThreshold: 80},
+			spent:  1000,
+			want:   100,
+		},
+		{
+			name:   "both crossed at once alerts the highest",
+			budget: Budget{Amount: 1000},
+			spent:  1500,
+			want:   100,
+		},
+		{
+			name:   "alerted the previous month",
+			budget: Budget{Amount: 1000, AlertedMonth: month.AddDate(0, -1, 0), AlertedThreshold: 100},
+			spent:  850,
+			want:   80,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.budget.ThresholdToAlert(month, tt.spent))
+		})
+	}
+}
+
+func TestNewBudgetStatus(t *testing.T) {
+	month := StartOfMonth(time.Now())
+	spending := []CategorySpending{{Category: CategoryGroceries, Amount: 450, Count: 3}}
+
+	status := NewBudgetStatus(Budget{Amount: 1000}, CurrencyUSD, month, spending)
+	require.Equal(t, int64(450), status.Spent)
+	require.Equal(t, int64(550), status.Remaining)
+	require.Equal(t, 45, status.Percent)
+
+	status = NewBudgetStatus(Budget{Category: CategoryGroceries, Amount: 300}, CurrencyUSD, month, spending)
+	require.Equal(t, int64(450), status.Spent)
+	require.Zero(t, status.Remaining)
+	require.Equal(t, 150, status.Percent)
+}
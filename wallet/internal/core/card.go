@@ -0,0 +1,156 @@
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+type CardStatus string
+
+const (
+	CardStatusActive  CardStatus = "active"
+	CardStatusBlocked CardStatus = "blocked" // by its owner, can be unblocked
+	CardStatusLost    CardStatus = "lost"    // reported lost, can't be used again
+	CardStatusExpired CardStatus = "expired" // active card past its expiry date, never stored
+)
+
+type CardKind string
+
+const (
+	CardKindPhysical  CardKind = "physical"
+	CardKindVirtual   CardKind = "virtual"    // online only, no physical counterpart
+	CardKindSingleUse CardKind = "single_use" // virtual card blocked by its first debit or hold
+)
+
+// EncryptedCardNumber is a card number encrypted with a data key, itself encrypted with the vault's key
+type EncryptedCardNumber struct {
+	Ciphertext []byte
+	DataKey    []byte
+}
+
+type CreateCardParams struct {
+	AccountID       int64
+	Number          string // card token
+	EncryptedNumber EncryptedCardNumber
+	Nickname        string
+	CVVHash         string
+	ExpiresAt       time.Time
+	Kind            CardKind
+	SpendingCap     int64 // total amount the card can debit over its life, zero is uncapped
+	// Only account the card can pay, zero pays any account
+	LockedToAccountID int64
+}
+
+// Card is referenced by its token, the keyed hash of its number followed by the number's last 4 digits
+// The number itself is only stored encrypted
+type Card struct {
+	AccountID       int64               `json:"account_id"`
+	Number          string              `json:"number"` // card token
+	EncryptedNumber EncryptedCardNumber `json:"-"`
+	Nickname        string              `json:"nickname"`
+	Status          CardStatus          `json:"status"`
+	CVVHash         string              `json:"-"`          // empty on cards issued before CVVs
+	CVV             string              `json:"cvv"`        // only set on the card returned when it's issued, never stored
+	ExpiresAt       time.Time           `json:"expires_at"` // the card can't be used from then on
+	// Token of the card issued in place of this one, set on replaced cards
+	ReplacedBy string    `json:"replaced_by"`
+	CreatedAt  time.Time `json:"created_at"`
+	PINHash    string    `json:"-"` // empty until the owner sets a PIN
+	// Failed PIN attempts in a row since the last successful one or the last lockout
+	PINFailedAttempts int       `json:"pin_failed_attempts"`
+	PINLockedUntil    time.Time `json:"pin_locked_until"` // the PIN can't be verified until then
+	Kind              CardKind  `json:"kind"`
+	SpendingCap       int64     `json:"spending_cap"` // in minor units of the account's currency, zero is uncapped
+	LockedToAccountID int64     `json:"locked_to_account_id"`
+}
+
+// CardPINAttempts are the failed PIN attempts of a card & the end of its PIN lockout
+type CardPINAttempts struct {
+	FailedAttempts int
+	LockedUntil    time.Time
+}
+
+// MaskCardNumber keeps the last 4 digits of a card number or token only
+func MaskCardNumber(number string) string {
+	if len(number) < 4 {
+		return number
+	}
+	return "**** " + number[len(number)-4:]
+}
+
+// StatusAt returns the card's status at t, active cards past their expiry date are expired
+func (c Card) StatusAt(t time.Time) CardStatus {
+	if c.Status == CardStatusActive && !t.Before(c.ExpiresAt) {
+		return CardStatusExpired
+	}
+	return c.Status
+}
+
+// CheckUsable returns an error unless money can be moved with the card at t
+func (c Card) CheckUsable(t time.Time) error {
+	switch status := c.StatusAt(t); status {
+	case CardStatusActive:
+		return nil
+	case CardStatusExpired:
+		return fmt.Errorf("card expired at %s", c.ExpiresAt.Format(time.RFC3339))
+	default:
+		return fmt.Errorf("card is %s", status)
+	}
+}
+
+// CheckStatusChange returns an error when the card's owner can't set its status
+// Lost & replaced cards are final, expiry only depends on the card's expiry date
+func (c Card) CheckStatusChange(status CardStatus) error {
+	switch {
+	case status != CardStatusActive && status != CardStatusBlocked && status != CardStatusLost:
+		return fmt.Errorf("card status can't be set to %s", status)
+	case c.ReplacedBy != "":
+		return fmt.Errorf("card was replaced by another card")
+	case c.Status == CardStatusLost:
+		return fmt.Errorf("card was reported lost, replace it to get a new one")
+	}
+	return nil
+}
+
+// CheckDebit returns an error unless the card can debit `amount` to the account `toAccountID`, zero on withdrawals
+// `debited` is the total already debited with the card, including its authorized holds
+func (c Card) CheckDebit(toAccountID, amount, debited int64) error {
+	switch {
+	case c.LockedToAccountID != 0 && c.LockedToAccountID != toAccountID:
+		return fmt.Errorf("card can only pay account %d", c.LockedToAccountID)
+	case c.SpendingCap > 0 && debited+amount > c.SpendingCap:
+		return fmt.Errorf("card spending cap exceeded, cap: %d, debited: %d", c.SpendingCap, debited)
+	}
+	return nil
+}
+
+// HasPIN reports whether the card's owner set a PIN
+func (c Card) HasPIN() bool {
+	return c.PINHash != ""
+}
+
+// CheckPINAttempt returns an error when the card's PIN can't be verified at t
+func (c Card) CheckPINAttempt(t time.Time) error {
+	switch {
+	case !c.HasPIN():
+		return fmt.Errorf("card has no PIN")
+	case t.Before(c.PINLockedUntil):
+		return fmt.Errorf("card PIN locked until %s after too many failed attempts", c.PINLockedUntil.Format(time.RFC3339))
+	}
+	return nil
+}
+
+// PINFailed returns the card's PIN attempts after a failed attempt at t
+// The PIN is locked for `lockout` once `maxAttempts` attempts failed in a row, the count starts over after the lockout
+func (c Card) PINFailed(t time.Time, maxAttempts int, lockout time.Duration) CardPINAttempts {
+	attempts := CardPINAttempts{FailedAttempts: c.PINFailedAttempts + 1, LockedUntil: c.PINLockedUntil}
+	if attempts.FailedAttempts >= maxAttempts {
+		attempts = CardPINAttempts{FailedAttempts: 0, LockedUntil: t.Add(lockout)}
+	}
+	return attempts
+}
+
+// CardExpiry returns the expiry of a card issued at t & valid for `years`, cards expire at the end of a month in UTC
+func CardExpiry(t time.Time, years int) time.Time {
+	return StartOfMonth(t).AddDate(years, 1, 0)
+}
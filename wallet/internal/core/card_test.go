@@ -0,0 +1,171 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCard_CheckUsable(t *testing.T) {
+	now := time.Date(2023, time.March, 20, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		card    Card
+		status  CardStatus
+		wantErr bool
+	}{
+		{
+			name:   "active",
+			card:   Card{Status: CardStatusActive, ExpiresAt: now.Add(time.Hour)},
+			status: CardStatusActive,
+		},
+		{
+			name:    "expired",
+			card:    Card{Status: CardStatusActive, ExpiresAt: now},
+			status:  CardStatusExpired,
+			wantErr: true,
+		},
+		{
+			name:    "blocked",
+			card:    Card{Status: CardStatusBlocked, ExpiresAt: now.Add(time.Hour)},
+			status:  CardStatusBlocked,
+			wantErr: true,
+		},
+		{
+			name:    "lost & expired",
+			card:    Card{Status: CardStatusLost, ExpiresAt: now.Add(-time.Hour)},
+			status:  CardStatusLost,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.status, tt.card.StatusAt(now))
+			err := tt.card.CheckUsable(now)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCard_CheckStatusChange(t *testing.T) {
+	tests := []struct {
+		name    string
+		card    Card
+		status  CardStatus
+		wantErr bool
+	}{
+		{
+			name:   "block",
+			card:   Card{Status: CardStatusActive},
+			status: CardStatusBlocked,
+		},
+		{
+			name:   "unblock",
+			card:   Card{Status: CardStatusBlocked},
+			status: CardStatusActive,
+		},
+		{
+			name:   "report lost",
+			card:   Card{Status: CardStatusBlocked},
+			status: CardStatusLost,
+		},
+		{
+			name:    "unblock lost",
+			card:    Card{Status: CardStatusLost},
+			status:  CardStatusActive,
+			wantErr: true,
+		},
+		{
+			name:    "unblock replaced",
+			card:    Card{Status: CardStatusBlocked, ReplacedBy: "4000"},
+			status:  CardStatusActive,
+			wantErr: true,
+		},
+		{
+			name:    "expire",
+			card:    Card{Status: CardStatusActive},
+			status:  CardStatusExpired,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.card.CheckStatusChange(tt.status)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCardExpiry(t *testing.T) {
+	issuedAt := time.Date(2023, time.March, 20, 12, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Date(2027, time.April, 1, 0, 0, 0, 0, time.UTC), CardExpiry(issuedAt, 4))
+}
+
+func TestMaskCardNumber(t *testing.T) {
+	require.Equal(t, "**** 4242", MaskCardNumber("4242424242424242"))
+	require.Equal(t, "**** 4242", MaskCardNumber("9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f04242"))
+	require.Equal(t, "", MaskCardNumber(""))
+}
+
+func TestCard_PINAttempts(t *testing.T) {
+	now := time.Date(2023, time.March, 20, 12, 0, 0, 0, time.UTC)
+	card := Card{}
+	require.Error(t, card.CheckPINAttempt(now))
+
+	// The PIN is locked once the max attempts failed in a row
+	card.PINHash = "hash"
+	require.NoError(t, card.CheckPINAttempt(now))
+	for i := 1; i < 3; i++ {
+		attempts := card.PINFailed(now, 3, time.Hour)
+		require.Equal(t, CardPINAttempts{FailedAttempts: i}, attempts)
+		card.PINFailedAttempts, card.PINLockedUntil = attempts.FailedAttempts, attempts.LockedUntil
+		require.NoError(t, card.CheckPINAttempt(now))
+	}
+	attempts := card.PINFailed(now, 3, time.Hour)
+	require.Equal(t, CardPINAttempts{FailedAttempts: 0, LockedUntil: now.Add(time.Hour)}, attempts)
+	card.PINFailedAttempts, card.PINLockedUntil = attempts.FailedAttempts, attempts.LockedUntil
+	require.Error(t, card.CheckPINAttempt(now))
+	require.Error(t, card.CheckPINAttempt(now.Add(time.Hour-time.Second)))
+
+	// The count starts over after the lockout, the previous lockout end is kept until the next one
+	require.NoError(t, card.CheckPINAttempt(now.Add(time.Hour)))
+	later := now.Add(2 * time.Hour)
+	require.Equal(t, CardPINAttempts{FailedAttempts: 1, LockedUntil: now.Add(time.Hour)}, card.PINFailed(later, 3, time.Hour))
+}
+
+func TestCard_CheckDebit(t *testing.T) {
+	tests := []struct {
+		name        string
+		card        Card
+		toAccountID int64
+		amount      int64
+		debited     int64
+		wantErr     bool
+	}{
+		{name: "uncapped", card: Card{}, toAccountID: 2, amount: 1000, debited: 1000},
+		{name: "within cap", card: Card{SpendingCap: 1000}, toAccountID: 2, amount: 400, debited: 600},
+		{name: "over cap", card: Card{SpendingCap: 1000}, toAccountID: 2, amount: 401, debited: 600, wantErr: true},
+		{name: "locked recipient", card: Card{LockedToAccountID: 2}, toAccountID: 2, amount: 100},
+		{name: "other recipient", card: Card{LockedToAccountID: 2}, toAccountID: 3, amount: 100, wantErr: true},
+		{name: "locked withdrawal", card: Card{LockedToAccountID: 2}, amount: 100, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.card.CheckDebit(tt.toAccountID, tt.amount, tt.debited)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
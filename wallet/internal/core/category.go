@@ -0,0 +1,119 @@
+package core
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Category classifies the spending & income of an account, each account sees its own category of a transaction
+// Transactions without one are given a suggested category by rules on their type & counterparty
+type Category string
+
+const (
+	CategoryIncome        Category = "income"
+	CategoryCash          Category = "cash"
+	CategoryRefunds       Category = "refunds"
+	CategoryHousing       Category = "housing"
+	CategoryTransport     Category = "transport"
+	CategoryGroceries     Category = "groceries"
+	CategoryDining        Category = "dining"
+	CategoryBills         Category = "bills"
+	CategoryHealth        Category = "health"
+	CategoryShopping      Category = "shopping"
+	CategoryEntertainment Category = "entertainment"
+	CategorySavings       Category = "savings"
+	CategoryTransfers     Category = "transfers"
+	CategoryOther         Category = "other"
+)
+
+// Categories lists the categories in display order
+var Categories = []Category{
+	CategoryIncome,
+	CategoryCash,
+	CategoryRefunds,
+	CategoryHousing,
+	CategoryTransport,
+	CategoryGroceries,
+	CategoryDining,
+	CategoryBills,
+	CategoryHealth,
+	CategoryShopping,
+	CategoryEntertainment,
+	CategorySavings,
+	CategoryTransfers,
+	CategoryOther,
+}
+
+// ParseCategory returns the category named `c`, case-insensitive, or the empty category
+func ParseCategory(c string) Category {
+	c = strings.ToLower(c)
+	for _, category := range Categories {
+		if category.String() == c {
+			return category
+		}
+	}
+	return ""
+}
+
+func (c Category) String() string {
+	return string(c)
+}
+
+// SetTransactionLabelsParams replaces the category & the tags an account gave to one of its transactions
+type SetTransactionLabelsParams struct {
+	TransactionID uuid.UUID
+	AccountID     int64
+	Category      Category // empty to go back to the suggested category
+	Tags          []string // normalized with `NormalizeTags`, empty to remove all the tags
+}
+
+// NormalizeTags lowercases & trims the tags, dropping the empty & the duplicated ones, sorted like in the history
+func NormalizeTags(tags []string) []string {
+	seen := make(map[string]bool, len(tags))
+	res := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		res = append(res, tag)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// CategorySpending is the amount debited from an account within a category, net of the reversals
+type CategorySpending struct {
+	Category Category `json:"category"`
+	Amount   int64    `json:"amount"` // in the account's currency minor units
+	Count    int64    `json:"count"`  // number of transactions
+}
+
+// SpendingSummary is the spending of an account by category over a period, the highest spending first
+type SpendingSummary struct {
+	AccountID  int64              `json:"account_id"`
+	Currency   Currency           `json:"currency"`
+	From       time.Time          `json:"from"` // inclusive
+	To         time.Time          `json:"to"`   // exclusive
+	Total      int64              `json:"total"`
+	Categories []CategorySpending `json:"categories"`
+}
+
+// NewSpendingSummary sums up the spending of the categories
+func NewSpendingSummary(account Account, from, to time.Time, categories []CategorySpending) SpendingSummary {
+	summary := SpendingSummary{
+		AccountID:  account.ID,
+		Currency:   account.Currency,
+		From:       from,
+		To:         to,
+		Categories: categories,
+	}
+	for _, c := range categories {
+		summary.Total += c.Amount
+	}
+	return summary
+}
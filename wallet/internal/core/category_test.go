@@ -0,0 +1,62 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCategory(t *testing.T) {
+	require.Equal(t, CategoryGroceries, ParseCategory("groceries"))
+	require.Equal(t, CategoryGroceries, ParseCategory("GROCERIES"))
+	require.Equal(t, CategoryOther, ParseCategory("other"))
+	require.Equal(t, Category(""), ParseCategory("food"))
+	require.Equal(t, Category(""), ParseCategory(""))
+}
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name string
+		tags []string
+		want []string
+	}{
+		{
+			name: "no tags",
+			tags: nil,
+			want: []string{},
+		},
+		{
+			name: "lowercased & sorted",
+			tags: []string{"Trip", " work ", "berlin"},
+			want: []string{"berlin", "trip", "work"},
+		},
+		{
+			name: "duplicated & empty tags dropped",
+			tags: []string{"trip", "TRIP", " ", ""},
+			want: []string{"trip"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, NormalizeTags(tt.tags))
+		})
+	}
+}
+
+func TestNewSpendingSummary(t *testing.T) {
+	from := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	account := Account{ID: 1, Currency: CurrencyEUR}
+	summary := NewSpendingSummary(account, from, to, []CategorySpending{
+		{Category: CategoryHousing, Amount: 90000, Count: 1},
+		{Category: CategoryGroceries, Amount: 12550, Count: 7},
+	})
+	require.Equal(t, int64(1), summary.AccountID)
+	require.Equal(t, CurrencyEUR, summary.Currency)
+	require.Equal(t, int64(102550), summary.Total)
+	require.Len(t, summary.Categories, 2)
+
+	empty := NewSpendingSummary(account, from, to, nil)
+	require.Zero(t, empty.Total)
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Currency is an ISO 4217 alphabetic code, the supported currencies are registered in the currency repository
+type Currency string
+
+// Currencies registered by default
+const (
+	CurrencyUSD Currency = "USD"
+	CurrencyEUR Currency = "EUR"
+	CurrencyGBP Currency = "GBP"
+	CurrencyRUB Currency = "RUB"
+	CurrencyEGP Currency = "EGP"
+)
+
+func (c Currency) String() string {
+	return string(c)
+}
+
+// ParseCurrency normalizes a currency code, it doesn't check whether the currency is supported
+func ParseCurrency(code string) Currency {
+	return Currency(strings.ToUpper(strings.TrimSpace(code)))
+}
+
+// FormatAmount formats an amount in minor units of a currency with `minorUnits` digits, e.g. 1250 USD as "12.50 USD"
+func FormatAmount(amount int64, minorUnits int, currency Currency) string {
+	return FormatDecimal(amount, minorUnits) + " " + currency.String()
+}
+
+// FormatDecimal formats an amount in minor units of a currency with `minorUnits` digits, e.g. 1250 as "12.50"
+func FormatDecimal(amount int64, minorUnits int) string {
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+	if minorUnits <= 0 {
+		return fmt.Sprintf("%s%d", sign, amount)
+	}
+	unit := int64(1)
+	for i := 0; i < minorUnits; i++ {
+		unit *= 10
+	}
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/unit, minorUnits, amount%unit)
+}
+
+// MinorAmount converts a whole amount of major units to minor units of a currency with `minorUnits` digits, e.g. 10 USD as 1000
+func MinorAmount(major int64, minorUnits int) int64 {
+	for i := 0; i < minorUnits; i++ {
+		major *= 10
+	}
+	return major
+}
+
+// CurrencyInfo is a currency as registered in the currency registry
+type CurrencyInfo struct {
+	Code       Currency `json:"code"`
+	ISONumber  int      `json:"iso_number"`
+	MinorUnits int      `json:"minor_units"` // number of digits after the decimal separator
+	Enabled    bool     `json:"enabled"`     // whether new accounts can be opened in the currency
+}
@@ -0,0 +1,122 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCurrency(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		currency string
+		want     Currency
+	}{
+		{
+			name:     "USD",
+			currency: "USD",
+			want:     CurrencyUSD,
+		},
+		{
+			name:     "EUR",
+			currency: "EUR",
+			want:     CurrencyEUR,
+		},
+		{
+			name:     "GBP",
+			currency: "GBP",
+			want:     CurrencyGBP,
+		},
+		{
+			name:     "RUB",
+			currency: "RUB",
+			want:     CurrencyRUB,
+		},
+		{
+			name:     "EGP",
+			currency: "EGP",
+			want:     CurrencyEGP,
+		},
+		{
+			name:     "lower case",
+			currency: "chf",
+			want:     "CHF",
+		},
+		{
+			name:     "spaces",
+			currency: " jpy ",
+			want:     "JPY",
+		},
+		{
+			name:     "empty",
+			currency: "",
+			want:     "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, ParseCurrency(tt.currency))
+		})
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		amount     int64
+		minorUnits int
+		currency   Currency
+		want       string
+	}{
+		{
+			name:       "two digits",
+			amount:     1250,
+			minorUnits: 2,
+			currency:   CurrencyUSD,
+			want:       "12.50 USD",
+		},
+		{
+			name:       "less than a major unit",
+			amount:     5,
+			minorUnits: 2,
+			currency:   CurrencyEUR,
+			want:       "0.05 EUR",
+		},
+		{
+			name:       "no minor units",
+			amount:     1500,
+			minorUnits: 0,
+			currency:   "JPY",
+			want:       "1500 JPY",
+		},
+		{
+			name:       "three digits",
+			amount:     1234,
+			minorUnits: 3,
+			currency:   "KWD",
+			want:       "1.234 KWD",
+		},
+		{
+			name:       "negative",
+			amount:     -1250,
+			minorUnits: 2,
+			currency:   CurrencyGBP,
+			want:       "-12.50 GBP",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, FormatAmount(tt.amount, tt.minorUnits, tt.currency))
+		})
+	}
+}
+
+func TestMinorAmount(t *testing.T) {
+	require.Equal(t, int64(1000), MinorAmount(10, 2))
+	require.Equal(t, int64(10), MinorAmount(10, 0))
+	require.Equal(t, int64(10000), MinorAmount(10, 3))
+}
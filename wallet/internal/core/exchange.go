@@ -0,0 +1,78 @@
+package core
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateQuoteParams struct {
+	UserID        int64
+	FromAccountID int64
+	ToAccountID   int64
+	Rate          string // decimal
+	Amount        int64  // in the source account's currency minor units
+	ToAmount      int64  // in the destination account's currency minor units
+	ExpiresAt     time.Time
+}
+
+// Quote is an exchange rate locked for a transfer between two accounts of different currencies
+type Quote struct {
+	ID            uuid.UUID `json:"id"`
+	UserID        int64     `json:"user_id"`
+	FromAccountID int64     `json:"from_account_id"`
+	ToAccountID   int64     `json:"to_account_id"`
+	Rate          string    `json:"rate"`      // decimal, destination currency units per one source currency unit
+	Amount        int64     `json:"amount"`    // debited from the source account, in its currency minor units
+	ToAmount      int64     `json:"to_amount"` // credited to the destination account, in its currency minor units
+	Currency      Currency  `json:"currency"`
+	ToCurrency    Currency  `json:"to_currency"`
+	ExpiresAt     time.Time `json:"expires_at"`
+	UsedAt        time.Time `json:"used_at"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// IsExpired reports whether the quote can no longer be used at the given time
+func (q Quote) IsExpired(now time.Time) bool {
+	return !now.Before(q.ExpiresAt)
+}
+
+// IsUsed reports whether a transfer was already made with the quote
+func (q Quote) IsUsed() bool {
+	return !q.UsedAt.IsZero()
+}
+
+// ConvertAmount converts an amount expressed in minor units of a currency with `fromMinorUnits` digits
+// to minor units of a currency with `toMinorUnits` digits using the decimal rate, the result is rounded half up
+// ok is false when the rate is not a valid positive decimal
+func ConvertAmount(amount int64, rate string, fromMinorUnits, toMinorUnits int) (converted int64, ok bool) {
+	r, ok := new(big.Rat).SetString(rate)
+	if !ok || r.Sign() <= 0 {
+		return 0, false
+	}
+	r.Mul(r, new(big.Rat).SetInt64(amount))
+	// Shift the minor units digits
+	shift := toMinorUnits - fromMinorUnits
+	scale := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(abs(shift))), nil))
+	if shift >= 0 {
+		r.Mul(r, scale)
+	} else {
+		r.Quo(r, scale)
+	}
+	// Round half up: floor((2 * num + den) / (2 * den))
+	num := new(big.Int).Add(new(big.Int).Mul(r.Num(), big.NewInt(2)), r.Denom())
+	den := new(big.Int).Mul(r.Denom(), big.NewInt(2))
+	res := new(big.Int).Div(num, den)
+	if !res.IsInt64() {
+		return 0, false
+	}
+	return res.Int64(), true
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
@@ -0,0 +1,97 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAmount(t *testing.T) {
+	tests := []struct {
+		name           string
+		amount         int64
+		rate           string
+		fromMinorUnits int
+		toMinorUnits   int
+		want           int64
+		wantOk         bool
+	}{
+		{
+			name:           "same exponent",
+			amount:         10000, // 100.00 USD
+			rate:           "0.92",
+			fromMinorUnits: 2,
+			toMinorUnits:   2,
+			want:           9200, // 92.00 EUR
+			wantOk:         true,
+		},
+		{
+			name:           "round half up",
+			amount:         1, // 0.01 USD
+			rate:           "0.5",
+			fromMinorUnits: 2,
+			toMinorUnits:   2,
+			want:           1,
+			wantOk:         true,
+		},
+		{
+			name:           "round down",
+			amount:         1,
+			rate:           "0.49",
+			fromMinorUnits: 2,
+			toMinorUnits:   2,
+			want:           0,
+			wantOk:         true,
+		},
+		{
+			name:           "to zero minor units",
+			amount:         1050, // 10.50 USD
+			rate:           "149.5",
+			fromMinorUnits: 2,
+			toMinorUnits:   0,
+			want:           1570, // 1569.75 JPY
+			wantOk:         true,
+		},
+		{
+			name:           "from zero minor units",
+			amount:         1000, // 1000 JPY
+			rate:           "0.0067",
+			fromMinorUnits: 0,
+			toMinorUnits:   2,
+			want:           670, // 6.70 USD
+			wantOk:         true,
+		},
+		{
+			name:           "invalid rate",
+			amount:         1000,
+			rate:           "abc",
+			fromMinorUnits: 2,
+			toMinorUnits:   2,
+			wantOk:         false,
+		},
+		{
+			name:           "non positive rate",
+			amount:         1000,
+			rate:           "0",
+			fromMinorUnits: 2,
+			toMinorUnits:   2,
+			wantOk:         false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ConvertAmount(tt.amount, tt.rate, tt.fromMinorUnits, tt.toMinorUnits)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestQuote_IsExpired(t *testing.T) {
+	now := time.Now()
+	require.False(t, Quote{ExpiresAt: now.Add(time.Second)}.IsExpired(now))
+	require.True(t, Quote{ExpiresAt: now}.IsExpired(now))
+	require.True(t, Quote{ExpiresAt: now.Add(-time.Second)}.IsExpired(now))
+}
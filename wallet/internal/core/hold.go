@@ -0,0 +1,46 @@
+package core
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type HoldStatus string
+
+const (
+	HoldStatusAuthorized HoldStatus = "authorized"
+	HoldStatusCaptured   HoldStatus = "captured"
+	HoldStatusVoided     HoldStatus = "voided"
+	HoldStatusExpired    HoldStatus = "expired"
+)
+
+type CreateHoldParams struct {
+	AccountID          int64
+	RecipientAccountID int64
+	Amount             int64 // in the account's currency minor units
+	ExpiresAt          time.Time
+	Card               string // token of the card authorizing the hold
+}
+
+// Hold is an amount reserved on an account for a later transfer to the recipient account
+// While authorized it reduces the account's available balance without moving money
+type Hold struct {
+	ID                 uuid.UUID  `json:"id"`
+	AccountID          int64      `json:"account_id"`
+	RecipientAccountID int64      `json:"recipient_account_id"`
+	Amount             int64      `json:"amount"`          // reserved amount, in the account's currency minor units
+	CapturedAmount     int64      `json:"captured_amount"` // transferred to the recipient on capture, the rest is released
+	Currency           Currency   `json:"currency"`
+	Status             HoldStatus `json:"status"`
+	TransactionID      uuid.UUID  `json:"transaction_id"` // transfer created on capture
+	ExpiresAt          time.Time  `json:"expires_at"`
+	CreatedAt          time.Time  `json:"created_at"`
+	UpdatedAt          time.Time  `json:"updated_at"`
+	Card               string     `json:"card"` // token of the card authorizing the hold, captures are debits of the card
+}
+
+// IsActive reports whether the hold still reserves its amount at the given time
+func (h Hold) IsActive(now time.Time) bool {
+	return h.Status == HoldStatusAuthorized && now.Before(h.ExpiresAt)
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHold_IsActive(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name string
+		hold Hold
+		want bool
+	}{
+		{
+			name: "authorized",
+			hold: Hold{Status: HoldStatusAuthorized, ExpiresAt: now.Add(time.Minute)},
+			want: true,
+		},
+		{
+			name: "authorized & expired",
+			hold: Hold{Status: HoldStatusAuthorized, ExpiresAt: now},
+			want: false,
+		},
+		{
+			name: "captured",
+			hold: Hold{Status: HoldStatusCaptured, ExpiresAt: now.Add(time.Minute)},
+			want: false,
+		},
+		{
+			name: "voided",
+			hold: Hold{Status: HoldStatusVoided, ExpiresAt: now.Add(time.Minute)},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.hold.IsActive(now))
+		})
+	}
+}
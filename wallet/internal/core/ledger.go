@@ -0,0 +1,11 @@
+package core
+
+import "time"
+
+// LedgerMismatch is an account whose stored balance disagrees with the sum of its ledger postings
+type LedgerMismatch struct {
+	AccountID     int64     `json:"account_id"`
+	StoredBalance int64     `json:"stored_balance"`
+	LedgerBalance int64     `json:"ledger_balance"`
+	DetectedAt    time.Time `json:"detected_at"`
+}
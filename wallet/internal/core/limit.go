@@ -0,0 +1,76 @@
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+// SpendingLimit bounds the amounts moved by an account or by one of its cards with one transaction type
+// Deposits count on the account they credit, the other types on the account they debit
+// Bounds are in the account's currency minor units, zero bounds are unlimited
+type SpendingLimit struct {
+	AccountID      int64           `json:"account_id"`  // set on account limits
+	CardNumber     string          `json:"card_number"` // set on card limits
+	Type           TransactionType `json:"type"`
+	PerTransaction int64           `json:"per_transaction"`
+	Daily          int64           `json:"daily"`   // since the start of the day in UTC
+	Monthly        int64           `json:"monthly"` // since the start of the month in UTC
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// Spending is the amount already moved within a limit's periods
+type Spending struct {
+	Day   int64
+	Month int64
+}
+
+// Check returns an error when moving the amount on top of what was already spent exceeds one of the limit's bounds
+func (l SpendingLimit) Check(amount int64, spent Spending) error {
+	subject := "account"
+	if l.CardNumber != "" {
+		subject = "card"
+	}
+	if l.PerTransaction > 0 && amount > l.PerTransaction {
+		return fmt.Errorf("%s %s limit per transaction exceeded, limit: %d, amount: %d", subject, l.Type, l.PerTransaction, amount)
+	}
+	if l.Daily > 0 && spent.Day+amount > l.Daily {
+		return fmt.Errorf("%s daily %s limit exceeded, limit: %d, remaining: %d", subject, l.Type, l.Daily, remaining(l.Daily, spent.Day))
+	}
+	if l.Monthly > 0 && spent.Month+amount > l.Monthly {
+		return fmt.Errorf("%s monthly %s limit exceeded, limit: %d, remaining: %d", subject, l.Type, l.Monthly, remaining(l.Monthly, spent.Month))
+	}
+	return nil
+}
+
+// Lowers reports whether none of the limit's bounds is higher than the current limit's one
+func (l SpendingLimit) Lowers(current SpendingLimit) bool {
+	return lowerBound(l.PerTransaction, current.PerTransaction) &&
+		lowerBound(l.Daily, current.Daily) &&
+		lowerBound(l.Monthly, current.Monthly)
+}
+
+func lowerBound(bound, current int64) bool {
+	if current == 0 {
+		return true
+	}
+	return bound > 0 && bound <= current
+}
+
+func remaining(limit, spent int64) int64 {
+	if spent >= limit {
+		return 0
+	}
+	return limit - spent
+}
+
+// StartOfDay returns the start of the day of t in UTC
+func StartOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// StartOfMonth returns the start of the month of t in UTC
+func StartOfMonth(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
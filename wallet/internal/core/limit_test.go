@@ -0,0 +1,103 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpendingLimit_Check(t *testing.T) {
+	limit := SpendingLimit{AccountID: 1, Type: TransactionTypeWithdrawal, PerTransaction: 500, Daily: 1000, Monthly: 5000}
+	tests := []struct {
+		name    string
+		limit   SpendingLimit
+		amount  int64
+		spent   Spending
+		wantErr bool
+	}{
+		{
+			name:   "within limits",
+			limit:  limit,
+			amount: 500,
+			spent:  Spending{Day: 500, Month: 4500},
+		},
+		{
+			name:    "per transaction exceeded",
+			limit:   limit,
+			amount:  501,
+			wantErr: true,
+		},
+		{
+			name:    "daily exceeded",
+			limit:   limit,
+			amount:  100,
+			spent:   Spending{Day: 901, Month: 901},
+			wantErr: true,
+		},
+		{
+			name:    "monthly exceeded",
+			limit:   limit,
+			amount:  100,
+			spent:   Spending{Month: 4901},
+			wantErr: true,
+		},
+		{
+			name:   "unlimited",
+			limit:  SpendingLimit{CardNumber: "4000", Type: TransactionTypeTransfer},
+			amount: 1_000_000,
+			spent:  Spending{Day: 1_000_000, Month: 1_000_000},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.limit.Check(tt.amount, tt.spent)
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSpendingLimit_Lowers(t *testing.T) {
+	current := SpendingLimit{PerTransaction: 500, Daily: 1000}
+	tests := []struct {
+		name  string
+		limit SpendingLimit
+		want  bool
+	}{
+		{
+			name:  "same limit",
+			limit: current,
+			want:  true,
+		},
+		{
+			name:  "lower bounds & new bound",
+			limit: SpendingLimit{PerTransaction: 100, Daily: 1000, Monthly: 2000},
+			want:  true,
+		},
+		{
+			name:  "higher bound",
+			limit: SpendingLimit{PerTransaction: 501, Daily: 1000},
+			want:  false,
+		},
+		{
+			name:  "removed bound",
+			limit: SpendingLimit{PerTransaction: 500},
+			want:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.limit.Lowers(current))
+		})
+	}
+}
+
+func TestStartOfPeriod(t *testing.T) {
+	now := time.Date(2023, time.March, 20, 1, 30, 0, 0, time.FixedZone("UTC+3", 3*60*60))
+	require.Equal(t, time.Date(2023, time.March, 19, 0, 0, 0, 0, time.UTC), StartOfDay(now))
+	require.Equal(t, time.Date(2023, time.March, 1, 0, 0, 0, 0, time.UTC), StartOfMonth(now))
+}
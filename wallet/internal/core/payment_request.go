@@ -0,0 +1,61 @@
+package core
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentRequestStatus string
+
+const (
+	PaymentRequestStatusPending   PaymentRequestStatus = "pending"
+	PaymentRequestStatusPaid      PaymentRequestStatus = "paid"
+	PaymentRequestStatusDeclined  PaymentRequestStatus = "declined"  // by the payer
+	PaymentRequestStatusCancelled PaymentRequestStatus = "cancelled" // by the requester
+	PaymentRequestStatusExpired   PaymentRequestStatus = "expired"
+)
+
+type CreatePaymentRequestParams struct {
+	RequesterID int64
+	PayerID     int64
+	ToAccountID int64
+	Amount      int64 // in `Currency` minor units
+	Currency    Currency
+	Note        string
+	ExpiresAt   time.Time
+}
+
+// PaymentRequest is money asked by a requester to a payer, paying it transfers the amount to the requester's account
+type PaymentRequest struct {
+	ID                  uuid.UUID            `json:"id"`
+	RequesterID         int64                `json:"requester_id"`
+	PayerID             int64                `json:"payer_id"`
+	RequesterExternalID uuid.UUID            `json:"requester_external_id"`
+	PayerExternalID     uuid.UUID            `json:"payer_external_id"`
+	ToAccountID         int64                `json:"to_account_id"`
+	Amount              int64                `json:"amount"`
+	Currency            Currency             `json:"currency"`
+	Note                string               `json:"note"`
+	Status              PaymentRequestStatus `json:"status"`
+	TransactionID       uuid.UUID            `json:"transaction_id"` // transfer created on payment
+	SplitID             uuid.UUID            `json:"split_id"`       // set on the shares of a split
+	ExpiresAt           time.Time            `json:"expires_at"`
+	CreatedAt           time.Time            `json:"created_at"`
+	UpdatedAt           time.Time            `json:"updated_at"`
+}
+
+// IsPending reports whether the request can still be paid, declined or cancelled at the given time
+func (pr PaymentRequest) IsPending(now time.Time) bool {
+	return pr.Status == PaymentRequestStatusPending && now.Before(pr.ExpiresAt)
+}
+
+// PaymentRequestMessage notifies the payer of a new payment request
+type PaymentRequestMessage struct {
+	Name          string `json:"name"`  // payer's name
+	Email         string `json:"email"` // payer's email
+	RequesterName string `json:"requester_name"`
+	Amount        string `json:"amount"` // formatted in the request's currency major units
+	Note          string `json:"note"`
+	ExpiresAt     string `json:"expires_at"`
+}
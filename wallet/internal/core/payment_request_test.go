@@ -0,0 +1,43 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaymentRequest_IsPending(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		request PaymentRequest
+		want    bool
+	}{
+		{
+			name:    "pending",
+			request: PaymentRequest{Status: PaymentRequestStatusPending, ExpiresAt: now.Add(time.Minute)},
+			want:    true,
+		},
+		{
+			name:    "pending & expired",
+			request: PaymentRequest{Status: PaymentRequestStatusPending, ExpiresAt: now},
+			want:    false,
+		},
+		{
+			name:    "paid",
+			request: PaymentRequest{Status: PaymentRequestStatusPaid, ExpiresAt: now.Add(time.Minute)},
+			want:    false,
+		},
+		{
+			name:    "declined",
+			request: PaymentRequest{Status: PaymentRequestStatusDeclined, ExpiresAt: now.Add(time.Minute)},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.request.IsPending(now))
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PotMove is the direction money moves between an account & one of its pots
+type PotMove string
+
+const (
+	PotMoveDeposit  PotMove = "deposit"  // from the account's balance into the pot
+	PotMoveWithdraw PotMove = "withdraw" // from the pot back into the account's balance
+)
+
+// Pot sets money aside within an account, in the account's currency
+// The money moved into a pot leaves the account's balance until it's moved back
+type Pot struct {
+	ID        uuid.UUID `json:"id"`
+	AccountID int64     `json:"account_id"`
+	Name      string    `json:"name"`
+	Balance   int64     `json:"balance"` // in the account's currency minor units
+	Currency  Currency  `json:"currency"`
+	// Optional savings goal
+	TargetAmount int64     `json:"target_amount"` // zero when unset
+	TargetDate   time.Time `json:"target_date"`   // zero when unset
+	CreatedAt    time.Time `json:"created_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// Progress returns the percent of the target amount saved, rounded down & capped at 100, zero without a target amount
+func (p Pot) Progress() int {
+	if p.TargetAmount <= 0 {
+		return 0
+	}
+	if p.Balance >= p.TargetAmount {
+		return 100
+	}
+	return int(p.Balance * 100 / p.TargetAmount)
+}
+
+type CreatePotParams struct {
+	AccountID    int64
+	Name         string
+	TargetAmount int64
+	TargetDate   time.Time
+}
+
+// UpdatePotParams replaces the pot's name & target, zero values unset the target
+type UpdatePotParams struct {
+	ID           uuid.UUID
+	Name         string
+	TargetAmount int64
+	TargetDate   time.Time
+}
+
+type MovePotMoneyParams struct {
+	PotID     uuid.UUID
+	AccountID int64
+	Amount    int64 // in the account's currency minor units
+	Move      PotMove
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPot_Progress(t *testing.T) {
+	tests := []struct {
+		name string
+		pot  Pot
+		want int
+	}{
+		{
+			name: "no target",
+			pot:  Pot{Balance: 500},
+			want: 0,
+		},
+		{
+			name: "empty",
+			pot:  Pot{TargetAmount: 1000},
+			want: 0,
+		},
+		{
+			name: "rounded down",
+			pot:  Pot{Balance: 333, TargetAmount: 1000},
+			want: 33,
+		},
+		{
+			name: "reached",
+			pot:  Pot{Balance: 1000, TargetAmount: 1000},
+			want: 100,
+		},
+		{
+			name: "exceeded",
+			pot:  Pot{Balance: 1500, TargetAmount: 1000},
+			want: 100,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.pot.Progress())
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package core
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// scheduleSearchYears bounds the search of the next run of cron expressions that rarely or never match, e.g. `0 0 30 2 *`
+const scheduleSearchYears = 5
+
+// Schedule tells when a standing order runs, either a cron expression or a day of the month
+// Schedules are evaluated in UTC, monthly schedules run at midnight and fall back to the last day of shorter months
+type Schedule struct {
+	Cron       string `json:"cron"`         // standard 5 fields expression: minute hour day-of-month month day-of-week
+	DayOfMonth int    `json:"day_of_month"` // 1 to 31
+}
+
+// ParseSchedule validates that exactly one of `cron` & `dayOfMonth` is set and returns the schedule
+func ParseSchedule(cron string, dayOfMonth int) (Schedule, error) {
+	cron = strings.TrimSpace(cron)
+	switch {
+	case cron != "" && dayOfMonth != 0:
+		return Schedule{}, fmt.Errorf("either a cron expression or a day of month must be set, not both")
+	case cron != "":
+		if _, err := parseCron(cron); err != nil {
+			return Schedule{}, err
+		}
+		return Schedule{Cron: cron}, nil
+	case dayOfMonth >= 1 && dayOfMonth <= 31:
+		return Schedule{DayOfMonth: dayOfMonth}, nil
+	case dayOfMonth != 0:
+		return Schedule{}, fmt.Errorf("day of month must be between 1 and 31, got %d", dayOfMonth)
+	default:
+		return Schedule{}, fmt.Errorf("either a cron expression or a day of month must be set")
+	}
+}
+
+// Next returns the first run strictly after `after`, the zero time is returned if the schedule never runs
+func (s Schedule) Next(after time.Time) time.Time {
+	after = after.UTC()
+	if s.Cron == "" {
+		return s.nextMonthly(after)
+	}
+	c, err := parseCron(s.Cron)
+	if err != nil {
+		return time.Time{}
+	}
+	return c.next(after)
+}
+
+func (s Schedule) nextMonthly(after time.Time) time.Time {
+	run := monthlyRun(after.Year(), after.Month(), s.DayOfMonth)
+	if !run.After(after) {
+		run = monthlyRun(after.Year(), after.Month()+1, s.DayOfMonth)
+	}
+	return run
+}
+
+// monthlyRun returns midnight of the day of the month, or of the month's last day if the month is shorter
+func monthlyRun(year int, month time.Month, day int) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return first.AddDate(0, 0, day-1)
+}
+
+// cron holds the allowed values of each field of a cron expression
+type cron struct {
+	minute, hour, dom, month, dow [64]bool
+	// day of month & day of week match when either does if both are restricted, as in standard cron
+	domAny, dowAny bool
+}
+
+func parseCron(expr string) (cron, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return cron{}, fmt.Errorf("cron expression must have 5 fields, got %d", len(fields))
+	}
+	var c cron
+	var err error
+	if c.minute, _, err = parseCronField(fields[0], 0, 59); err != nil {
+		return cron{}, fmt.Errorf("invalid minute field: %w", err)
+	}
+	if c.hour, _, err = parseCronField(fields[1], 0, 23); err != nil {
+		return cron{}, fmt.Errorf("invalid hour field: %w", err)
+	}
+	if c.dom, c.domAny, err = parseCronField(fields[2], 1, 31); err != nil {
+		return cron{}, fmt.Errorf("invalid day of month field: %w", err)
+	}
+	if c.month, _, err = parseCronField(fields[3], 1, 12); err != nil {
+		return cron{}, fmt.Errorf("invalid month field: %w", err)
+	}
+	if c.dow, c.dowAny, err = parseCronField(fields[4], 0, 7); err != nil {
+		return cron{}, fmt.Errorf("invalid day of week field: %w", err)
+	}
+	// 7 is another name for sunday
+	c.dow[0] = c.dow[0] || c.dow[7]
+	return c, nil
+}
+
+// parseCronField parses a comma separated list of `*`, `n` & `a-b` items, each optionally followed by a `/step`
+// wildcard is true when the field is a bare `*`
+func parseCronField(field string, min, max int) (values [64]bool, wildcard bool, err error) {
+	for _, item := range strings.Split(field, ",") {
+		rng, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			rng = item[:i]
+			if step, err = strconv.Atoi(item[i+1:]); err != nil || step < 1 {
+				return values, false, fmt.Errorf("invalid step in %q", item)
+			}
+		}
+		lo, hi := min, max
+		switch {
+		case rng == "*":
+			wildcard = field == "*"
+		case strings.Contains(rng, "-"):
+			bounds := strings.SplitN(rng, "-", 2)
+			if lo, err = strconv.Atoi(bounds[0]); err != nil {
+				return values, false, fmt.Errorf("invalid range %q", item)
+			}
+			if hi, err = strconv.Atoi(bounds[1]); err != nil {
+				return values, false, fmt.Errorf("invalid range %q", item)
+			}
+		default:
+			if lo, err = strconv.Atoi(rng); err != nil {
+				return values, false, fmt.Errorf("invalid value %q", item)
+			}
+			hi = lo
+			// `n/step` runs from n to the field's max
+			if step > 1 {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return values, false, fmt.Errorf("%q out of range %d-%d", item, min, max)
+		}
+		for v := lo; v <= hi; v += step {
+			values[v] = true
+		}
+	}
+	return values, wildcard, nil
+}
+
+func (c cron) matchDay(t time.Time) bool {
+	dom, dow := c.dom[t.Day()], c.dow[int(t.Weekday())]
+	if c.domAny || c.dowAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// next walks forward from `after`, skipping whole months, days & hours that can't match
+func (c cron) next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(scheduleSearchYears, 0, 0)
+	for t.Before(limit) {
+		switch {
+		case !c.month[int(t.Month())]:
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+		case !c.matchDay(t):
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+		case !c.hour[t.Hour()]:
+			t = t.Truncate(time.Hour).Add(time.Hour)
+		case !c.minute[t.Minute()]:
+			t = t.Add(time.Minute)
+		default:
+			return t
+		}
+	}
+	return time.Time{}
+}
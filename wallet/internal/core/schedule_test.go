@@ -0,0 +1,112 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSchedule(t *testing.T) {
+	tests := []struct {
+		name       string
+		cron       string
+		dayOfMonth int
+		wantErr    bool
+	}{
+		{name: "cron", cron: "0 9 * * 1-5"},
+		{name: "cron with steps & lists", cron: "*/15 0,12 1-7 */2 0"},
+		{name: "day of month", dayOfMonth: 31},
+		{name: "nothing set", wantErr: true},
+		{name: "both set", cron: "0 0 1 * *", dayOfMonth: 1, wantErr: true},
+		{name: "day of month out of range", dayOfMonth: 32, wantErr: true},
+		{name: "missing cron field", cron: "0 0 1 *", wantErr: true},
+		{name: "cron value out of range", cron: "60 0 1 * *", wantErr: true},
+		{name: "cron invalid step", cron: "*/0 0 1 * *", wantErr: true},
+		{name: "cron inverted range", cron: "0 0 10-5 * *", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSchedule(tt.cron, tt.dayOfMonth)
+			require.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	date := func(year int, month time.Month, day, hour, minute int) time.Time {
+		return time.Date(year, month, day, hour, minute, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name     string
+		schedule Schedule
+		after    time.Time
+		want     time.Time
+	}{
+		{
+			name:     "monthly later this month",
+			schedule: Schedule{DayOfMonth: 15},
+			after:    date(2023, time.March, 10, 8, 30),
+			want:     date(2023, time.March, 15, 0, 0),
+		},
+		{
+			name:     "monthly on the run is strictly after",
+			schedule: Schedule{DayOfMonth: 15},
+			after:    date(2023, time.March, 15, 0, 0),
+			want:     date(2023, time.April, 15, 0, 0),
+		},
+		{
+			name:     "monthly falls back to the last day of short months",
+			schedule: Schedule{DayOfMonth: 31},
+			after:    date(2023, time.January, 31, 0, 0),
+			want:     date(2023, time.February, 28, 0, 0),
+		},
+		{
+			name:     "monthly over the year end",
+			schedule: Schedule{DayOfMonth: 1},
+			after:    date(2023, time.December, 2, 0, 0),
+			want:     date(2024, time.January, 1, 0, 0),
+		},
+		{
+			name:     "cron every 15 minutes",
+			schedule: Schedule{Cron: "*/15 * * * *"},
+			after:    date(2023, time.March, 10, 8, 30),
+			want:     date(2023, time.March, 10, 8, 45),
+		},
+		{
+			name:     "cron weekdays at 9",
+			schedule: Schedule{Cron: "0 9 * * 1-5"},
+			after:    date(2023, time.March, 10, 9, 0), // friday
+			want:     date(2023, time.March, 13, 9, 0),
+		},
+		{
+			name:     "cron day of month or day of week",
+			schedule: Schedule{Cron: "0 0 20 * 0"},
+			after:    date(2023, time.March, 10, 0, 0),
+			want:     date(2023, time.March, 12, 0, 0), // sunday comes before the 20th
+		},
+		{
+			name:     "cron sunday as 7",
+			schedule: Schedule{Cron: "0 0 * * 7"},
+			after:    date(2023, time.March, 10, 0, 0),
+			want:     date(2023, time.March, 12, 0, 0),
+		},
+		{
+			name:     "cron leap day",
+			schedule: Schedule{Cron: "0 0 29 2 *"},
+			after:    date(2023, time.March, 1, 0, 0),
+			want:     date(2024, time.February, 29, 0, 0),
+		},
+		{
+			name:     "cron never runs",
+			schedule: Schedule{Cron: "0 0 30 2 *"},
+			after:    date(2023, time.March, 1, 0, 0),
+			want:     time.Time{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.schedule.Next(tt.after))
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SplitMethod string
+
+const (
+	SplitMethodEqual      SplitMethod = "equal"
+	SplitMethodPercentage SplitMethod = "percentage" // shares in basis points of the amount
+	SplitMethodAmount     SplitMethod = "amount"     // shares in minor units
+)
+
+// SplitBasisPoints is a whole split amount in basis points, i.e. 100%
+const SplitBasisPoints = 10000
+
+type CreateSplitParams struct {
+	RequesterID int64
+	ToAccountID int64
+	Amount      int64 // the whole bill in `Currency` minor units
+	Currency    Currency
+	Method      SplitMethod
+	Note        string
+	Shares      []SplitShare
+	ExpiresAt   time.Time // of the shares' payment requests
+}
+
+// SplitShare is the amount owed by a participant of a split
+type SplitShare struct {
+	PayerID int64
+	Amount  int64
+}
+
+// Split is a bill paid by a requester & split between other users
+// Each participant's share is a payment request, settling a share is paying its request
+type Split struct {
+	ID                  uuid.UUID        `json:"id"`
+	RequesterID         int64            `json:"requester_id"`
+	RequesterExternalID uuid.UUID        `json:"requester_external_id"`
+	ToAccountID         int64            `json:"to_account_id"`
+	Amount              int64            `json:"amount"`
+	Currency            Currency         `json:"currency"`
+	Method              SplitMethod      `json:"method"`
+	Note                string           `json:"note"`
+	Shares              []PaymentRequest `json:"shares"`
+	CreatedAt           time.Time        `json:"created_at"`
+}
+
+// RequesterShare returns the part of the bill not requested to any participant
+func (s Split) RequesterShare() int64 {
+	share := s.Amount
+	for _, pr := range s.Shares {
+		share -= pr.Amount
+	}
+	return share
+}
+
+// Outstanding returns the total of the shares that can still be settled at the given time
+func (s Split) Outstanding(now time.Time) int64 {
+	var total int64
+	for _, pr := range s.Shares {
+		if pr.IsPending(now) {
+			total += pr.Amount
+		}
+	}
+	return total
+}
+
+// Settled returns the total of the settled shares
+func (s Split) Settled() int64 {
+	var total int64
+	for _, pr := range s.Shares {
+		if pr.Status == PaymentRequestStatusPaid {
+			total += pr.Amount
+		}
+	}
+	return total
+}
+
+// ShareOf returns the share of a participant, false if the user doesn't take part in the split
+func (s Split) ShareOf(payerID int64) (PaymentRequest, bool) {
+	for _, pr := range s.Shares {
+		if pr.PayerID == payerID {
+			return pr, true
+		}
+	}
+	return PaymentRequest{}, false
+}
+
+// SplitShares returns the amount owed by each participant of a split of `amount`, weights has one entry per participant
+//   - equal: weights are ignored, the minor units left by the division are owed by the first participants
+//   - percentage: weights are basis points of `amount`, rounded by the largest remainder so that the shares add up exactly
+//   - amount: weights are the owed amounts
+//
+// When `includeRequester` is set the requester takes part in the split & keeps what isn't requested,
+// otherwise the shares must cover the whole amount
+func SplitShares(amount int64, method SplitMethod, weights []int64, includeRequester bool) ([]int64, error) {
+	if amount <= 0 {
+		return nil, fmt.Errorf("amount must be positive, got %d", amount)
+	}
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("a split needs at least one participant")
+	}
+	var shares []int64
+	switch method {
+	case SplitMethodEqual:
+		shares = splitEqually(amount, len(weights), includeRequester)
+	case SplitMethodPercentage:
+		total, err := sumSplitWeights(weights, SplitBasisPoints, includeRequester)
+		if err != nil {
+			return nil, fmt.Errorf("invalid percentages: %s", err)
+		}
+		shares = splitByBasisPoints(amount, weights, total)
+	case SplitMethodAmount:
+		if _, err := sumSplitWeights(weights, amount, includeRequester); err != nil {
+			return nil, fmt.Errorf("invalid amounts: %s", err)
+		}
+		shares = append(shares, weights...)
+	default:
+		return nil, fmt.Errorf("unknown split method %q", method)
+	}
+	for i, share := range shares {
+		if share <= 0 {
+			return nil, fmt.Errorf("share of participant %d is less than the smallest unit of the currency", i+1)
+		}
+	}
+	return shares, nil
+}
+
+func splitEqually(amount int64, participants int, includeRequester bool) []int64 {
+	n := int64(participants)
+	if includeRequester {
+		n++
+	}
+	share, rest := amount/n, amount%n
+	shares := make([]int64, participants)
+	for i := range shares {
+		shares[i] = share
+		if int64(i) < rest {
+			shares[i]++
+		}
+	}
+	return shares
+}
+
+// splitByBasisPoints shares floor(amount * total / SplitBasisPoints) in proportion to the weights
+func splitByBasisPoints(amount int64, weights []int64, total int64) []int64 {
+	// a*w/B is computed as (a/B)*w + (a%B)*w/B to not overflow on large amounts
+	mulDiv := func(w int64) (int64, int64) {
+		return amount/SplitBasisPoints*w + amount%SplitBasisPoints*w/SplitBasisPoints, amount % SplitBasisPoints * w % SplitBasisPoints
+	}
+	requested, _ := mulDiv(total)
+	shares := make([]int64, len(weights))
+	remainders := make([]int64, len(weights))
+	for i, w := range weights {
+		shares[i], remainders[i] = mulDiv(w)
+		requested -= shares[i]
+	}
+	// Give the units lost by rounding down to the largest remainders, the first participants win ties
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+	for i := 0; i < int(requested); i++ {
+		shares[order[i]]++
+	}
+	return shares
+}
+
+// sumSplitWeights checks that the weights are positive & add up to `whole`, or less than it when the requester takes part
+func sumSplitWeights(weights []int64, whole int64, includeRequester bool) (int64, error) {
+	var total int64
+	for i, w := range weights {
+		if w <= 0 {
+			return 0, fmt.Errorf("share of participant %d must be positive, got %d", i+1, w)
+		}
+		total += w
+		if total > whole {
+			return 0, fmt.Errorf("shares add up to more than %d", whole)
+		}
+	}
+	if includeRequester && total == whole {
+		return 0, fmt.Errorf("shares leave nothing to the requester, add up to %d", whole)
+	}
+	if !includeRequester && total != whole {
+		return 0, fmt.Errorf("shares must add up to %d, got %d", whole, total)
+	}
+	return total, nil
+}
@@ -0,0 +1,182 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitShares(t *testing.T) {
+	tests := []struct {
+		name             string
+		amount           int64
+		method           SplitMethod
+		weights          []int64
+		includeRequester bool
+		want             []int64
+		wantErr          bool
+	}{
+		{
+			name:    "equal",
+			amount:  900,
+			method:  SplitMethodEqual,
+			weights: make([]int64, 3),
+			want:    []int64{300, 300, 300},
+		},
+		{
+			name:    "equal with rest",
+			amount:  1000,
+			method:  SplitMethodEqual,
+			weights: make([]int64, 3),
+			want:    []int64{334, 333, 333},
+		},
+		{
+			name:             "equal with the requester",
+			amount:           1000,
+			method:           SplitMethodEqual,
+			weights:          make([]int64, 3),
+			includeRequester: true,
+			want:             []int64{250, 250, 250},
+		},
+		{
+			name:    "equal less than a unit each",
+			amount:  2,
+			method:  SplitMethodEqual,
+			weights: make([]int64, 3),
+			wantErr: true,
+		},
+		{
+			name:    "percentage",
+			amount:  1000,
+			method:  SplitMethodPercentage,
+			weights: []int64{5000, 3000, 2000},
+			want:    []int64{500, 300, 200},
+		},
+		{
+			name:    "percentage largest remainder",
+			amount:  100,
+			method:  SplitMethodPercentage,
+			weights: []int64{3333, 3333, 3334},
+			want:    []int64{33, 33, 34},
+		},
+		{
+			name:    "percentage ties go to the first participants",
+			amount:  101,
+			method:  SplitMethodPercentage,
+			weights: []int64{5000, 5000},
+			want:    []int64{51, 50},
+		},
+		{
+			name:             "percentage with the requester",
+			amount:           1000,
+			method:           SplitMethodPercentage,
+			weights:          []int64{2500, 2500},
+			includeRequester: true,
+			want:             []int64{250, 250},
+		},
+		{
+			name:    "percentage large amount",
+			amount:  900_000_000_000_000_000,
+			method:  SplitMethodPercentage,
+			weights: []int64{5000, 5000},
+			want:    []int64{450_000_000_000_000_000, 450_000_000_000_000_000},
+		},
+		{
+			name:    "percentage below 100%",
+			amount:  1000,
+			method:  SplitMethodPercentage,
+			weights: []int64{5000, 3000},
+			wantErr: true,
+		},
+		{
+			name:    "percentage above 100%",
+			amount:  1000,
+			method:  SplitMethodPercentage,
+			weights: []int64{5000, 6000},
+			wantErr: true,
+		},
+		{
+			name:             "percentage leaves nothing to the requester",
+			amount:           1000,
+			method:           SplitMethodPercentage,
+			weights:          []int64{5000, 5000},
+			includeRequester: true,
+			wantErr:          true,
+		},
+		{
+			name:    "amount",
+			amount:  1000,
+			method:  SplitMethodAmount,
+			weights: []int64{700, 300},
+			want:    []int64{700, 300},
+		},
+		{
+			name:             "amount with the requester",
+			amount:           1000,
+			method:           SplitMethodAmount,
+			weights:          []int64{200, 300},
+			includeRequester: true,
+			want:             []int64{200, 300},
+		},
+		{
+			name:    "amount not covering the bill",
+			amount:  1000,
+			method:  SplitMethodAmount,
+			weights: []int64{200, 300},
+			wantErr: true,
+		},
+		{
+			name:    "amount not positive",
+			amount:  1000,
+			method:  SplitMethodAmount,
+			weights: []int64{1000, 0},
+			wantErr: true,
+		},
+		{
+			name:    "no participants",
+			amount:  1000,
+			method:  SplitMethodEqual,
+			wantErr: true,
+		},
+		{
+			name:    "unknown method",
+			amount:  1000,
+			method:  "shares",
+			weights: make([]int64, 2),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SplitShares(tt.amount, tt.method, tt.weights, tt.includeRequester)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSplit_Totals(t *testing.T) {
+	now := time.Now()
+	split := Split{
+		Amount: 1000,
+		Shares: []PaymentRequest{
+			{PayerID: 1, Amount: 250, Status: PaymentRequestStatusPaid},
+			{PayerID: 2, Amount: 250, Status: PaymentRequestStatusPending, ExpiresAt: now.Add(time.Hour)},
+			{PayerID: 3, Amount: 200, Status: PaymentRequestStatusPending, ExpiresAt: now.Add(-time.Hour)},
+			{PayerID: 4, Amount: 100, Status: PaymentRequestStatusDeclined},
+		},
+	}
+	require.Equal(t, int64(200), split.RequesterShare())
+	require.Equal(t, int64(250), split.Outstanding(now))
+	require.Equal(t, int64(250), split.Settled())
+	share, ok := split.ShareOf(2)
+	require.True(t, ok)
+	require.Equal(t, int64(250), share.Amount)
+	_, ok = split.ShareOf(5)
+	require.False(t, ok)
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StandingOrderStatus string
+
+const (
+	StandingOrderStatusActive    StandingOrderStatus = "active"
+	StandingOrderStatusCompleted StandingOrderStatus = "completed" // no run left before the end date
+	StandingOrderStatusCancelled StandingOrderStatus = "cancelled"
+)
+
+type StandingOrderExecutionStatus string
+
+const (
+	StandingOrderExecutionStatusSucceeded StandingOrderExecutionStatus = "succeeded"
+	StandingOrderExecutionStatusFailed    StandingOrderExecutionStatus = "failed"
+)
+
+type CreateStandingOrderParams struct {
+	UserID    int64
+	FromCard  string
+	ToCard    string
+	Amount    int64 // in `Currency` minor units
+	Currency  Currency
+	Schedule  Schedule
+	EndDate   time.Time // zero for no end date
+	NextRunAt time.Time
+}
+
+type UpdateStandingOrderParams struct {
+	ID        uuid.UUID
+	Amount    int64
+	Schedule  Schedule
+	EndDate   time.Time
+	NextRunAt time.Time
+}
+
+// StandingOrder is a transfer between two cards repeated on a schedule on behalf of its owner
+type StandingOrder struct {
+	ID        uuid.UUID           `json:"id"`
+	UserID    int64               `json:"user_id"`
+	FromCard  string              `json:"from_card"`
+	ToCard    string              `json:"to_card"`
+	Amount    int64               `json:"amount"`
+	Currency  Currency            `json:"currency"`
+	Schedule  Schedule            `json:"schedule"`
+	EndDate   time.Time           `json:"end_date"`    // zero for no end date
+	NextRunAt time.Time           `json:"next_run_at"` // zero once the order is completed or cancelled
+	Status    StandingOrderStatus `json:"status"`
+	CreatedAt time.Time           `json:"created_at"`
+	UpdatedAt time.Time           `json:"updated_at"`
+	// Set on due orders only, the order is executed as this user
+	UserExternalID uuid.UUID `json:"user_external_id"`
+}
+
+// NextRun returns the first run of the order strictly after `after` and later than `now`, missed runs are skipped
+// ok is false when no run is left before the end date
+func (o StandingOrder) NextRun(after, now time.Time) (next time.Time, ok bool) {
+	next = o.Schedule.Next(after)
+	for !next.IsZero() && !next.After(now) {
+		next = o.Schedule.Next(next)
+	}
+	if next.IsZero() || (!o.EndDate.IsZero() && next.After(o.EndDate)) {
+		return time.Time{}, false
+	}
+	return next, true
+}
+
+type RecordStandingOrderExecutionParams struct {
+	StandingOrderID uuid.UUID
+	ScheduledAt     time.Time // the run executed, the order must still be due at this time
+	Status          StandingOrderExecutionStatus
+	TransactionID   uuid.UUID // set on successful executions
+	FailureReason   string    // set on failed executions
+	NextRunAt       time.Time // zero completes the order
+}
+
+// StandingOrderExecution is the outcome of one run of a standing order
+type StandingOrderExecution struct {
+	ID              uuid.UUID                    `json:"id"`
+	StandingOrderID uuid.UUID                    `json:"standing_order_id"`
+	ScheduledAt     time.Time                    `json:"scheduled_at"`
+	Status          StandingOrderExecutionStatus `json:"status"`
+	TransactionID   uuid.UUID                    `json:"transaction_id"`
+	FailureReason   string                       `json:"failure_reason"`
+	ExecutedAt      time.Time                    `json:"executed_at"`
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStandingOrder_NextRun(t *testing.T) {
+	now := time.Date(2023, time.March, 20, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		order  StandingOrder
+		after  time.Time
+		want   time.Time
+		wantOk bool
+	}{
+		{
+			name:   "next run",
+			order:  StandingOrder{Schedule: Schedule{DayOfMonth: 25}},
+			after:  time.Date(2023, time.February, 25, 0, 0, 0, 0, time.UTC),
+			want:   time.Date(2023, time.March, 25, 0, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "missed runs are skipped",
+			order:  StandingOrder{Schedule: Schedule{DayOfMonth: 1}},
+			after:  time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC),
+			want:   time.Date(2023, time.April, 1, 0, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "run on the end date",
+			order:  StandingOrder{Schedule: Schedule{DayOfMonth: 25}, EndDate: time.Date(2023, time.March, 25, 0, 0, 0, 0, time.UTC)},
+			after:  now,
+			want:   time.Date(2023, time.March, 25, 0, 0, 0, 0, time.UTC),
+			wantOk: true,
+		},
+		{
+			name:   "no run before the end date",
+			order:  StandingOrder{Schedule: Schedule{DayOfMonth: 25}, EndDate: time.Date(2023, time.March, 24, 0, 0, 0, 0, time.UTC)},
+			after:  now,
+			wantOk: false,
+		},
+		{
+			name:   "schedule never runs",
+			order:  StandingOrder{Schedule: Schedule{Cron: "0 0 30 2 *"}},
+			after:  now,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.order.NextRun(tt.after, now)
+			require.Equal(t, tt.wantOk, ok)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
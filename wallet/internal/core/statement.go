@@ -0,0 +1,86 @@
+package core
+
+import (
+	"fmt"
+	"time"
+)
+
+type StatementFormat string
+
+const (
+	StatementFormatCSV StatementFormat = "csv"
+	StatementFormatOFX StatementFormat = "ofx" // OFX 2 (XML), also read as QFX
+	StatementFormatPDF StatementFormat = "pdf"
+)
+
+// ContentType returns the media type of the statements rendered in the format
+func (f StatementFormat) ContentType() string {
+	switch f {
+	case StatementFormatCSV:
+		return "text/csv"
+	case StatementFormatOFX:
+		return "application/x-ofx"
+	case StatementFormatPDF:
+		return "application/pdf"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// StatementLine is a transaction of a statement as seen by the statement's account
+type StatementLine struct {
+	Transaction Transaction
+	Amount      int64  // credited to the account when positive, debited when negative, in the account's currency
+	Balance     int64  // running balance of the account right after the transaction
+	Description string // the other account's name, followed by the transaction's reason when set
+}
+
+// Statement is the history of an account over a period, from the opening balance to the closing balance
+type Statement struct {
+	Account        Account
+	From           time.Time // inclusive
+	To             time.Time // exclusive
+	OpeningBalance int64     // balance right before `From`
+	ClosingBalance int64     // balance right before `To`
+	Lines          []StatementLine
+	CreatedAt      time.Time
+}
+
+// StatementFile is a statement rendered in a format
+type StatementFile struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// NewStatement returns the statement of the account over the period, from its opening balance & its transactions in the period, oldest first
+func NewStatement(account Account, from, to time.Time, openingBalance int64, transactions []Transaction) Statement {
+	s := Statement{
+		Account:        account,
+		From:           from,
+		To:             to,
+		OpeningBalance: openingBalance,
+		ClosingBalance: openingBalance,
+		Lines:          make([]StatementLine, len(transactions)),
+	}
+	for i, t := range transactions {
+		amount, _ := t.AmountFor(account.ID)
+		counterparty := t.FromAccountName
+		if t.FromAccountID == account.ID {
+			amount, counterparty = -amount, t.ToAccountName
+		}
+		description := counterparty
+		if t.Reason != "" {
+			description += " - " + t.Reason
+		}
+		s.ClosingBalance += amount
+		s.Lines[i] = StatementLine{Transaction: t, Amount: amount, Balance: s.ClosingBalance, Description: description}
+	}
+	return s
+}
+
+// FileName returns the name of the file of the statement rendered in the format, the period's end date is inclusive
+func (s Statement) FileName(format StatementFormat) string {
+	return fmt.Sprintf("statement-%d-%s-%s.%s",
+		s.Account.ID, s.From.UTC().Format("2006-01-02"), s.To.UTC().Add(-time.Nanosecond).Format("2006-01-02"), format)
+}
@@ -0,0 +1,38 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStatement(t *testing.T) {
+	account := Account{ID: 1, Name: "checking", Currency: CurrencyEUR}
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	transactions := []Transaction{
+		{Type: TransactionTypeDeposit, Amount: 5000, Currency: CurrencyEUR, ToAccountID: 1, FromAccountName: "ATM", ToAccountName: "checking"},
+		{Type: TransactionTypeTransfer, Amount: 1200, Currency: CurrencyEUR, FromAccountID: 1, FromAccountName: "checking", ToAccountID: 2, ToAccountName: "rent", Reason: "march"},
+		// Cross-currency transfers are credited in the account's currency
+		{Type: TransactionTypeTransfer, Amount: 1000, Currency: CurrencyUSD, FromAccountID: 3, FromAccountName: "savings", ToAccountID: 1, ToAmount: 920, ToCurrency: CurrencyEUR},
+	}
+	s := NewStatement(account, from, to, 300, transactions)
+	require.Equal(t, int64(300), s.OpeningBalance)
+	require.Equal(t, int64(300+5000-1200+920), s.ClosingBalance)
+	require.Len(t, s.Lines, 3)
+	require.Equal(t, int64(5000), s.Lines[0].Amount)
+	require.Equal(t, int64(5300), s.Lines[0].Balance)
+	require.Equal(t, "ATM", s.Lines[0].Description)
+	require.Equal(t, int64(-1200), s.Lines[1].Amount)
+	require.Equal(t, int64(4100), s.Lines[1].Balance)
+	require.Equal(t, "rent - march", s.Lines[1].Description)
+	require.Equal(t, int64(920), s.Lines[2].Amount)
+	require.Equal(t, "savings", s.Lines[2].Description)
+	require.Equal(t, "statement-1-2024-03-01-2024-03-31.pdf", s.FileName(StatementFormatPDF))
+
+	// Periods without transactions close at their opening balance
+	s = NewStatement(account, from, to, 300, nil)
+	require.Equal(t, int64(300), s.ClosingBalance)
+	require.Empty(t, s.Lines)
+}
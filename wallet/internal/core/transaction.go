@@ -1,6 +1,10 @@
 package core
 
 import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math/big"
 	"strings"
 	"time"
 
@@ -13,6 +17,8 @@ const (
 	TransactionTypeTransfer   TransactionType = "transfer"
 	TransactionTypeDeposit    TransactionType = "deposit"
 	TransactionTypeWithdrawal TransactionType = "withdrawal"
+	TransactionTypeReversal   TransactionType = "reversal"
+	TransactionTypePot        TransactionType = "pot" // moves between an account & one of its pots
 )
 
 func ParseTransactionType(t string) TransactionType {
@@ -23,6 +29,10 @@ func ParseTransactionType(t string) TransactionType {
 		return TransactionTypeDeposit
 	case TransactionTypeWithdrawal.String():
 		return TransactionTypeWithdrawal
+	case TransactionTypeReversal.String():
+		return TransactionTypeReversal
+	case TransactionTypePot.String():
+		return TransactionTypePot
 	default:
 		return ""
 	}
@@ -33,36 +43,186 @@ func (t TransactionType) String() string {
 }
 
 type CreateTransactionParams struct {
-	Amount        float64
-	FromAccountID int64
-	ToAccountID   int64
+	Amount         int64 // in the source account's currency minor units
+	FromAccountID  int64
+	ToAccountID    int64
+	IdempotencyKey string
+	Reason         string // transfers only, the sender's note or the reference of a batch transfer item
+	Card           string // the card used to create the transaction, counted in the card's spending limits
+	// Set on cross-currency transfers only
+	ToAmount     int64  // credited to the destination account, in its currency minor units
+	ExchangeRate string // decimal
+	QuoteID      uuid.UUID
+}
+
+// ReverseTransactionParams moves back `Amount` of a transaction, expressed in the reversed transaction's `Amount` currency
+type ReverseTransactionParams struct {
+	TransactionID  uuid.UUID
+	Amount         int64
+	IdempotencyKey string
+	Reason         string
+}
+
+// TransactionDirection is the side of a transaction the viewing account is on
+type TransactionDirection string
+
+const (
+	TransactionDirectionIncoming TransactionDirection = "incoming" // credited to the account
+	TransactionDirectionOutgoing TransactionDirection = "outgoing" // debited from the account
+)
+
+// TransactionCursor is the position of a transaction in an account's history, ordered newest first
+// It's handed to clients as an opaque string, see `String` & `ParseTransactionCursor`
+type TransactionCursor struct {
+	CreatedAt time.Time
+	ID        uuid.UUID
+}
+
+// IsZero reports whether the cursor points before the newest transaction
+func (c TransactionCursor) IsZero() bool {
+	return c.ID == uuid.Nil
+}
+
+// String encodes the cursor, the creation time is kept to the microsecond like in the database
+func (c TransactionCursor) String() string {
+	if c.IsZero() {
+		return ""
+	}
+	b := make([]byte, 8, 8+len(c.ID))
+	binary.BigEndian.PutUint64(b, uint64(c.CreatedAt.UnixMicro()))
+	return base64.RawURLEncoding.EncodeToString(append(b, c.ID[:]...))
+}
+
+// ParseTransactionCursor decodes a cursor returned by `TransactionCursor.String`, empty strings are the zero cursor
+func ParseTransactionCursor(s string) (TransactionCursor, error) {
+	if s == "" {
+		return TransactionCursor{}, nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil || len(b) != 8+len(uuid.Nil) {
+		return TransactionCursor{}, fmt.Errorf("invalid transaction cursor")
+	}
+	id, err := uuid.FromBytes(b[8:])
+	if err != nil || id == uuid.Nil {
+		return TransactionCursor{}, fmt.Errorf("invalid transaction cursor")
+	}
+	return TransactionCursor{
+		CreatedAt: time.UnixMicro(int64(binary.BigEndian.Uint64(b[:8]))).UTC(),
+		ID:        id,
+	}, nil
+}
+
+// TransactionPage is a page of an account's history, newest first
+type TransactionPage struct {
+	Transactions []Transaction
+	Next         TransactionCursor // zero on the last page
 }
 
 type GetTransactionsParams struct {
 	AccountID int64
-	Offset    int32
+	After     TransactionCursor // the page starts after it, the zero cursor starts from the newest transaction
 	Limit     int32
 	// Additional filters
-	Type      TransactionType
-	MinAmount float64
-	MaxAmount float64
+	Type         TransactionType
+	MinAmount    int64
+	MaxAmount    int64
+	From         time.Time            // inclusive, zero is unbounded
+	To           time.Time            // exclusive, zero is unbounded
+	Direction    TransactionDirection // empty for both directions
+	Counterparty string               // part of the other account's name, case-insensitive, "ATM" on deposits & withdrawals
+	Category     Category             // the account's category, or the suggested one when the account didn't set any
+	Tag          string
 }
 
 type SendTransactionSmsParams struct {
-	CardNumber    string  `json:"card_number"`
-	RecipientName string  `json:"recipient_name"`
-	Amount        float64 `json:"amount"`
-	Balance       float64 `json:"balance"`
+	CardNumber    string   `json:"card_number"`
+	RecipientName string   `json:"recipient_name"`
+	Amount        int64    `json:"amount"`
+	Balance       int64    `json:"balance"`
+	Currency      Currency `json:"currency"`
 }
 
 type Transaction struct {
 	ID              uuid.UUID       `json:"id"`
-	Amount          float64         `json:"amount"`
+	Amount          int64           `json:"amount"` // in the currency's minor units
+	Currency        Currency        `json:"currency"`
 	Type            TransactionType `json:"type"`
 	FromAccountID   int64           `json:"from_account_id"`
 	FromAccountName string          `json:"from_account_name"`
 	ToAccountID     int64           `json:"to_account_id"`
 	ToAccountName   string          `json:"to_account_name"`
-	CreatedAt       time.Time       `json:"created_at"`
-	IsRolledBack    bool            `json:"is_rolled_back"`
+	// Amount credited to the destination account, differs from `Amount` on cross-currency transfers only
+	ToAmount     int64    `json:"to_amount"`
+	ToCurrency   Currency `json:"to_currency"`
+	ExchangeRate string   `json:"exchange_rate"` // decimal, empty when no conversion took place
+	// Balances of the involved accounts right after the transaction was applied
+	FromAccountBalance int64     `json:"from_account_balance"`
+	ToAccountBalance   int64     `json:"to_account_balance"`
+	CreatedAt          time.Time `json:"created_at"`
+	IsRolledBack       bool      `json:"is_rolled_back"` // fully reversed
+	// Client supplied key used to detect retried requests
+	IdempotencyKey string `json:"idempotency_key"`
+	// Sums of the reversals of the transaction so far, in the `Amount` & `ToAmount` currencies
+	ReversedAmount   int64 `json:"reversed_amount"`
+	ReversedToAmount int64 `json:"reversed_to_amount"`
+	// Set on reversals only
+	ReversedTransactionID uuid.UUID `json:"reversed_transaction_id"`
+	Reason                string    `json:"reason"` // the sender's note on transfers, the reason of reversals
+	// Set on pot moves only, the pot is named in place of the missing account
+	PotID uuid.UUID `json:"pot_id"`
+	// Labels private to the account viewing the history, set in history pages only
+	Category          Category `json:"category"`
+	CategorySuggested bool     `json:"category_suggested"` // the account didn't set a category, `Category` was suggested
+	Tags              []string `json:"tags"`
+}
+
+// Cursor returns the position of the transaction in the history of its accounts
+func (t Transaction) Cursor() TransactionCursor {
+	return TransactionCursor{CreatedAt: t.CreatedAt, ID: t.ID}
+}
+
+// InitiatorAccountID returns the id of the account whose card was used to create the transaction
+func (t Transaction) InitiatorAccountID() int64 {
+	if t.Type == TransactionTypeDeposit {
+		return t.ToAccountID
+	}
+	return t.FromAccountID
+}
+
+// AmountFor returns the amount moved in or out of the given account, in the account's currency
+func (t Transaction) AmountFor(accountID int64) (int64, Currency) {
+	if accountID == t.ToAccountID && t.ToCurrency != "" {
+		return t.ToAmount, t.ToCurrency
+	}
+	return t.Amount, t.Currency
+}
+
+// RefundableAmount returns the amount that can still be reversed, in the `Amount` currency
+func (t Transaction) RefundableAmount() int64 {
+	if t.Type == TransactionTypeReversal {
+		return 0
+	}
+	return t.Amount - t.ReversedAmount
+}
+
+// ReversalToAmount returns the amount to take back from the destination account, in its currency, when reversing `amount`
+// Cross-currency transfers are reversed at their original rate, rounded half up, the reversal of the whole refundable amount
+// takes back exactly what is left so rounding never leaks money
+func (t Transaction) ReversalToAmount(amount int64) int64 {
+	if amount >= t.RefundableAmount() {
+		return t.ToAmount - t.ReversedToAmount
+	}
+	// amount * ToAmount / Amount, rounded half up
+	num := new(big.Int).Mul(big.NewInt(amount), big.NewInt(t.ToAmount))
+	num.Mul(num, big.NewInt(2)).Add(num, big.NewInt(t.Amount))
+	den := new(big.Int).Mul(big.NewInt(t.Amount), big.NewInt(2))
+	return new(big.Int).Div(num, den).Int64()
+}
+
+// BalanceAfter returns the balance of the given account right after the transaction was applied
+func (t Transaction) BalanceAfter(accountID int64) int64 {
+	if accountID == t.FromAccountID {
+		return t.FromAccountBalance
+	}
+	return t.ToAccountBalance
 }
@@ -2,6 +2,9 @@ package core
 
 import (
 	"testing"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/stretchr/testify/require"
 )
@@ -33,6 +36,11 @@ func TestParseTransactionType(t *testing.T) {
 			t:    "DEPOSIT",
 			want: TransactionTypeDeposit,
 		},
+		{
+			name: "reversal upper case",
+			t:    "REVERSAL",
+			want: TransactionTypeReversal,
+		},
 		{
 			name: "withdrawal lower case",
 			t:    "withdrawal",
@@ -43,6 +51,11 @@ func TestParseTransactionType(t *testing.T) {
 			t:    "WITHDRAWAL",
 			want: TransactionTypeWithdrawal,
 		},
+		{
+			name: "pot lower case",
+			t:    "pot",
+			want: TransactionTypePot,
+		},
 		{
 			name: "empty",
 			t:    "",
@@ -61,3 +74,133 @@ func TestParseTransactionType(t *testing.T) {
 		})
 	}
 }
+
+func TestTransaction_InitiatorAccountID(t *testing.T) {
+	tests := []struct {
+		name string
+		t    Transaction
+		want int64
+	}{
+		{
+			name: "transfer",
+			t:    Transaction{Type: TransactionTypeTransfer, FromAccountID: 1, ToAccountID: 2},
+			want: 1,
+		},
+		{
+			name: "deposit",
+			t:    Transaction{Type: TransactionTypeDeposit, ToAccountID: 2},
+			want: 2,
+		},
+		{
+			name: "withdrawal",
+			t:    Transaction{Type: TransactionTypeWithdrawal, FromAccountID: 1},
+			want: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.t.InitiatorAccountID())
+		})
+	}
+}
+
+func TestTransaction_BalanceAfter(t *testing.T) {
+	transaction := Transaction{
+		FromAccountID:      1,
+		FromAccountBalance: 100,
+		ToAccountID:        2,
+		ToAccountBalance:   250,
+	}
+	require.Equal(t, int64(100), transaction.BalanceAfter(1))
+	require.Equal(t, int64(250), transaction.BalanceAfter(2))
+}
+
+func TestTransaction_AmountFor(t *testing.T) {
+	transaction := Transaction{
+		Amount:        10000,
+		Currency:      CurrencyUSD,
+		FromAccountID: 1,
+		ToAccountID:   2,
+		ToAmount:      9200,
+		ToCurrency:    CurrencyEUR,
+	}
+	amount, currency := transaction.AmountFor(1)
+	require.Equal(t, int64(10000), amount)
+	require.Equal(t, CurrencyUSD, currency)
+	amount, currency = transaction.AmountFor(2)
+	require.Equal(t, int64(9200), amount)
+	require.Equal(t, CurrencyEUR, currency)
+}
+
+func TestTransaction_RefundableAmount(t *testing.T) {
+	transfer := Transaction{Type: TransactionTypeTransfer, Amount: 1000, ReversedAmount: 300}
+	require.Equal(t, int64(700), transfer.RefundableAmount())
+	reversal := Transaction{Type: TransactionTypeReversal, Amount: 300}
+	require.Equal(t, int64(0), reversal.RefundableAmount())
+}
+
+func TestTransaction_ReversalToAmount(t *testing.T) {
+	tests := []struct {
+		name        string
+		transaction Transaction
+		amount      int64
+		want        int64
+	}{
+		{
+			name:        "same currency",
+			transaction: Transaction{Type: TransactionTypeTransfer, Amount: 1000, ToAmount: 1000},
+			amount:      300,
+			want:        300,
+		},
+		{
+			name:        "cross-currency partial",
+			transaction: Transaction{Type: TransactionTypeTransfer, Amount: 1000, ToAmount: 920},
+			amount:      333,
+			want:        306, // 306.36
+		},
+		{
+			name:        "cross-currency round half up",
+			transaction: Transaction{Type: TransactionTypeTransfer, Amount: 1000, ToAmount: 925},
+			amount:      2,
+			want:        2, // 1.85
+		},
+		{
+			name: "cross-currency remaining",
+			transaction: Transaction{
+				Type:             TransactionTypeTransfer,
+				Amount:           1000,
+				ToAmount:         920,
+				ReversedAmount:   333,
+				ReversedToAmount: 306,
+			},
+			amount: 667,
+			want:   614,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, tt.transaction.ReversalToAmount(tt.amount))
+		})
+	}
+}
+
+func TestTransactionCursor(t *testing.T) {
+	cursor := Transaction{
+		ID:        uuid.New(),
+		CreatedAt: time.Date(2024, 3, 1, 10, 30, 0, 123456000, time.UTC),
+	}.Cursor()
+	parsed, err := ParseTransactionCursor(cursor.String())
+	require.NoError(t, err)
+	require.Equal(t, cursor, parsed)
+	// Empty strings are the zero cursor
+	parsed, err = ParseTransactionCursor("")
+	require.NoError(t, err)
+	require.True(t, parsed.IsZero())
+	require.Empty(t, parsed.String())
+	// Tampered cursors are rejected
+	for _, s := range []string{"not a cursor", cursor.String()[1:], TransactionCursor{CreatedAt: time.Now()}.String() + "AAAA"} {
+		_, err = ParseTransactionCursor(s)
+		require.Error(t, err, s)
+	}
+}
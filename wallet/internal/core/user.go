@@ -0,0 +1,19 @@
+package core
+
+import "github.com/google/uuid"
+
+// User is a user registered in the auth service
+type User struct {
+	ID        uuid.UUID `json:"id"` // the user's external id
+	Username  string    `json:"username"`
+	FirstName string    `json:"first_name"`
+	LastName  string    `json:"last_name"`
+	Email     string    `json:"email"`
+}
+
+// LookupUserParams finds a user registered in the auth service, exactly one of the fields is set
+type LookupUserParams struct {
+	Username string
+	Email    string
+	UserID   uuid.UUID
+}
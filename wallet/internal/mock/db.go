@@ -6,7 +6,9 @@ package mock
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	sqlc "github.com/escalopa/fingo/wallet/internal/adapters/db/sql/sqlc"
 	gomock "github.com/golang/mock/gomock"
@@ -37,11 +39,12 @@ func (m *MockQuerier) EXPECT() *MockQuerierMockRecorder {
 }
 
 // AddAccountBalance mocks base method.
-func (m *MockQuerier) AddAccountBalance(ctx context.Context, db sqlc.DBTX, arg sqlc.AddAccountBalanceParams) error {
+func (m *MockQuerier) AddAccountBalance(ctx context.Context, db sqlc.DBTX, arg sqlc.AddAccountBalanceParams) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddAccountBalance", ctx, db, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // AddAccountBalance indicates an expected call of AddAccountBalance.
@@ -50,6 +53,123 @@ func (mr *MockQuerierMockRecorder) AddAccountBalance(ctx, db, arg interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountBalance", reflect.TypeOf((*MockQuerier)(nil).AddAccountBalance), ctx, db, arg)
 }
 
+// AddPotBalance mocks base method.
+func (m *MockQuerier) AddPotBalance(ctx context.Context, db sqlc.DBTX, arg sqlc.AddPotBalanceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPotBalance", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPotBalance indicates an expected call of AddPotBalance.
+func (mr *MockQuerierMockRecorder) AddPotBalance(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPotBalance", reflect.TypeOf((*MockQuerier)(nil).AddPotBalance), ctx, db, arg)
+}
+
+// AdvanceStandingOrder mocks base method.
+func (m *MockQuerier) AdvanceStandingOrder(ctx context.Context, db sqlc.DBTX, arg sqlc.AdvanceStandingOrderParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceStandingOrder", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceStandingOrder indicates an expected call of AdvanceStandingOrder.
+func (mr *MockQuerierMockRecorder) AdvanceStandingOrder(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceStandingOrder", reflect.TypeOf((*MockQuerier)(nil).AdvanceStandingOrder), ctx, db, arg)
+}
+
+// CancelStandingOrder mocks base method.
+func (m *MockQuerier) CancelStandingOrder(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelStandingOrder", ctx, db, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelStandingOrder indicates an expected call of CancelStandingOrder.
+func (mr *MockQuerierMockRecorder) CancelStandingOrder(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStandingOrder", reflect.TypeOf((*MockQuerier)(nil).CancelStandingOrder), ctx, db, id)
+}
+
+// CaptureHold mocks base method.
+func (m *MockQuerier) CaptureHold(ctx context.Context, db sqlc.DBTX, arg sqlc.CaptureHoldParams) (sqlc.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockQuerierMockRecorder) CaptureHold(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockQuerier)(nil).CaptureHold), ctx, db, arg)
+}
+
+// ClearDefaultAccount mocks base method.
+func (m *MockQuerier) ClearDefaultAccount(ctx context.Context, db sqlc.DBTX, arg sqlc.ClearDefaultAccountParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearDefaultAccount", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearDefaultAccount indicates an expected call of ClearDefaultAccount.
+func (mr *MockQuerierMockRecorder) ClearDefaultAccount(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearDefaultAccount", reflect.TypeOf((*MockQuerier)(nil).ClearDefaultAccount), ctx, db, arg)
+}
+
+// CloseAccount mocks base method.
+func (m *MockQuerier) CloseAccount(ctx context.Context, db sqlc.DBTX, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAccount", ctx, db, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseAccount indicates an expected call of CloseAccount.
+func (mr *MockQuerierMockRecorder) CloseAccount(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockQuerier)(nil).CloseAccount), ctx, db, id)
+}
+
+// ClosePaymentRequest mocks base method.
+func (m *MockQuerier) ClosePaymentRequest(ctx context.Context, db sqlc.DBTX, arg sqlc.ClosePaymentRequestParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePaymentRequest", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClosePaymentRequest indicates an expected call of ClosePaymentRequest.
+func (mr *MockQuerierMockRecorder) ClosePaymentRequest(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePaymentRequest", reflect.TypeOf((*MockQuerier)(nil).ClosePaymentRequest), ctx, db, arg)
+}
+
+// CopyCardLimits mocks base method.
+func (m *MockQuerier) CopyCardLimits(ctx context.Context, db sqlc.DBTX, arg sqlc.CopyCardLimitsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyCardLimits", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CopyCardLimits indicates an expected call of CopyCardLimits.
+func (mr *MockQuerierMockRecorder) CopyCardLimits(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyCardLimits", reflect.TypeOf((*MockQuerier)(nil).CopyCardLimits), ctx, db, arg)
+}
+
 // CreateAccount mocks base method.
 func (m *MockQuerier) CreateAccount(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateAccountParams) error {
 	m.ctrl.T.Helper()
@@ -78,308 +198,1431 @@ func (mr *MockQuerierMockRecorder) CreateCard(ctx, db, arg interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCard", reflect.TypeOf((*MockQuerier)(nil).CreateCard), ctx, db, arg)
 }
 
-// CreateDepositTransaction mocks base method.
-func (m *MockQuerier) CreateDepositTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateDepositTransactionParams) error {
+// CreateCardReveal mocks base method.
+func (m *MockQuerier) CreateCardReveal(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateCardRevealParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateDepositTransaction", ctx, db, arg)
+	ret := m.ctrl.Call(m, "CreateCardReveal", ctx, db, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// CreateCardReveal indicates an expected call of CreateCardReveal.
+func (mr *MockQuerierMockRecorder) CreateCardReveal(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCardReveal", reflect.TypeOf((*MockQuerier)(nil).CreateCardReveal), ctx, db, arg)
+}
+
+// CreateDepositTransaction mocks base method.
+func (m *MockQuerier) CreateDepositTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateDepositTransactionParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDepositTransaction", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // CreateDepositTransaction indicates an expected call of CreateDepositTransaction.
 func (mr *MockQuerierMockRecorder) CreateDepositTransaction(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDepositTransaction", reflect.TypeOf((*MockQuerier)(nil).CreateDepositTransaction), ctx, db, arg)
 }
 
-// CreateTransferTransaction mocks base method.
-func (m *MockQuerier) CreateTransferTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateTransferTransactionParams) error {
+// CreateHold mocks base method.
+func (m *MockQuerier) CreateHold(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateHoldParams) (sqlc.Hold, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateTransferTransaction", ctx, db, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateHold", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateTransferTransaction indicates an expected call of CreateTransferTransaction.
-func (mr *MockQuerierMockRecorder) CreateTransferTransaction(ctx, db, arg interface{}) *gomock.Call {
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockQuerierMockRecorder) CreateHold(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferTransaction", reflect.TypeOf((*MockQuerier)(nil).CreateTransferTransaction), ctx, db, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockQuerier)(nil).CreateHold), ctx, db, arg)
 }
 
-// CreateUser mocks base method.
-func (m *MockQuerier) CreateUser(ctx context.Context, db sqlc.DBTX, externalID uuid.UUID) error {
+// CreateJournalEntry mocks base method.
+func (m *MockQuerier) CreateJournalEntry(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateJournalEntryParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUser", ctx, db, externalID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateJournalEntry", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateUser indicates an expected call of CreateUser.
-func (mr *MockQuerierMockRecorder) CreateUser(ctx, db, externalID interface{}) *gomock.Call {
+// CreateJournalEntry indicates an expected call of CreateJournalEntry.
+func (mr *MockQuerierMockRecorder) CreateJournalEntry(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockQuerier)(nil).CreateUser), ctx, db, externalID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJournalEntry", reflect.TypeOf((*MockQuerier)(nil).CreateJournalEntry), ctx, db, arg)
 }
 
-// CreateWithdrawTransaction mocks base method.
-func (m *MockQuerier) CreateWithdrawTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateWithdrawTransactionParams) error {
+// CreatePaymentRequest mocks base method.
+func (m *MockQuerier) CreatePaymentRequest(ctx context.Context, db sqlc.DBTX, arg sqlc.CreatePaymentRequestParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateWithdrawTransaction", ctx, db, arg)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateWithdrawTransaction indicates an expected call of CreateWithdrawTransaction.
-func (mr *MockQuerierMockRecorder) CreateWithdrawTransaction(ctx, db, arg interface{}) *gomock.Call {
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockQuerierMockRecorder) CreatePaymentRequest(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithdrawTransaction", reflect.TypeOf((*MockQuerier)(nil).CreateWithdrawTransaction), ctx, db, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockQuerier)(nil).CreatePaymentRequest), ctx, db, arg)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockQuerier) DeleteAccount(ctx context.Context, db sqlc.DBTX, id int64) error {
+// CreatePosting mocks base method.
+func (m *MockQuerier) CreatePosting(ctx context.Context, db sqlc.DBTX, arg sqlc.CreatePostingParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", ctx, db, id)
+	ret := m.ctrl.Call(m, "CreatePosting", ctx, db, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockQuerierMockRecorder) DeleteAccount(ctx, db, id interface{}) *gomock.Call {
+// CreatePosting indicates an expected call of CreatePosting.
+func (mr *MockQuerierMockRecorder) CreatePosting(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockQuerier)(nil).DeleteAccount), ctx, db, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePosting", reflect.TypeOf((*MockQuerier)(nil).CreatePosting), ctx, db, arg)
 }
 
-// DeleteAccountCards mocks base method.
-func (m *MockQuerier) DeleteAccountCards(ctx context.Context, db sqlc.DBTX, accountID int64) error {
+// CreatePot mocks base method.
+func (m *MockQuerier) CreatePot(ctx context.Context, db sqlc.DBTX, arg sqlc.CreatePotParams) (sqlc.Pot, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccountCards", ctx, db, accountID)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreatePot", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteAccountCards indicates an expected call of DeleteAccountCards.
-func (mr *MockQuerierMockRecorder) DeleteAccountCards(ctx, db, accountID interface{}) *gomock.Call {
+// CreatePot indicates an expected call of CreatePot.
+func (mr *MockQuerierMockRecorder) CreatePot(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountCards", reflect.TypeOf((*MockQuerier)(nil).DeleteAccountCards), ctx, db, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePot", reflect.TypeOf((*MockQuerier)(nil).CreatePot), ctx, db, arg)
 }
 
-// DeleteCard mocks base method.
-func (m *MockQuerier) DeleteCard(ctx context.Context, db sqlc.DBTX, number string) error {
+// CreatePotTransaction mocks base method.
+func (m *MockQuerier) CreatePotTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.CreatePotTransactionParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteCard", ctx, db, number)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreatePotTransaction", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// DeleteCard indicates an expected call of DeleteCard.
-func (mr *MockQuerierMockRecorder) DeleteCard(ctx, db, number interface{}) *gomock.Call {
+// CreatePotTransaction indicates an expected call of CreatePotTransaction.
+func (mr *MockQuerierMockRecorder) CreatePotTransaction(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCard", reflect.TypeOf((*MockQuerier)(nil).DeleteCard), ctx, db, number)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePotTransaction", reflect.TypeOf((*MockQuerier)(nil).CreatePotTransaction), ctx, db, arg)
 }
 
-// GetAccount mocks base method.
-func (m *MockQuerier) GetAccount(ctx context.Context, db sqlc.DBTX, id int64) (sqlc.GetAccountRow, error) {
+// CreateQuote mocks base method.
+func (m *MockQuerier) CreateQuote(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateQuoteParams) (sqlc.ExchangeQuote, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccount", ctx, db, id)
-	ret0, _ := ret[0].(sqlc.GetAccountRow)
+	ret := m.ctrl.Call(m, "CreateQuote", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.ExchangeQuote)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccount indicates an expected call of GetAccount.
-func (mr *MockQuerierMockRecorder) GetAccount(ctx, db, id interface{}) *gomock.Call {
+// CreateQuote indicates an expected call of CreateQuote.
+func (mr *MockQuerierMockRecorder) CreateQuote(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockQuerier)(nil).GetAccount), ctx, db, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuote", reflect.TypeOf((*MockQuerier)(nil).CreateQuote), ctx, db, arg)
 }
 
-// GetAccountCards mocks base method.
-func (m *MockQuerier) GetAccountCards(ctx context.Context, db sqlc.DBTX, accountID int64) ([]sqlc.Card, error) {
+// CreateReversalTransaction mocks base method.
+func (m *MockQuerier) CreateReversalTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateReversalTransactionParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccountCards", ctx, db, accountID)
-	ret0, _ := ret[0].([]sqlc.Card)
+	ret := m.ctrl.Call(m, "CreateReversalTransaction", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccountCards indicates an expected call of GetAccountCards.
-func (mr *MockQuerierMockRecorder) GetAccountCards(ctx, db, accountID interface{}) *gomock.Call {
+// CreateReversalTransaction indicates an expected call of CreateReversalTransaction.
+func (mr *MockQuerierMockRecorder) CreateReversalTransaction(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountCards", reflect.TypeOf((*MockQuerier)(nil).GetAccountCards), ctx, db, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReversalTransaction", reflect.TypeOf((*MockQuerier)(nil).CreateReversalTransaction), ctx, db, arg)
 }
 
-// GetAccounts mocks base method.
-func (m *MockQuerier) GetAccounts(ctx context.Context, db sqlc.DBTX, userID int64) ([]sqlc.GetAccountsRow, error) {
+// CreateSplit mocks base method.
+func (m *MockQuerier) CreateSplit(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateSplitParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAccounts", ctx, db, userID)
-	ret0, _ := ret[0].([]sqlc.GetAccountsRow)
+	ret := m.ctrl.Call(m, "CreateSplit", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAccounts indicates an expected call of GetAccounts.
-func (mr *MockQuerierMockRecorder) GetAccounts(ctx, db, userID interface{}) *gomock.Call {
+// CreateSplit indicates an expected call of CreateSplit.
+func (mr *MockQuerierMockRecorder) CreateSplit(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockQuerier)(nil).GetAccounts), ctx, db, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSplit", reflect.TypeOf((*MockQuerier)(nil).CreateSplit), ctx, db, arg)
 }
 
-// GetCard mocks base method.
-func (m *MockQuerier) GetCard(ctx context.Context, db sqlc.DBTX, number string) (sqlc.Card, error) {
+// CreateStandingOrder mocks base method.
+func (m *MockQuerier) CreateStandingOrder(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateStandingOrderParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCard", ctx, db, number)
-	ret0, _ := ret[0].(sqlc.Card)
+	ret := m.ctrl.Call(m, "CreateStandingOrder", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCard indicates an expected call of GetCard.
-func (mr *MockQuerierMockRecorder) GetCard(ctx, db, number interface{}) *gomock.Call {
+// CreateStandingOrder indicates an expected call of CreateStandingOrder.
+func (mr *MockQuerierMockRecorder) CreateStandingOrder(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCard", reflect.TypeOf((*MockQuerier)(nil).GetCard), ctx, db, number)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStandingOrder", reflect.TypeOf((*MockQuerier)(nil).CreateStandingOrder), ctx, db, arg)
 }
 
-// GetCardAccount mocks base method.
-func (m *MockQuerier) GetCardAccount(ctx context.Context, db sqlc.DBTX, number string) (sqlc.GetCardAccountRow, error) {
+// CreateStandingOrderExecution mocks base method.
+func (m *MockQuerier) CreateStandingOrderExecution(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateStandingOrderExecutionParams) (sqlc.StandingOrderExecution, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCardAccount", ctx, db, number)
-	ret0, _ := ret[0].(sqlc.GetCardAccountRow)
+	ret := m.ctrl.Call(m, "CreateStandingOrderExecution", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.StandingOrderExecution)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCardAccount indicates an expected call of GetCardAccount.
-func (mr *MockQuerierMockRecorder) GetCardAccount(ctx, db, number interface{}) *gomock.Call {
+// CreateStandingOrderExecution indicates an expected call of CreateStandingOrderExecution.
+func (mr *MockQuerierMockRecorder) CreateStandingOrderExecution(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardAccount", reflect.TypeOf((*MockQuerier)(nil).GetCardAccount), ctx, db, number)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStandingOrderExecution", reflect.TypeOf((*MockQuerier)(nil).CreateStandingOrderExecution), ctx, db, arg)
 }
 
-// GetCardBalance mocks base method.
-func (m *MockQuerier) GetCardBalance(ctx context.Context, db sqlc.DBTX, number string) (float64, error) {
+// CreateTransactionTag mocks base method.
+func (m *MockQuerier) CreateTransactionTag(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateTransactionTagParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCardBalance", ctx, db, number)
-	ret0, _ := ret[0].(float64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateTransactionTag", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetCardBalance indicates an expected call of GetCardBalance.
-func (mr *MockQuerierMockRecorder) GetCardBalance(ctx, db, number interface{}) *gomock.Call {
+// CreateTransactionTag indicates an expected call of CreateTransactionTag.
+func (mr *MockQuerierMockRecorder) CreateTransactionTag(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardBalance", reflect.TypeOf((*MockQuerier)(nil).GetCardBalance), ctx, db, number)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionTag", reflect.TypeOf((*MockQuerier)(nil).CreateTransactionTag), ctx, db, arg)
 }
 
-// GetCurrencyByID mocks base method.
-func (m *MockQuerier) GetCurrencyByID(ctx context.Context, db sqlc.DBTX, id int64) (sqlc.Currency, error) {
+// CreateTransferTransaction mocks base method.
+func (m *MockQuerier) CreateTransferTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateTransferTransactionParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCurrencyByID", ctx, db, id)
-	ret0, _ := ret[0].(sqlc.Currency)
+	ret := m.ctrl.Call(m, "CreateTransferTransaction", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCurrencyByID indicates an expected call of GetCurrencyByID.
-func (mr *MockQuerierMockRecorder) GetCurrencyByID(ctx, db, id interface{}) *gomock.Call {
+// CreateTransferTransaction indicates an expected call of CreateTransferTransaction.
+func (mr *MockQuerierMockRecorder) CreateTransferTransaction(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencyByID", reflect.TypeOf((*MockQuerier)(nil).GetCurrencyByID), ctx, db, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferTransaction", reflect.TypeOf((*MockQuerier)(nil).CreateTransferTransaction), ctx, db, arg)
 }
 
-// GetCurrencyByName mocks base method.
-func (m *MockQuerier) GetCurrencyByName(ctx context.Context, db sqlc.DBTX, name string) (int64, error) {
+// CreateUser mocks base method.
+func (m *MockQuerier) CreateUser(ctx context.Context, db sqlc.DBTX, externalID uuid.UUID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCurrencyByName", ctx, db, name)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "CreateUser", ctx, db, externalID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetCurrencyByName indicates an expected call of GetCurrencyByName.
-func (mr *MockQuerierMockRecorder) GetCurrencyByName(ctx, db, name interface{}) *gomock.Call {
+// CreateUser indicates an expected call of CreateUser.
+func (mr *MockQuerierMockRecorder) CreateUser(ctx, db, externalID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencyByName", reflect.TypeOf((*MockQuerier)(nil).GetCurrencyByName), ctx, db, name)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockQuerier)(nil).CreateUser), ctx, db, externalID)
 }
 
-// GetTransaction mocks base method.
-func (m *MockQuerier) GetTransaction(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetTransactionRow, error) {
+// CreateWithdrawTransaction mocks base method.
+func (m *MockQuerier) CreateWithdrawTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.CreateWithdrawTransactionParams) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransaction", ctx, db, id)
-	ret0, _ := ret[0].(sqlc.GetTransactionRow)
+	ret := m.ctrl.Call(m, "CreateWithdrawTransaction", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetTransaction indicates an expected call of GetTransaction.
-func (mr *MockQuerierMockRecorder) GetTransaction(ctx, db, id interface{}) *gomock.Call {
+// CreateWithdrawTransaction indicates an expected call of CreateWithdrawTransaction.
+func (mr *MockQuerierMockRecorder) CreateWithdrawTransaction(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockQuerier)(nil).GetTransaction), ctx, db, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithdrawTransaction", reflect.TypeOf((*MockQuerier)(nil).CreateWithdrawTransaction), ctx, db, arg)
 }
 
-// GetTransactions mocks base method.
-func (m *MockQuerier) GetTransactions(ctx context.Context, db sqlc.DBTX, arg sqlc.GetTransactionsParams) ([]sqlc.GetTransactionsRow, error) {
+// DeleteAccountCards mocks base method.
+func (m *MockQuerier) DeleteAccountCards(ctx context.Context, db sqlc.DBTX, accountID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTransactions", ctx, db, arg)
-	ret0, _ := ret[0].([]sqlc.GetTransactionsRow)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeleteAccountCards", ctx, db, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// GetTransactions indicates an expected call of GetTransactions.
-func (mr *MockQuerierMockRecorder) GetTransactions(ctx, db, arg interface{}) *gomock.Call {
+// DeleteAccountCards indicates an expected call of DeleteAccountCards.
+func (mr *MockQuerierMockRecorder) DeleteAccountCards(ctx, db, accountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockQuerier)(nil).GetTransactions), ctx, db, arg)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountCards", reflect.TypeOf((*MockQuerier)(nil).DeleteAccountCards), ctx, db, accountID)
 }
 
-// GetUserByExternalID mocks base method.
-func (m *MockQuerier) GetUserByExternalID(ctx context.Context, db sqlc.DBTX, externalID uuid.UUID) (int64, error) {
+// DeleteBudget mocks base method.
+func (m *MockQuerier) DeleteBudget(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteBudgetParams) (int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByExternalID", ctx, db, externalID)
+	ret := m.ctrl.Call(m, "DeleteBudget", ctx, db, arg)
 	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserByExternalID indicates an expected call of GetUserByExternalID.
-func (mr *MockQuerierMockRecorder) GetUserByExternalID(ctx, db, externalID interface{}) *gomock.Call {
+// DeleteBudget indicates an expected call of DeleteBudget.
+func (mr *MockQuerierMockRecorder) DeleteBudget(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByExternalID", reflect.TypeOf((*MockQuerier)(nil).GetUserByExternalID), ctx, db, externalID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockQuerier)(nil).DeleteBudget), ctx, db, arg)
 }
 
-// GetUserCards mocks base method.
-func (m *MockQuerier) GetUserCards(ctx context.Context, db sqlc.DBTX, userID int64) ([]sqlc.GetUserCardsRow, error) {
+// DeleteCard mocks base method.
+func (m *MockQuerier) DeleteCard(ctx context.Context, db sqlc.DBTX, number string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserCards", ctx, db, userID)
-	ret0, _ := ret[0].([]sqlc.GetUserCardsRow)
+	ret := m.ctrl.Call(m, "DeleteCard", ctx, db, number)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCard indicates an expected call of DeleteCard.
+func (mr *MockQuerierMockRecorder) DeleteCard(ctx, db, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCard", reflect.TypeOf((*MockQuerier)(nil).DeleteCard), ctx, db, number)
+}
+
+// DeletePot mocks base method.
+func (m *MockQuerier) DeletePot(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePot", ctx, db, id)
+	ret0, _ := ret[0].(int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetUserCards indicates an expected call of GetUserCards.
-func (mr *MockQuerierMockRecorder) GetUserCards(ctx, db, userID interface{}) *gomock.Call {
+// DeletePot indicates an expected call of DeletePot.
+func (mr *MockQuerierMockRecorder) DeletePot(ctx, db, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCards", reflect.TypeOf((*MockQuerier)(nil).GetUserCards), ctx, db, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePot", reflect.TypeOf((*MockQuerier)(nil).DeletePot), ctx, db, id)
 }
 
-// SetTransactionRolledBack mocks base method.
-func (m *MockQuerier) SetTransactionRolledBack(ctx context.Context, db sqlc.DBTX, id uuid.UUID) error {
+// DeleteResolvedLedgerMismatches mocks base method.
+func (m *MockQuerier) DeleteResolvedLedgerMismatches(ctx context.Context, db sqlc.DBTX) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetTransactionRolledBack", ctx, db, id)
+	ret := m.ctrl.Call(m, "DeleteResolvedLedgerMismatches", ctx, db)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SetTransactionRolledBack indicates an expected call of SetTransactionRolledBack.
-func (mr *MockQuerierMockRecorder) SetTransactionRolledBack(ctx, db, id interface{}) *gomock.Call {
+// DeleteResolvedLedgerMismatches indicates an expected call of DeleteResolvedLedgerMismatches.
+func (mr *MockQuerierMockRecorder) DeleteResolvedLedgerMismatches(ctx, db interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionRolledBack", reflect.TypeOf((*MockQuerier)(nil).SetTransactionRolledBack), ctx, db, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResolvedLedgerMismatches", reflect.TypeOf((*MockQuerier)(nil).DeleteResolvedLedgerMismatches), ctx, db)
 }
 
-// SubAccountBalance mocks base method.
-func (m *MockQuerier) SubAccountBalance(ctx context.Context, db sqlc.DBTX, arg sqlc.SubAccountBalanceParams) error {
+// DeleteTransactionCategory mocks base method.
+func (m *MockQuerier) DeleteTransactionCategory(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteTransactionCategoryParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubAccountBalance", ctx, db, arg)
+	ret := m.ctrl.Call(m, "DeleteTransactionCategory", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransactionCategory indicates an expected call of DeleteTransactionCategory.
+func (mr *MockQuerierMockRecorder) DeleteTransactionCategory(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionCategory", reflect.TypeOf((*MockQuerier)(nil).DeleteTransactionCategory), ctx, db, arg)
+}
+
+// DeleteTransactionTags mocks base method.
+func (m *MockQuerier) DeleteTransactionTags(ctx context.Context, db sqlc.DBTX, arg sqlc.DeleteTransactionTagsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionTags", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTransactionTags indicates an expected call of DeleteTransactionTags.
+func (mr *MockQuerierMockRecorder) DeleteTransactionTags(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionTags", reflect.TypeOf((*MockQuerier)(nil).DeleteTransactionTags), ctx, db, arg)
+}
+
+// ExpireHolds mocks base method.
+func (m *MockQuerier) ExpireHolds(ctx context.Context, db sqlc.DBTX) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHolds", ctx, db)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHolds indicates an expected call of ExpireHolds.
+func (mr *MockQuerierMockRecorder) ExpireHolds(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHolds", reflect.TypeOf((*MockQuerier)(nil).ExpireHolds), ctx, db)
+}
+
+// ExpirePaymentRequests mocks base method.
+func (m *MockQuerier) ExpirePaymentRequests(ctx context.Context, db sqlc.DBTX) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePaymentRequests", ctx, db)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePaymentRequests indicates an expected call of ExpirePaymentRequests.
+func (mr *MockQuerierMockRecorder) ExpirePaymentRequests(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePaymentRequests", reflect.TypeOf((*MockQuerier)(nil).ExpirePaymentRequests), ctx, db)
+}
+
+// FlagLedgerMismatches mocks base method.
+func (m *MockQuerier) FlagLedgerMismatches(ctx context.Context, db sqlc.DBTX) ([]sqlc.LedgerMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FlagLedgerMismatches", ctx, db)
+	ret0, _ := ret[0].([]sqlc.LedgerMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FlagLedgerMismatches indicates an expected call of FlagLedgerMismatches.
+func (mr *MockQuerierMockRecorder) FlagLedgerMismatches(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FlagLedgerMismatches", reflect.TypeOf((*MockQuerier)(nil).FlagLedgerMismatches), ctx, db)
+}
+
+// GetAccount mocks base method.
+func (m *MockQuerier) GetAccount(ctx context.Context, db sqlc.DBTX, id int64) (sqlc.GetAccountRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccount", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetAccountRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccount indicates an expected call of GetAccount.
+func (mr *MockQuerierMockRecorder) GetAccount(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockQuerier)(nil).GetAccount), ctx, db, id)
+}
+
+// GetAccountBalanceAt mocks base method.
+func (m *MockQuerier) GetAccountBalanceAt(ctx context.Context, db sqlc.DBTX, arg sqlc.GetAccountBalanceAtParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalanceAt", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalanceAt indicates an expected call of GetAccountBalanceAt.
+func (mr *MockQuerierMockRecorder) GetAccountBalanceAt(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalanceAt", reflect.TypeOf((*MockQuerier)(nil).GetAccountBalanceAt), ctx, db, arg)
+}
+
+// GetAccountBudgets mocks base method.
+func (m *MockQuerier) GetAccountBudgets(ctx context.Context, db sqlc.DBTX, accountID int64) ([]sqlc.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBudgets", ctx, db, accountID)
+	ret0, _ := ret[0].([]sqlc.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBudgets indicates an expected call of GetAccountBudgets.
+func (mr *MockQuerierMockRecorder) GetAccountBudgets(ctx, db, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBudgets", reflect.TypeOf((*MockQuerier)(nil).GetAccountBudgets), ctx, db, accountID)
+}
+
+// GetAccountCards mocks base method.
+func (m *MockQuerier) GetAccountCards(ctx context.Context, db sqlc.DBTX, accountID int64) ([]sqlc.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountCards", ctx, db, accountID)
+	ret0, _ := ret[0].([]sqlc.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountCards indicates an expected call of GetAccountCards.
+func (mr *MockQuerierMockRecorder) GetAccountCards(ctx, db, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountCards", reflect.TypeOf((*MockQuerier)(nil).GetAccountCards), ctx, db, accountID)
+}
+
+// GetAccountLimits mocks base method.
+func (m *MockQuerier) GetAccountLimits(ctx context.Context, db sqlc.DBTX, accountID sql.NullInt64) ([]sqlc.SpendingLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountLimits", ctx, db, accountID)
+	ret0, _ := ret[0].([]sqlc.SpendingLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountLimits indicates an expected call of GetAccountLimits.
+func (mr *MockQuerierMockRecorder) GetAccountLimits(ctx, db, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountLimits", reflect.TypeOf((*MockQuerier)(nil).GetAccountLimits), ctx, db, accountID)
+}
+
+// GetAccountPots mocks base method.
+func (m *MockQuerier) GetAccountPots(ctx context.Context, db sqlc.DBTX, accountID int64) ([]sqlc.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountPots", ctx, db, accountID)
+	ret0, _ := ret[0].([]sqlc.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountPots indicates an expected call of GetAccountPots.
+func (mr *MockQuerierMockRecorder) GetAccountPots(ctx, db, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountPots", reflect.TypeOf((*MockQuerier)(nil).GetAccountPots), ctx, db, accountID)
+}
+
+// GetAccountSpending mocks base method.
+func (m *MockQuerier) GetAccountSpending(ctx context.Context, db sqlc.DBTX, arg sqlc.GetAccountSpendingParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountSpending", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountSpending indicates an expected call of GetAccountSpending.
+func (mr *MockQuerierMockRecorder) GetAccountSpending(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSpending", reflect.TypeOf((*MockQuerier)(nil).GetAccountSpending), ctx, db, arg)
+}
+
+// GetAccountSystemAccount mocks base method.
+func (m *MockQuerier) GetAccountSystemAccount(ctx context.Context, db sqlc.DBTX, arg sqlc.GetAccountSystemAccountParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountSystemAccount", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountSystemAccount indicates an expected call of GetAccountSystemAccount.
+func (mr *MockQuerierMockRecorder) GetAccountSystemAccount(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountSystemAccount", reflect.TypeOf((*MockQuerier)(nil).GetAccountSystemAccount), ctx, db, arg)
+}
+
+// GetAccounts mocks base method.
+func (m *MockQuerier) GetAccounts(ctx context.Context, db sqlc.DBTX, userID int64) ([]sqlc.GetAccountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccounts", ctx, db, userID)
+	ret0, _ := ret[0].([]sqlc.GetAccountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccounts indicates an expected call of GetAccounts.
+func (mr *MockQuerierMockRecorder) GetAccounts(ctx, db, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockQuerier)(nil).GetAccounts), ctx, db, userID)
+}
+
+// GetBudgets mocks base method.
+func (m *MockQuerier) GetBudgets(ctx context.Context, db sqlc.DBTX, arg sqlc.GetBudgetsParams) ([]sqlc.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgets", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgets indicates an expected call of GetBudgets.
+func (mr *MockQuerierMockRecorder) GetBudgets(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgets", reflect.TypeOf((*MockQuerier)(nil).GetBudgets), ctx, db, arg)
+}
+
+// GetCard mocks base method.
+func (m *MockQuerier) GetCard(ctx context.Context, db sqlc.DBTX, number string) (sqlc.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCard", ctx, db, number)
+	ret0, _ := ret[0].(sqlc.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCard indicates an expected call of GetCard.
+func (mr *MockQuerierMockRecorder) GetCard(ctx, db, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCard", reflect.TypeOf((*MockQuerier)(nil).GetCard), ctx, db, number)
+}
+
+// GetCardAccount mocks base method.
+func (m *MockQuerier) GetCardAccount(ctx context.Context, db sqlc.DBTX, number string) (sqlc.GetCardAccountRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardAccount", ctx, db, number)
+	ret0, _ := ret[0].(sqlc.GetCardAccountRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardAccount indicates an expected call of GetCardAccount.
+func (mr *MockQuerierMockRecorder) GetCardAccount(ctx, db, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardAccount", reflect.TypeOf((*MockQuerier)(nil).GetCardAccount), ctx, db, number)
+}
+
+// GetCardBalance mocks base method.
+func (m *MockQuerier) GetCardBalance(ctx context.Context, db sqlc.DBTX, number string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardBalance", ctx, db, number)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardBalance indicates an expected call of GetCardBalance.
+func (mr *MockQuerierMockRecorder) GetCardBalance(ctx, db, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardBalance", reflect.TypeOf((*MockQuerier)(nil).GetCardBalance), ctx, db, number)
+}
+
+// GetCardDebited mocks base method.
+func (m *MockQuerier) GetCardDebited(ctx context.Context, db sqlc.DBTX, cardNumber string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardDebited", ctx, db, cardNumber)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardDebited indicates an expected call of GetCardDebited.
+func (mr *MockQuerierMockRecorder) GetCardDebited(ctx, db, cardNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardDebited", reflect.TypeOf((*MockQuerier)(nil).GetCardDebited), ctx, db, cardNumber)
+}
+
+// GetCardLimits mocks base method.
+func (m *MockQuerier) GetCardLimits(ctx context.Context, db sqlc.DBTX, cardNumber sql.NullString) ([]sqlc.SpendingLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardLimits", ctx, db, cardNumber)
+	ret0, _ := ret[0].([]sqlc.SpendingLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardLimits indicates an expected call of GetCardLimits.
+func (mr *MockQuerierMockRecorder) GetCardLimits(ctx, db, cardNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardLimits", reflect.TypeOf((*MockQuerier)(nil).GetCardLimits), ctx, db, cardNumber)
+}
+
+// GetCardSpending mocks base method.
+func (m *MockQuerier) GetCardSpending(ctx context.Context, db sqlc.DBTX, arg sqlc.GetCardSpendingParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardSpending", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardSpending indicates an expected call of GetCardSpending.
+func (mr *MockQuerierMockRecorder) GetCardSpending(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardSpending", reflect.TypeOf((*MockQuerier)(nil).GetCardSpending), ctx, db, arg)
+}
+
+// GetCurrencies mocks base method.
+func (m *MockQuerier) GetCurrencies(ctx context.Context, db sqlc.DBTX) ([]sqlc.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrencies", ctx, db)
+	ret0, _ := ret[0].([]sqlc.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrencies indicates an expected call of GetCurrencies.
+func (mr *MockQuerierMockRecorder) GetCurrencies(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencies", reflect.TypeOf((*MockQuerier)(nil).GetCurrencies), ctx, db)
+}
+
+// GetCurrencyByCode mocks base method.
+func (m *MockQuerier) GetCurrencyByCode(ctx context.Context, db sqlc.DBTX, code string) (sqlc.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrencyByCode", ctx, db, code)
+	ret0, _ := ret[0].(sqlc.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrencyByCode indicates an expected call of GetCurrencyByCode.
+func (mr *MockQuerierMockRecorder) GetCurrencyByCode(ctx, db, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencyByCode", reflect.TypeOf((*MockQuerier)(nil).GetCurrencyByCode), ctx, db, code)
+}
+
+// GetCurrencyByID mocks base method.
+func (m *MockQuerier) GetCurrencyByID(ctx context.Context, db sqlc.DBTX, id int64) (sqlc.Currency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrencyByID", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.Currency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrencyByID indicates an expected call of GetCurrencyByID.
+func (mr *MockQuerierMockRecorder) GetCurrencyByID(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencyByID", reflect.TypeOf((*MockQuerier)(nil).GetCurrencyByID), ctx, db, id)
+}
+
+// GetDueStandingOrders mocks base method.
+func (m *MockQuerier) GetDueStandingOrders(ctx context.Context, db sqlc.DBTX, arg sqlc.GetDueStandingOrdersParams) ([]sqlc.GetDueStandingOrdersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueStandingOrders", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.GetDueStandingOrdersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueStandingOrders indicates an expected call of GetDueStandingOrders.
+func (mr *MockQuerierMockRecorder) GetDueStandingOrders(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueStandingOrders", reflect.TypeOf((*MockQuerier)(nil).GetDueStandingOrders), ctx, db, arg)
+}
+
+// GetHold mocks base method.
+func (m *MockQuerier) GetHold(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockQuerierMockRecorder) GetHold(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockQuerier)(nil).GetHold), ctx, db, id)
+}
+
+// GetIncomingPaymentRequests mocks base method.
+func (m *MockQuerier) GetIncomingPaymentRequests(ctx context.Context, db sqlc.DBTX, payerID int64) ([]sqlc.GetIncomingPaymentRequestsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncomingPaymentRequests", ctx, db, payerID)
+	ret0, _ := ret[0].([]sqlc.GetIncomingPaymentRequestsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncomingPaymentRequests indicates an expected call of GetIncomingPaymentRequests.
+func (mr *MockQuerierMockRecorder) GetIncomingPaymentRequests(ctx, db, payerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncomingPaymentRequests", reflect.TypeOf((*MockQuerier)(nil).GetIncomingPaymentRequests), ctx, db, payerID)
+}
+
+// GetOutgoingPaymentRequests mocks base method.
+func (m *MockQuerier) GetOutgoingPaymentRequests(ctx context.Context, db sqlc.DBTX, requesterID int64) ([]sqlc.GetOutgoingPaymentRequestsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoingPaymentRequests", ctx, db, requesterID)
+	ret0, _ := ret[0].([]sqlc.GetOutgoingPaymentRequestsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutgoingPaymentRequests indicates an expected call of GetOutgoingPaymentRequests.
+func (mr *MockQuerierMockRecorder) GetOutgoingPaymentRequests(ctx, db, requesterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingPaymentRequests", reflect.TypeOf((*MockQuerier)(nil).GetOutgoingPaymentRequests), ctx, db, requesterID)
+}
+
+// GetPaymentRequest mocks base method.
+func (m *MockQuerier) GetPaymentRequest(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetPaymentRequestRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequest", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetPaymentRequestRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequest indicates an expected call of GetPaymentRequest.
+func (mr *MockQuerierMockRecorder) GetPaymentRequest(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequest", reflect.TypeOf((*MockQuerier)(nil).GetPaymentRequest), ctx, db, id)
+}
+
+// GetPot mocks base method.
+func (m *MockQuerier) GetPot(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPot", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPot indicates an expected call of GetPot.
+func (mr *MockQuerierMockRecorder) GetPot(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPot", reflect.TypeOf((*MockQuerier)(nil).GetPot), ctx, db, id)
+}
+
+// GetQuote mocks base method.
+func (m *MockQuerier) GetQuote(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.ExchangeQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuote", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.ExchangeQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuote indicates an expected call of GetQuote.
+func (mr *MockQuerierMockRecorder) GetQuote(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuote", reflect.TypeOf((*MockQuerier)(nil).GetQuote), ctx, db, id)
+}
+
+// GetReceivingAccount mocks base method.
+func (m *MockQuerier) GetReceivingAccount(ctx context.Context, db sqlc.DBTX, arg sqlc.GetReceivingAccountParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceivingAccount", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceivingAccount indicates an expected call of GetReceivingAccount.
+func (mr *MockQuerierMockRecorder) GetReceivingAccount(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceivingAccount", reflect.TypeOf((*MockQuerier)(nil).GetReceivingAccount), ctx, db, arg)
+}
+
+// GetSpendingByCategory mocks base method.
+func (m *MockQuerier) GetSpendingByCategory(ctx context.Context, db sqlc.DBTX, arg sqlc.GetSpendingByCategoryParams) ([]sqlc.GetSpendingByCategoryRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingByCategory", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.GetSpendingByCategoryRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingByCategory indicates an expected call of GetSpendingByCategory.
+func (mr *MockQuerierMockRecorder) GetSpendingByCategory(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingByCategory", reflect.TypeOf((*MockQuerier)(nil).GetSpendingByCategory), ctx, db, arg)
+}
+
+// GetSplit mocks base method.
+func (m *MockQuerier) GetSplit(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetSplitRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSplit", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetSplitRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSplit indicates an expected call of GetSplit.
+func (mr *MockQuerierMockRecorder) GetSplit(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSplit", reflect.TypeOf((*MockQuerier)(nil).GetSplit), ctx, db, id)
+}
+
+// GetSplitPaymentRequests mocks base method.
+func (m *MockQuerier) GetSplitPaymentRequests(ctx context.Context, db sqlc.DBTX, splitID uuid.NullUUID) ([]sqlc.GetSplitPaymentRequestsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSplitPaymentRequests", ctx, db, splitID)
+	ret0, _ := ret[0].([]sqlc.GetSplitPaymentRequestsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSplitPaymentRequests indicates an expected call of GetSplitPaymentRequests.
+func (mr *MockQuerierMockRecorder) GetSplitPaymentRequests(ctx, db, splitID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSplitPaymentRequests", reflect.TypeOf((*MockQuerier)(nil).GetSplitPaymentRequests), ctx, db, splitID)
+}
+
+// GetStandingOrder mocks base method.
+func (m *MockQuerier) GetStandingOrder(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetStandingOrderRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandingOrder", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetStandingOrderRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandingOrder indicates an expected call of GetStandingOrder.
+func (mr *MockQuerierMockRecorder) GetStandingOrder(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandingOrder", reflect.TypeOf((*MockQuerier)(nil).GetStandingOrder), ctx, db, id)
+}
+
+// GetStandingOrderExecutions mocks base method.
+func (m *MockQuerier) GetStandingOrderExecutions(ctx context.Context, db sqlc.DBTX, standingOrderID uuid.UUID) ([]sqlc.StandingOrderExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandingOrderExecutions", ctx, db, standingOrderID)
+	ret0, _ := ret[0].([]sqlc.StandingOrderExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandingOrderExecutions indicates an expected call of GetStandingOrderExecutions.
+func (mr *MockQuerierMockRecorder) GetStandingOrderExecutions(ctx, db, standingOrderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandingOrderExecutions", reflect.TypeOf((*MockQuerier)(nil).GetStandingOrderExecutions), ctx, db, standingOrderID)
+}
+
+// GetTransaction mocks base method.
+func (m *MockQuerier) GetTransaction(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (sqlc.GetTransactionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransaction", ctx, db, id)
+	ret0, _ := ret[0].(sqlc.GetTransactionRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransaction indicates an expected call of GetTransaction.
+func (mr *MockQuerierMockRecorder) GetTransaction(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockQuerier)(nil).GetTransaction), ctx, db, id)
+}
+
+// GetTransactionByIdempotencyKey mocks base method.
+func (m *MockQuerier) GetTransactionByIdempotencyKey(ctx context.Context, db sqlc.DBTX, idempotencyKey sql.NullString) (sqlc.GetTransactionByIdempotencyKeyRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByIdempotencyKey", ctx, db, idempotencyKey)
+	ret0, _ := ret[0].(sqlc.GetTransactionByIdempotencyKeyRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByIdempotencyKey indicates an expected call of GetTransactionByIdempotencyKey.
+func (mr *MockQuerierMockRecorder) GetTransactionByIdempotencyKey(ctx, db, idempotencyKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByIdempotencyKey", reflect.TypeOf((*MockQuerier)(nil).GetTransactionByIdempotencyKey), ctx, db, idempotencyKey)
+}
+
+// GetTransactionLimits mocks base method.
+func (m *MockQuerier) GetTransactionLimits(ctx context.Context, db sqlc.DBTX, arg sqlc.GetTransactionLimitsParams) ([]sqlc.SpendingLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionLimits", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.SpendingLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionLimits indicates an expected call of GetTransactionLimits.
+func (mr *MockQuerierMockRecorder) GetTransactionLimits(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionLimits", reflect.TypeOf((*MockQuerier)(nil).GetTransactionLimits), ctx, db, arg)
+}
+
+// GetTransactions mocks base method.
+func (m *MockQuerier) GetTransactions(ctx context.Context, db sqlc.DBTX, arg sqlc.GetTransactionsParams) ([]sqlc.GetTransactionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactions", ctx, db, arg)
+	ret0, _ := ret[0].([]sqlc.GetTransactionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactions indicates an expected call of GetTransactions.
+func (mr *MockQuerierMockRecorder) GetTransactions(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockQuerier)(nil).GetTransactions), ctx, db, arg)
+}
+
+// GetUnvaultedCards mocks base method.
+func (m *MockQuerier) GetUnvaultedCards(ctx context.Context, db sqlc.DBTX) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnvaultedCards", ctx, db)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnvaultedCards indicates an expected call of GetUnvaultedCards.
+func (mr *MockQuerierMockRecorder) GetUnvaultedCards(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnvaultedCards", reflect.TypeOf((*MockQuerier)(nil).GetUnvaultedCards), ctx, db)
+}
+
+// GetUserByExternalID mocks base method.
+func (m *MockQuerier) GetUserByExternalID(ctx context.Context, db sqlc.DBTX, externalID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByExternalID", ctx, db, externalID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByExternalID indicates an expected call of GetUserByExternalID.
+func (mr *MockQuerierMockRecorder) GetUserByExternalID(ctx, db, externalID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByExternalID", reflect.TypeOf((*MockQuerier)(nil).GetUserByExternalID), ctx, db, externalID)
+}
+
+// GetUserCards mocks base method.
+func (m *MockQuerier) GetUserCards(ctx context.Context, db sqlc.DBTX, userID int64) ([]sqlc.GetUserCardsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserCards", ctx, db, userID)
+	ret0, _ := ret[0].([]sqlc.GetUserCardsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserCards indicates an expected call of GetUserCards.
+func (mr *MockQuerierMockRecorder) GetUserCards(ctx, db, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserCards", reflect.TypeOf((*MockQuerier)(nil).GetUserCards), ctx, db, userID)
+}
+
+// GetUserExternalID mocks base method.
+func (m *MockQuerier) GetUserExternalID(ctx context.Context, db sqlc.DBTX, id int64) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserExternalID", ctx, db, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserExternalID indicates an expected call of GetUserExternalID.
+func (mr *MockQuerierMockRecorder) GetUserExternalID(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExternalID", reflect.TypeOf((*MockQuerier)(nil).GetUserExternalID), ctx, db, id)
+}
+
+// GetUserPots mocks base method.
+func (m *MockQuerier) GetUserPots(ctx context.Context, db sqlc.DBTX, userID int64) ([]sqlc.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPots", ctx, db, userID)
+	ret0, _ := ret[0].([]sqlc.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPots indicates an expected call of GetUserPots.
+func (mr *MockQuerierMockRecorder) GetUserPots(ctx, db, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPots", reflect.TypeOf((*MockQuerier)(nil).GetUserPots), ctx, db, userID)
+}
+
+// GetUserSplits mocks base method.
+func (m *MockQuerier) GetUserSplits(ctx context.Context, db sqlc.DBTX, requesterID int64) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSplits", ctx, db, requesterID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserSplits indicates an expected call of GetUserSplits.
+func (mr *MockQuerierMockRecorder) GetUserSplits(ctx, db, requesterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSplits", reflect.TypeOf((*MockQuerier)(nil).GetUserSplits), ctx, db, requesterID)
+}
+
+// GetUserStandingOrders mocks base method.
+func (m *MockQuerier) GetUserStandingOrders(ctx context.Context, db sqlc.DBTX, userID int64) ([]sqlc.GetUserStandingOrdersRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserStandingOrders", ctx, db, userID)
+	ret0, _ := ret[0].([]sqlc.GetUserStandingOrdersRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserStandingOrders indicates an expected call of GetUserStandingOrders.
+func (mr *MockQuerierMockRecorder) GetUserStandingOrders(ctx, db, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserStandingOrders", reflect.TypeOf((*MockQuerier)(nil).GetUserStandingOrders), ctx, db, userID)
+}
+
+// GetVaultedCards mocks base method.
+func (m *MockQuerier) GetVaultedCards(ctx context.Context, db sqlc.DBTX) ([]sqlc.GetVaultedCardsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVaultedCards", ctx, db)
+	ret0, _ := ret[0].([]sqlc.GetVaultedCardsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVaultedCards indicates an expected call of GetVaultedCards.
+func (mr *MockQuerierMockRecorder) GetVaultedCards(ctx, db interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVaultedCards", reflect.TypeOf((*MockQuerier)(nil).GetVaultedCards), ctx, db)
+}
+
+// PayPaymentRequest mocks base method.
+func (m *MockQuerier) PayPaymentRequest(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayPaymentRequest", ctx, db, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayPaymentRequest indicates an expected call of PayPaymentRequest.
+func (mr *MockQuerierMockRecorder) PayPaymentRequest(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayPaymentRequest", reflect.TypeOf((*MockQuerier)(nil).PayPaymentRequest), ctx, db, id)
+}
+
+// PurgeIdempotencyKeys mocks base method.
+func (m *MockQuerier) PurgeIdempotencyKeys(ctx context.Context, db sqlc.DBTX, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeIdempotencyKeys", ctx, db, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeIdempotencyKeys indicates an expected call of PurgeIdempotencyKeys.
+func (mr *MockQuerierMockRecorder) PurgeIdempotencyKeys(ctx, db, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeIdempotencyKeys", reflect.TypeOf((*MockQuerier)(nil).PurgeIdempotencyKeys), ctx, db, before)
+}
+
+// RenameStandingOrdersCard mocks base method.
+func (m *MockQuerier) RenameStandingOrdersCard(ctx context.Context, db sqlc.DBTX, arg sqlc.RenameStandingOrdersCardParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameStandingOrdersCard", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameStandingOrdersCard indicates an expected call of RenameStandingOrdersCard.
+func (mr *MockQuerierMockRecorder) RenameStandingOrdersCard(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameStandingOrdersCard", reflect.TypeOf((*MockQuerier)(nil).RenameStandingOrdersCard), ctx, db, arg)
+}
+
+// RenameTransactionsCard mocks base method.
+func (m *MockQuerier) RenameTransactionsCard(ctx context.Context, db sqlc.DBTX, arg sqlc.RenameTransactionsCardParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameTransactionsCard", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameTransactionsCard indicates an expected call of RenameTransactionsCard.
+func (mr *MockQuerierMockRecorder) RenameTransactionsCard(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameTransactionsCard", reflect.TypeOf((*MockQuerier)(nil).RenameTransactionsCard), ctx, db, arg)
+}
+
+// ReplaceStandingOrdersCard mocks base method.
+func (m *MockQuerier) ReplaceStandingOrdersCard(ctx context.Context, db sqlc.DBTX, arg sqlc.ReplaceStandingOrdersCardParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceStandingOrdersCard", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceStandingOrdersCard indicates an expected call of ReplaceStandingOrdersCard.
+func (mr *MockQuerierMockRecorder) ReplaceStandingOrdersCard(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceStandingOrdersCard", reflect.TypeOf((*MockQuerier)(nil).ReplaceStandingOrdersCard), ctx, db, arg)
+}
+
+// RetireCard mocks base method.
+func (m *MockQuerier) RetireCard(ctx context.Context, db sqlc.DBTX, arg sqlc.RetireCardParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetireCard", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RetireCard indicates an expected call of RetireCard.
+func (mr *MockQuerierMockRecorder) RetireCard(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetireCard", reflect.TypeOf((*MockQuerier)(nil).RetireCard), ctx, db, arg)
+}
+
+// SetAccountLimit mocks base method.
+func (m *MockQuerier) SetAccountLimit(ctx context.Context, db sqlc.DBTX, arg sqlc.SetAccountLimitParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountLimit", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccountLimit indicates an expected call of SetAccountLimit.
+func (mr *MockQuerierMockRecorder) SetAccountLimit(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountLimit", reflect.TypeOf((*MockQuerier)(nil).SetAccountLimit), ctx, db, arg)
+}
+
+// SetAccountStatus mocks base method.
+func (m *MockQuerier) SetAccountStatus(ctx context.Context, db sqlc.DBTX, arg sqlc.SetAccountStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountStatus", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccountStatus indicates an expected call of SetAccountStatus.
+func (mr *MockQuerierMockRecorder) SetAccountStatus(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountStatus", reflect.TypeOf((*MockQuerier)(nil).SetAccountStatus), ctx, db, arg)
+}
+
+// SetBudget mocks base method.
+func (m *MockQuerier) SetBudget(ctx context.Context, db sqlc.DBTX, arg sqlc.SetBudgetParams) (sqlc.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBudget", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBudget indicates an expected call of SetBudget.
+func (mr *MockQuerierMockRecorder) SetBudget(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBudget", reflect.TypeOf((*MockQuerier)(nil).SetBudget), ctx, db, arg)
+}
+
+// SetBudgetAlerted mocks base method.
+func (m *MockQuerier) SetBudgetAlerted(ctx context.Context, db sqlc.DBTX, arg sqlc.SetBudgetAlertedParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBudgetAlerted", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBudgetAlerted indicates an expected call of SetBudgetAlerted.
+func (mr *MockQuerierMockRecorder) SetBudgetAlerted(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBudgetAlerted", reflect.TypeOf((*MockQuerier)(nil).SetBudgetAlerted), ctx, db, arg)
+}
+
+// SetCardLimit mocks base method.
+func (m *MockQuerier) SetCardLimit(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCardLimitParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardLimit", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardLimit indicates an expected call of SetCardLimit.
+func (mr *MockQuerierMockRecorder) SetCardLimit(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardLimit", reflect.TypeOf((*MockQuerier)(nil).SetCardLimit), ctx, db, arg)
+}
+
+// SetCardNickname mocks base method.
+func (m *MockQuerier) SetCardNickname(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCardNicknameParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardNickname", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardNickname indicates an expected call of SetCardNickname.
+func (mr *MockQuerierMockRecorder) SetCardNickname(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardNickname", reflect.TypeOf((*MockQuerier)(nil).SetCardNickname), ctx, db, arg)
+}
+
+// SetCardPin mocks base method.
+func (m *MockQuerier) SetCardPin(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCardPinParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardPin", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardPin indicates an expected call of SetCardPin.
+func (mr *MockQuerierMockRecorder) SetCardPin(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardPin", reflect.TypeOf((*MockQuerier)(nil).SetCardPin), ctx, db, arg)
+}
+
+// SetCardPinAttempts mocks base method.
+func (m *MockQuerier) SetCardPinAttempts(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCardPinAttemptsParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardPinAttempts", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardPinAttempts indicates an expected call of SetCardPinAttempts.
+func (mr *MockQuerierMockRecorder) SetCardPinAttempts(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardPinAttempts", reflect.TypeOf((*MockQuerier)(nil).SetCardPinAttempts), ctx, db, arg)
+}
+
+// SetCardStatus mocks base method.
+func (m *MockQuerier) SetCardStatus(ctx context.Context, db sqlc.DBTX, arg sqlc.SetCardStatusParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardStatus", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardStatus indicates an expected call of SetCardStatus.
+func (mr *MockQuerierMockRecorder) SetCardStatus(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardStatus", reflect.TypeOf((*MockQuerier)(nil).SetCardStatus), ctx, db, arg)
+}
+
+// SetDefaultAccount mocks base method.
+func (m *MockQuerier) SetDefaultAccount(ctx context.Context, db sqlc.DBTX, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultAccount", ctx, db, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultAccount indicates an expected call of SetDefaultAccount.
+func (mr *MockQuerierMockRecorder) SetDefaultAccount(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultAccount", reflect.TypeOf((*MockQuerier)(nil).SetDefaultAccount), ctx, db, id)
+}
+
+// SetHoldTransaction mocks base method.
+func (m *MockQuerier) SetHoldTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.SetHoldTransactionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHoldTransaction", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHoldTransaction indicates an expected call of SetHoldTransaction.
+func (mr *MockQuerierMockRecorder) SetHoldTransaction(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHoldTransaction", reflect.TypeOf((*MockQuerier)(nil).SetHoldTransaction), ctx, db, arg)
+}
+
+// SetPaymentRequestTransaction mocks base method.
+func (m *MockQuerier) SetPaymentRequestTransaction(ctx context.Context, db sqlc.DBTX, arg sqlc.SetPaymentRequestTransactionParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPaymentRequestTransaction", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetPaymentRequestTransaction indicates an expected call of SetPaymentRequestTransaction.
+func (mr *MockQuerierMockRecorder) SetPaymentRequestTransaction(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPaymentRequestTransaction", reflect.TypeOf((*MockQuerier)(nil).SetPaymentRequestTransaction), ctx, db, arg)
+}
+
+// SetTransactionCategory mocks base method.
+func (m *MockQuerier) SetTransactionCategory(ctx context.Context, db sqlc.DBTX, arg sqlc.SetTransactionCategoryParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTransactionCategory", ctx, db, arg)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// SetTransactionCategory indicates an expected call of SetTransactionCategory.
+func (mr *MockQuerierMockRecorder) SetTransactionCategory(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionCategory", reflect.TypeOf((*MockQuerier)(nil).SetTransactionCategory), ctx, db, arg)
+}
+
+// SubAccountBalance mocks base method.
+func (m *MockQuerier) SubAccountBalance(ctx context.Context, db sqlc.DBTX, arg sqlc.SubAccountBalanceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubAccountBalance", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // SubAccountBalance indicates an expected call of SubAccountBalance.
 func (mr *MockQuerierMockRecorder) SubAccountBalance(ctx, db, arg interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubAccountBalance", reflect.TypeOf((*MockQuerier)(nil).SubAccountBalance), ctx, db, arg)
 }
+
+// SubPotBalance mocks base method.
+func (m *MockQuerier) SubPotBalance(ctx context.Context, db sqlc.DBTX, arg sqlc.SubPotBalanceParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubPotBalance", ctx, db, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubPotBalance indicates an expected call of SubPotBalance.
+func (mr *MockQuerierMockRecorder) SubPotBalance(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubPotBalance", reflect.TypeOf((*MockQuerier)(nil).SubPotBalance), ctx, db, arg)
+}
+
+// UpdatePot mocks base method.
+func (m *MockQuerier) UpdatePot(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdatePotParams) (sqlc.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePot", ctx, db, arg)
+	ret0, _ := ret[0].(sqlc.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePot indicates an expected call of UpdatePot.
+func (mr *MockQuerierMockRecorder) UpdatePot(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePot", reflect.TypeOf((*MockQuerier)(nil).UpdatePot), ctx, db, arg)
+}
+
+// UpdateStandingOrder mocks base method.
+func (m *MockQuerier) UpdateStandingOrder(ctx context.Context, db sqlc.DBTX, arg sqlc.UpdateStandingOrderParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStandingOrder", ctx, db, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStandingOrder indicates an expected call of UpdateStandingOrder.
+func (mr *MockQuerierMockRecorder) UpdateStandingOrder(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStandingOrder", reflect.TypeOf((*MockQuerier)(nil).UpdateStandingOrder), ctx, db, arg)
+}
+
+// UseQuote mocks base method.
+func (m *MockQuerier) UseQuote(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseQuote", ctx, db, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseQuote indicates an expected call of UseQuote.
+func (mr *MockQuerierMockRecorder) UseQuote(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseQuote", reflect.TypeOf((*MockQuerier)(nil).UseQuote), ctx, db, id)
+}
+
+// VaultCard mocks base method.
+func (m *MockQuerier) VaultCard(ctx context.Context, db sqlc.DBTX, arg sqlc.VaultCardParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VaultCard", ctx, db, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VaultCard indicates an expected call of VaultCard.
+func (mr *MockQuerierMockRecorder) VaultCard(ctx, db, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VaultCard", reflect.TypeOf((*MockQuerier)(nil).VaultCard), ctx, db, arg)
+}
+
+// VoidHold mocks base method.
+func (m *MockQuerier) VoidHold(ctx context.Context, db sqlc.DBTX, id uuid.UUID) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHold", ctx, db, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidHold indicates an expected call of VoidHold.
+func (mr *MockQuerierMockRecorder) VoidHold(ctx, db, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHold", reflect.TypeOf((*MockQuerier)(nil).VoidHold), ctx, db, id)
+}
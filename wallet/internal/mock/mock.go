@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	core "github.com/escalopa/fingo/wallet/internal/core"
 	gomock "github.com/golang/mock/gomock"
@@ -65,6 +66,21 @@ func (mr *MockUserRepositoryMockRecorder) GetUser(ctx, uuid interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserRepository)(nil).GetUser), ctx, uuid)
 }
 
+// GetUserExternalID mocks base method.
+func (m *MockUserRepository) GetUserExternalID(ctx context.Context, id int64) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserExternalID", ctx, id)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserExternalID indicates an expected call of GetUserExternalID.
+func (mr *MockUserRepositoryMockRecorder) GetUserExternalID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExternalID", reflect.TypeOf((*MockUserRepository)(nil).GetUserExternalID), ctx, id)
+}
+
 // MockAccountRepository is a mock of AccountRepository interface.
 type MockAccountRepository struct {
 	ctrl     *gomock.Controller
@@ -88,32 +104,33 @@ func (m *MockAccountRepository) EXPECT() *MockAccountRepositoryMockRecorder {
 	return m.recorder
 }
 
-// CreateAccount mocks base method.
-func (m *MockAccountRepository) CreateAccount(ctx context.Context, params core.CreateAccountParams) error {
+// CloseAccount mocks base method.
+func (m *MockAccountRepository) CloseAccount(ctx context.Context, accountID, sweepToAccountID int64) (core.Transaction, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateAccount", ctx, params)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CloseAccount", ctx, accountID, sweepToAccountID)
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// CreateAccount indicates an expected call of CreateAccount.
-func (mr *MockAccountRepositoryMockRecorder) CreateAccount(ctx, params interface{}) *gomock.Call {
+// CloseAccount indicates an expected call of CloseAccount.
+func (mr *MockAccountRepositoryMockRecorder) CloseAccount(ctx, accountID, sweepToAccountID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountRepository)(nil).CreateAccount), ctx, params)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAccount", reflect.TypeOf((*MockAccountRepository)(nil).CloseAccount), ctx, accountID, sweepToAccountID)
 }
 
-// DeleteAccount mocks base method.
-func (m *MockAccountRepository) DeleteAccount(ctx context.Context, accountID int64) error {
+// CreateAccount mocks base method.
+func (m *MockAccountRepository) CreateAccount(ctx context.Context, params core.CreateAccountParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAccount", ctx, accountID)
+	ret := m.ctrl.Call(m, "CreateAccount", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// DeleteAccount indicates an expected call of DeleteAccount.
-func (mr *MockAccountRepositoryMockRecorder) DeleteAccount(ctx, accountID interface{}) *gomock.Call {
+// CreateAccount indicates an expected call of CreateAccount.
+func (mr *MockAccountRepositoryMockRecorder) CreateAccount(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockAccountRepository)(nil).DeleteAccount), ctx, accountID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountRepository)(nil).CreateAccount), ctx, params)
 }
 
 // GetAccount mocks base method.
@@ -131,6 +148,21 @@ func (mr *MockAccountRepositoryMockRecorder) GetAccount(ctx, accountID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccount", reflect.TypeOf((*MockAccountRepository)(nil).GetAccount), ctx, accountID)
 }
 
+// GetAccountBalanceAt mocks base method.
+func (m *MockAccountRepository) GetAccountBalanceAt(ctx context.Context, accountID int64, at time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBalanceAt", ctx, accountID, at)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBalanceAt indicates an expected call of GetAccountBalanceAt.
+func (mr *MockAccountRepositoryMockRecorder) GetAccountBalanceAt(ctx, accountID, at interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBalanceAt", reflect.TypeOf((*MockAccountRepository)(nil).GetAccountBalanceAt), ctx, accountID, at)
+}
+
 // GetAccounts mocks base method.
 func (m *MockAccountRepository) GetAccounts(ctx context.Context, userID int64) ([]core.Account, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +178,49 @@ func (mr *MockAccountRepositoryMockRecorder) GetAccounts(ctx, userID interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccounts", reflect.TypeOf((*MockAccountRepository)(nil).GetAccounts), ctx, userID)
 }
 
+// GetReceivingAccount mocks base method.
+func (m *MockAccountRepository) GetReceivingAccount(ctx context.Context, userID uuid.UUID, currency core.Currency) (core.Account, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceivingAccount", ctx, userID, currency)
+	ret0, _ := ret[0].(core.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceivingAccount indicates an expected call of GetReceivingAccount.
+func (mr *MockAccountRepositoryMockRecorder) GetReceivingAccount(ctx, userID, currency interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceivingAccount", reflect.TypeOf((*MockAccountRepository)(nil).GetReceivingAccount), ctx, userID, currency)
+}
+
+// SetAccountStatus mocks base method.
+func (m *MockAccountRepository) SetAccountStatus(ctx context.Context, accountID int64, status core.AccountStatus, byOps bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAccountStatus", ctx, accountID, status, byOps)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAccountStatus indicates an expected call of SetAccountStatus.
+func (mr *MockAccountRepositoryMockRecorder) SetAccountStatus(ctx, accountID, status, byOps interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAccountStatus", reflect.TypeOf((*MockAccountRepository)(nil).SetAccountStatus), ctx, accountID, status, byOps)
+}
+
+// SetDefaultAccount mocks base method.
+func (m *MockAccountRepository) SetDefaultAccount(ctx context.Context, accountID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDefaultAccount", ctx, accountID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetDefaultAccount indicates an expected call of SetDefaultAccount.
+func (mr *MockAccountRepositoryMockRecorder) SetDefaultAccount(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDefaultAccount", reflect.TypeOf((*MockAccountRepository)(nil).SetDefaultAccount), ctx, accountID)
+}
+
 // MockCardRepository is a mock of CardRepository interface.
 type MockCardRepository struct {
 	ctrl     *gomock.Controller
@@ -183,6 +258,20 @@ func (mr *MockCardRepositoryMockRecorder) CreateCard(ctx, params interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCard", reflect.TypeOf((*MockCardRepository)(nil).CreateCard), ctx, params)
 }
 
+// CreateCardReveal mocks base method.
+func (m *MockCardRepository) CreateCardReveal(ctx context.Context, cardNumber string, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCardReveal", ctx, cardNumber, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateCardReveal indicates an expected call of CreateCardReveal.
+func (mr *MockCardRepositoryMockRecorder) CreateCardReveal(ctx, cardNumber, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCardReveal", reflect.TypeOf((*MockCardRepository)(nil).CreateCardReveal), ctx, cardNumber, userID)
+}
+
 // DeleteCard mocks base method.
 func (m *MockCardRepository) DeleteCard(ctx context.Context, cardNumber string) error {
 	m.ctrl.T.Helper()
@@ -227,6 +316,21 @@ func (mr *MockCardRepositoryMockRecorder) GetCardAccount(ctx, cardNumber interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardAccount", reflect.TypeOf((*MockCardRepository)(nil).GetCardAccount), ctx, cardNumber)
 }
 
+// GetCardDebited mocks base method.
+func (m *MockCardRepository) GetCardDebited(ctx context.Context, cardNumber string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardDebited", ctx, cardNumber)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardDebited indicates an expected call of GetCardDebited.
+func (mr *MockCardRepositoryMockRecorder) GetCardDebited(ctx, cardNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardDebited", reflect.TypeOf((*MockCardRepository)(nil).GetCardDebited), ctx, cardNumber)
+}
+
 // GetCards mocks base method.
 func (m *MockCardRepository) GetCards(ctx context.Context, accountID int64) ([]core.Card, error) {
 	m.ctrl.T.Helper()
@@ -242,6 +346,120 @@ func (mr *MockCardRepositoryMockRecorder) GetCards(ctx, accountID interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCards", reflect.TypeOf((*MockCardRepository)(nil).GetCards), ctx, accountID)
 }
 
+// GetUnvaultedCards mocks base method.
+func (m *MockCardRepository) GetUnvaultedCards(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnvaultedCards", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnvaultedCards indicates an expected call of GetUnvaultedCards.
+func (mr *MockCardRepositoryMockRecorder) GetUnvaultedCards(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnvaultedCards", reflect.TypeOf((*MockCardRepository)(nil).GetUnvaultedCards), ctx)
+}
+
+// GetVaultedCards mocks base method.
+func (m *MockCardRepository) GetVaultedCards(ctx context.Context) ([]core.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVaultedCards", ctx)
+	ret0, _ := ret[0].([]core.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVaultedCards indicates an expected call of GetVaultedCards.
+func (mr *MockCardRepositoryMockRecorder) GetVaultedCards(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVaultedCards", reflect.TypeOf((*MockCardRepository)(nil).GetVaultedCards), ctx)
+}
+
+// ReplaceCard mocks base method.
+func (m *MockCardRepository) ReplaceCard(ctx context.Context, cardNumber string, params core.CreateCardParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceCard", ctx, cardNumber, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceCard indicates an expected call of ReplaceCard.
+func (mr *MockCardRepositoryMockRecorder) ReplaceCard(ctx, cardNumber, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceCard", reflect.TypeOf((*MockCardRepository)(nil).ReplaceCard), ctx, cardNumber, params)
+}
+
+// SetCardNickname mocks base method.
+func (m *MockCardRepository) SetCardNickname(ctx context.Context, cardNumber, nickname string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardNickname", ctx, cardNumber, nickname)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardNickname indicates an expected call of SetCardNickname.
+func (mr *MockCardRepositoryMockRecorder) SetCardNickname(ctx, cardNumber, nickname interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardNickname", reflect.TypeOf((*MockCardRepository)(nil).SetCardNickname), ctx, cardNumber, nickname)
+}
+
+// SetCardPin mocks base method.
+func (m *MockCardRepository) SetCardPin(ctx context.Context, cardNumber, pinHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardPin", ctx, cardNumber, pinHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardPin indicates an expected call of SetCardPin.
+func (mr *MockCardRepositoryMockRecorder) SetCardPin(ctx, cardNumber, pinHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardPin", reflect.TypeOf((*MockCardRepository)(nil).SetCardPin), ctx, cardNumber, pinHash)
+}
+
+// SetCardPinAttempts mocks base method.
+func (m *MockCardRepository) SetCardPinAttempts(ctx context.Context, cardNumber string, attempts core.CardPINAttempts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardPinAttempts", ctx, cardNumber, attempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardPinAttempts indicates an expected call of SetCardPinAttempts.
+func (mr *MockCardRepositoryMockRecorder) SetCardPinAttempts(ctx, cardNumber, attempts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardPinAttempts", reflect.TypeOf((*MockCardRepository)(nil).SetCardPinAttempts), ctx, cardNumber, attempts)
+}
+
+// SetCardStatus mocks base method.
+func (m *MockCardRepository) SetCardStatus(ctx context.Context, cardNumber string, status core.CardStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardStatus", ctx, cardNumber, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardStatus indicates an expected call of SetCardStatus.
+func (mr *MockCardRepositoryMockRecorder) SetCardStatus(ctx, cardNumber, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardStatus", reflect.TypeOf((*MockCardRepository)(nil).SetCardStatus), ctx, cardNumber, status)
+}
+
+// VaultCard mocks base method.
+func (m *MockCardRepository) VaultCard(ctx context.Context, cardNumber, token string, encrypted core.EncryptedCardNumber) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VaultCard", ctx, cardNumber, token, encrypted)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VaultCard indicates an expected call of VaultCard.
+func (mr *MockCardRepositoryMockRecorder) VaultCard(ctx, cardNumber, token, encrypted interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VaultCard", reflect.TypeOf((*MockCardRepository)(nil).VaultCard), ctx, cardNumber, token, encrypted)
+}
+
 // MockTransactionRepository is a mock of TransactionRepository interface.
 type MockTransactionRepository struct {
 	ctrl     *gomock.Controller
@@ -265,12 +483,28 @@ func (m *MockTransactionRepository) EXPECT() *MockTransactionRepositoryMockRecor
 	return m.recorder
 }
 
+// BatchTransfer mocks base method.
+func (m *MockTransactionRepository) BatchTransfer(ctx context.Context, params []core.CreateTransactionParams) ([]core.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchTransfer", ctx, params)
+	ret0, _ := ret[0].([]core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchTransfer indicates an expected call of BatchTransfer.
+func (mr *MockTransactionRepositoryMockRecorder) BatchTransfer(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchTransfer", reflect.TypeOf((*MockTransactionRepository)(nil).BatchTransfer), ctx, params)
+}
+
 // Deposit mocks base method.
-func (m *MockTransactionRepository) Deposit(ctx context.Context, params core.CreateTransactionParams) error {
+func (m *MockTransactionRepository) Deposit(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Deposit", ctx, params)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Deposit indicates an expected call of Deposit.
@@ -279,6 +513,21 @@ func (mr *MockTransactionRepositoryMockRecorder) Deposit(ctx, params interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deposit", reflect.TypeOf((*MockTransactionRepository)(nil).Deposit), ctx, params)
 }
 
+// GetSpendingByCategory mocks base method.
+func (m *MockTransactionRepository) GetSpendingByCategory(ctx context.Context, accountID int64, from, to time.Time) ([]core.CategorySpending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpendingByCategory", ctx, accountID, from, to)
+	ret0, _ := ret[0].([]core.CategorySpending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpendingByCategory indicates an expected call of GetSpendingByCategory.
+func (mr *MockTransactionRepositoryMockRecorder) GetSpendingByCategory(ctx, accountID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpendingByCategory", reflect.TypeOf((*MockTransactionRepository)(nil).GetSpendingByCategory), ctx, accountID, from, to)
+}
+
 // GetTransaction mocks base method.
 func (m *MockTransactionRepository) GetTransaction(ctx context.Context, transactionID uuid.UUID) (core.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -294,6 +543,21 @@ func (mr *MockTransactionRepositoryMockRecorder) GetTransaction(ctx, transaction
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransaction", reflect.TypeOf((*MockTransactionRepository)(nil).GetTransaction), ctx, transactionID)
 }
 
+// GetTransactionByIdempotencyKey mocks base method.
+func (m *MockTransactionRepository) GetTransactionByIdempotencyKey(ctx context.Context, key string) (core.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionByIdempotencyKey", ctx, key)
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionByIdempotencyKey indicates an expected call of GetTransactionByIdempotencyKey.
+func (mr *MockTransactionRepositoryMockRecorder) GetTransactionByIdempotencyKey(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByIdempotencyKey", reflect.TypeOf((*MockTransactionRepository)(nil).GetTransactionByIdempotencyKey), ctx, key)
+}
+
 // GetTransactions mocks base method.
 func (m *MockTransactionRepository) GetTransactions(ctx context.Context, params core.GetTransactionsParams) ([]core.Transaction, error) {
 	m.ctrl.T.Helper()
@@ -309,26 +573,57 @@ func (mr *MockTransactionRepositoryMockRecorder) GetTransactions(ctx, params int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactions", reflect.TypeOf((*MockTransactionRepository)(nil).GetTransactions), ctx, params)
 }
 
-// RollbackTransaction mocks base method.
-func (m *MockTransactionRepository) RollbackTransaction(ctx context.Context, transactionID uuid.UUID) error {
+// PurgeIdempotencyKeys mocks base method.
+func (m *MockTransactionRepository) PurgeIdempotencyKeys(ctx context.Context, before time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeIdempotencyKeys", ctx, before)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeIdempotencyKeys indicates an expected call of PurgeIdempotencyKeys.
+func (mr *MockTransactionRepositoryMockRecorder) PurgeIdempotencyKeys(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeIdempotencyKeys", reflect.TypeOf((*MockTransactionRepository)(nil).PurgeIdempotencyKeys), ctx, before)
+}
+
+// ReverseTransaction mocks base method.
+func (m *MockTransactionRepository) ReverseTransaction(ctx context.Context, params core.ReverseTransactionParams) (core.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReverseTransaction", ctx, params)
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReverseTransaction indicates an expected call of ReverseTransaction.
+func (mr *MockTransactionRepositoryMockRecorder) ReverseTransaction(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReverseTransaction", reflect.TypeOf((*MockTransactionRepository)(nil).ReverseTransaction), ctx, params)
+}
+
+// SetTransactionLabels mocks base method.
+func (m *MockTransactionRepository) SetTransactionLabels(ctx context.Context, params core.SetTransactionLabelsParams) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RollbackTransaction", ctx, transactionID)
+	ret := m.ctrl.Call(m, "SetTransactionLabels", ctx, params)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RollbackTransaction indicates an expected call of RollbackTransaction.
-func (mr *MockTransactionRepositoryMockRecorder) RollbackTransaction(ctx, transactionID interface{}) *gomock.Call {
+// SetTransactionLabels indicates an expected call of SetTransactionLabels.
+func (mr *MockTransactionRepositoryMockRecorder) SetTransactionLabels(ctx, params interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTransaction", reflect.TypeOf((*MockTransactionRepository)(nil).RollbackTransaction), ctx, transactionID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTransactionLabels", reflect.TypeOf((*MockTransactionRepository)(nil).SetTransactionLabels), ctx, params)
 }
 
 // Transfer mocks base method.
-func (m *MockTransactionRepository) Transfer(ctx context.Context, params core.CreateTransactionParams) error {
+func (m *MockTransactionRepository) Transfer(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Transfer", ctx, params)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Transfer indicates an expected call of Transfer.
@@ -338,11 +633,12 @@ func (mr *MockTransactionRepositoryMockRecorder) Transfer(ctx, params interface{
 }
 
 // Withdraw mocks base method.
-func (m *MockTransactionRepository) Withdraw(ctx context.Context, params core.CreateTransactionParams) error {
+func (m *MockTransactionRepository) Withdraw(ctx context.Context, params core.CreateTransactionParams) (core.Transaction, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Withdraw", ctx, params)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // Withdraw indicates an expected call of Withdraw.
@@ -351,42 +647,1239 @@ func (mr *MockTransactionRepositoryMockRecorder) Withdraw(ctx, params interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Withdraw", reflect.TypeOf((*MockTransactionRepository)(nil).Withdraw), ctx, params)
 }
 
-// MockCardNumberGenerator is a mock of CardNumberGenerator interface.
-type MockCardNumberGenerator struct {
+// MockCurrencyRepository is a mock of CurrencyRepository interface.
+type MockCurrencyRepository struct {
 	ctrl     *gomock.Controller
-	recorder *MockCardNumberGeneratorMockRecorder
+	recorder *MockCurrencyRepositoryMockRecorder
 }
 
-// MockCardNumberGeneratorMockRecorder is the mock recorder for MockCardNumberGenerator.
-type MockCardNumberGeneratorMockRecorder struct {
-	mock *MockCardNumberGenerator
+// MockCurrencyRepositoryMockRecorder is the mock recorder for MockCurrencyRepository.
+type MockCurrencyRepositoryMockRecorder struct {
+	mock *MockCurrencyRepository
 }
 
-// NewMockCardNumberGenerator creates a new mock instance.
-func NewMockCardNumberGenerator(ctrl *gomock.Controller) *MockCardNumberGenerator {
-	mock := &MockCardNumberGenerator{ctrl: ctrl}
-	mock.recorder = &MockCardNumberGeneratorMockRecorder{mock}
+// NewMockCurrencyRepository creates a new mock instance.
+func NewMockCurrencyRepository(ctrl *gomock.Controller) *MockCurrencyRepository {
+	mock := &MockCurrencyRepository{ctrl: ctrl}
+	mock.recorder = &MockCurrencyRepositoryMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockCardNumberGenerator) EXPECT() *MockCardNumberGeneratorMockRecorder {
+func (m *MockCurrencyRepository) EXPECT() *MockCurrencyRepositoryMockRecorder {
 	return m.recorder
 }
 
-// GenCardNumber mocks base method.
-func (m *MockCardNumberGenerator) GenCardNumber(ctx context.Context) (string, error) {
+// GetCurrencies mocks base method.
+func (m *MockCurrencyRepository) GetCurrencies(ctx context.Context) ([]core.CurrencyInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GenCardNumber", ctx)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetCurrencies", ctx)
+	ret0, _ := ret[0].([]core.CurrencyInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GenCardNumber indicates an expected call of GenCardNumber.
-func (mr *MockCardNumberGeneratorMockRecorder) GenCardNumber(ctx interface{}) *gomock.Call {
+// GetCurrencies indicates an expected call of GetCurrencies.
+func (mr *MockCurrencyRepositoryMockRecorder) GetCurrencies(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenCardNumber", reflect.TypeOf((*MockCardNumberGenerator)(nil).GenCardNumber), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrencies", reflect.TypeOf((*MockCurrencyRepository)(nil).GetCurrencies), ctx)
+}
+
+// GetCurrency mocks base method.
+func (m *MockCurrencyRepository) GetCurrency(ctx context.Context, code core.Currency) (core.CurrencyInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCurrency", ctx, code)
+	ret0, _ := ret[0].(core.CurrencyInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCurrency indicates an expected call of GetCurrency.
+func (mr *MockCurrencyRepositoryMockRecorder) GetCurrency(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCurrency", reflect.TypeOf((*MockCurrencyRepository)(nil).GetCurrency), ctx, code)
+}
+
+// MockQuoteRepository is a mock of QuoteRepository interface.
+type MockQuoteRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQuoteRepositoryMockRecorder
+}
+
+// MockQuoteRepositoryMockRecorder is the mock recorder for MockQuoteRepository.
+type MockQuoteRepositoryMockRecorder struct {
+	mock *MockQuoteRepository
+}
+
+// NewMockQuoteRepository creates a new mock instance.
+func NewMockQuoteRepository(ctrl *gomock.Controller) *MockQuoteRepository {
+	mock := &MockQuoteRepository{ctrl: ctrl}
+	mock.recorder = &MockQuoteRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQuoteRepository) EXPECT() *MockQuoteRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateQuote mocks base method.
+func (m *MockQuoteRepository) CreateQuote(ctx context.Context, params core.CreateQuoteParams) (core.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQuote", ctx, params)
+	ret0, _ := ret[0].(core.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQuote indicates an expected call of CreateQuote.
+func (mr *MockQuoteRepositoryMockRecorder) CreateQuote(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQuote", reflect.TypeOf((*MockQuoteRepository)(nil).CreateQuote), ctx, params)
+}
+
+// GetQuote mocks base method.
+func (m *MockQuoteRepository) GetQuote(ctx context.Context, quoteID uuid.UUID) (core.Quote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuote", ctx, quoteID)
+	ret0, _ := ret[0].(core.Quote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuote indicates an expected call of GetQuote.
+func (mr *MockQuoteRepositoryMockRecorder) GetQuote(ctx, quoteID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuote", reflect.TypeOf((*MockQuoteRepository)(nil).GetQuote), ctx, quoteID)
+}
+
+// MockHoldRepository is a mock of HoldRepository interface.
+type MockHoldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHoldRepositoryMockRecorder
+}
+
+// MockHoldRepositoryMockRecorder is the mock recorder for MockHoldRepository.
+type MockHoldRepositoryMockRecorder struct {
+	mock *MockHoldRepository
+}
+
+// NewMockHoldRepository creates a new mock instance.
+func NewMockHoldRepository(ctrl *gomock.Controller) *MockHoldRepository {
+	mock := &MockHoldRepository{ctrl: ctrl}
+	mock.recorder = &MockHoldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHoldRepository) EXPECT() *MockHoldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CaptureHold mocks base method.
+func (m *MockHoldRepository) CaptureHold(ctx context.Context, holdID uuid.UUID, amount int64) (core.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureHold", ctx, holdID, amount)
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureHold indicates an expected call of CaptureHold.
+func (mr *MockHoldRepositoryMockRecorder) CaptureHold(ctx, holdID, amount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureHold", reflect.TypeOf((*MockHoldRepository)(nil).CaptureHold), ctx, holdID, amount)
+}
+
+// CreateHold mocks base method.
+func (m *MockHoldRepository) CreateHold(ctx context.Context, params core.CreateHoldParams) (core.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", ctx, params)
+	ret0, _ := ret[0].(core.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockHoldRepositoryMockRecorder) CreateHold(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockHoldRepository)(nil).CreateHold), ctx, params)
+}
+
+// ExpireHolds mocks base method.
+func (m *MockHoldRepository) ExpireHolds(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireHolds", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireHolds indicates an expected call of ExpireHolds.
+func (mr *MockHoldRepositoryMockRecorder) ExpireHolds(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireHolds", reflect.TypeOf((*MockHoldRepository)(nil).ExpireHolds), ctx)
+}
+
+// GetHold mocks base method.
+func (m *MockHoldRepository) GetHold(ctx context.Context, holdID uuid.UUID) (core.Hold, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHold", ctx, holdID)
+	ret0, _ := ret[0].(core.Hold)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHold indicates an expected call of GetHold.
+func (mr *MockHoldRepositoryMockRecorder) GetHold(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHold", reflect.TypeOf((*MockHoldRepository)(nil).GetHold), ctx, holdID)
+}
+
+// VoidHold mocks base method.
+func (m *MockHoldRepository) VoidHold(ctx context.Context, holdID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidHold", ctx, holdID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoidHold indicates an expected call of VoidHold.
+func (mr *MockHoldRepositoryMockRecorder) VoidHold(ctx, holdID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidHold", reflect.TypeOf((*MockHoldRepository)(nil).VoidHold), ctx, holdID)
+}
+
+// MockStandingOrderRepository is a mock of StandingOrderRepository interface.
+type MockStandingOrderRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStandingOrderRepositoryMockRecorder
+}
+
+// MockStandingOrderRepositoryMockRecorder is the mock recorder for MockStandingOrderRepository.
+type MockStandingOrderRepositoryMockRecorder struct {
+	mock *MockStandingOrderRepository
+}
+
+// NewMockStandingOrderRepository creates a new mock instance.
+func NewMockStandingOrderRepository(ctrl *gomock.Controller) *MockStandingOrderRepository {
+	mock := &MockStandingOrderRepository{ctrl: ctrl}
+	mock.recorder = &MockStandingOrderRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStandingOrderRepository) EXPECT() *MockStandingOrderRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelStandingOrder mocks base method.
+func (m *MockStandingOrderRepository) CancelStandingOrder(ctx context.Context, orderID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelStandingOrder", ctx, orderID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelStandingOrder indicates an expected call of CancelStandingOrder.
+func (mr *MockStandingOrderRepositoryMockRecorder) CancelStandingOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStandingOrder", reflect.TypeOf((*MockStandingOrderRepository)(nil).CancelStandingOrder), ctx, orderID)
+}
+
+// CreateStandingOrder mocks base method.
+func (m *MockStandingOrderRepository) CreateStandingOrder(ctx context.Context, params core.CreateStandingOrderParams) (core.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStandingOrder", ctx, params)
+	ret0, _ := ret[0].(core.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStandingOrder indicates an expected call of CreateStandingOrder.
+func (mr *MockStandingOrderRepositoryMockRecorder) CreateStandingOrder(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStandingOrder", reflect.TypeOf((*MockStandingOrderRepository)(nil).CreateStandingOrder), ctx, params)
+}
+
+// GetDueStandingOrders mocks base method.
+func (m *MockStandingOrderRepository) GetDueStandingOrders(ctx context.Context, now time.Time, limit int32) ([]core.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueStandingOrders", ctx, now, limit)
+	ret0, _ := ret[0].([]core.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueStandingOrders indicates an expected call of GetDueStandingOrders.
+func (mr *MockStandingOrderRepositoryMockRecorder) GetDueStandingOrders(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueStandingOrders", reflect.TypeOf((*MockStandingOrderRepository)(nil).GetDueStandingOrders), ctx, now, limit)
+}
+
+// GetExecutions mocks base method.
+func (m *MockStandingOrderRepository) GetExecutions(ctx context.Context, orderID uuid.UUID) ([]core.StandingOrderExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExecutions", ctx, orderID)
+	ret0, _ := ret[0].([]core.StandingOrderExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExecutions indicates an expected call of GetExecutions.
+func (mr *MockStandingOrderRepositoryMockRecorder) GetExecutions(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExecutions", reflect.TypeOf((*MockStandingOrderRepository)(nil).GetExecutions), ctx, orderID)
+}
+
+// GetStandingOrder mocks base method.
+func (m *MockStandingOrderRepository) GetStandingOrder(ctx context.Context, orderID uuid.UUID) (core.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandingOrder", ctx, orderID)
+	ret0, _ := ret[0].(core.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandingOrder indicates an expected call of GetStandingOrder.
+func (mr *MockStandingOrderRepositoryMockRecorder) GetStandingOrder(ctx, orderID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandingOrder", reflect.TypeOf((*MockStandingOrderRepository)(nil).GetStandingOrder), ctx, orderID)
+}
+
+// GetStandingOrders mocks base method.
+func (m *MockStandingOrderRepository) GetStandingOrders(ctx context.Context, userID int64) ([]core.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandingOrders", ctx, userID)
+	ret0, _ := ret[0].([]core.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandingOrders indicates an expected call of GetStandingOrders.
+func (mr *MockStandingOrderRepositoryMockRecorder) GetStandingOrders(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandingOrders", reflect.TypeOf((*MockStandingOrderRepository)(nil).GetStandingOrders), ctx, userID)
+}
+
+// RecordExecution mocks base method.
+func (m *MockStandingOrderRepository) RecordExecution(ctx context.Context, params core.RecordStandingOrderExecutionParams) (core.StandingOrderExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordExecution", ctx, params)
+	ret0, _ := ret[0].(core.StandingOrderExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordExecution indicates an expected call of RecordExecution.
+func (mr *MockStandingOrderRepositoryMockRecorder) RecordExecution(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordExecution", reflect.TypeOf((*MockStandingOrderRepository)(nil).RecordExecution), ctx, params)
+}
+
+// UpdateStandingOrder mocks base method.
+func (m *MockStandingOrderRepository) UpdateStandingOrder(ctx context.Context, params core.UpdateStandingOrderParams) (core.StandingOrder, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStandingOrder", ctx, params)
+	ret0, _ := ret[0].(core.StandingOrder)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStandingOrder indicates an expected call of UpdateStandingOrder.
+func (mr *MockStandingOrderRepositoryMockRecorder) UpdateStandingOrder(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStandingOrder", reflect.TypeOf((*MockStandingOrderRepository)(nil).UpdateStandingOrder), ctx, params)
+}
+
+// MockPaymentRequestRepository is a mock of PaymentRequestRepository interface.
+type MockPaymentRequestRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPaymentRequestRepositoryMockRecorder
+}
+
+// MockPaymentRequestRepositoryMockRecorder is the mock recorder for MockPaymentRequestRepository.
+type MockPaymentRequestRepositoryMockRecorder struct {
+	mock *MockPaymentRequestRepository
+}
+
+// NewMockPaymentRequestRepository creates a new mock instance.
+func NewMockPaymentRequestRepository(ctrl *gomock.Controller) *MockPaymentRequestRepository {
+	mock := &MockPaymentRequestRepository{ctrl: ctrl}
+	mock.recorder = &MockPaymentRequestRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPaymentRequestRepository) EXPECT() *MockPaymentRequestRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClosePaymentRequest mocks base method.
+func (m *MockPaymentRequestRepository) ClosePaymentRequest(ctx context.Context, requestID uuid.UUID, status core.PaymentRequestStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClosePaymentRequest", ctx, requestID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClosePaymentRequest indicates an expected call of ClosePaymentRequest.
+func (mr *MockPaymentRequestRepositoryMockRecorder) ClosePaymentRequest(ctx, requestID, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClosePaymentRequest", reflect.TypeOf((*MockPaymentRequestRepository)(nil).ClosePaymentRequest), ctx, requestID, status)
+}
+
+// CreatePaymentRequest mocks base method.
+func (m *MockPaymentRequestRepository) CreatePaymentRequest(ctx context.Context, params core.CreatePaymentRequestParams) (core.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePaymentRequest", ctx, params)
+	ret0, _ := ret[0].(core.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePaymentRequest indicates an expected call of CreatePaymentRequest.
+func (mr *MockPaymentRequestRepositoryMockRecorder) CreatePaymentRequest(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePaymentRequest", reflect.TypeOf((*MockPaymentRequestRepository)(nil).CreatePaymentRequest), ctx, params)
+}
+
+// ExpirePaymentRequests mocks base method.
+func (m *MockPaymentRequestRepository) ExpirePaymentRequests(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpirePaymentRequests", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpirePaymentRequests indicates an expected call of ExpirePaymentRequests.
+func (mr *MockPaymentRequestRepositoryMockRecorder) ExpirePaymentRequests(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpirePaymentRequests", reflect.TypeOf((*MockPaymentRequestRepository)(nil).ExpirePaymentRequests), ctx)
+}
+
+// GetIncomingPaymentRequests mocks base method.
+func (m *MockPaymentRequestRepository) GetIncomingPaymentRequests(ctx context.Context, payerID int64) ([]core.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncomingPaymentRequests", ctx, payerID)
+	ret0, _ := ret[0].([]core.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncomingPaymentRequests indicates an expected call of GetIncomingPaymentRequests.
+func (mr *MockPaymentRequestRepositoryMockRecorder) GetIncomingPaymentRequests(ctx, payerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncomingPaymentRequests", reflect.TypeOf((*MockPaymentRequestRepository)(nil).GetIncomingPaymentRequests), ctx, payerID)
+}
+
+// GetOutgoingPaymentRequests mocks base method.
+func (m *MockPaymentRequestRepository) GetOutgoingPaymentRequests(ctx context.Context, requesterID int64) ([]core.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOutgoingPaymentRequests", ctx, requesterID)
+	ret0, _ := ret[0].([]core.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOutgoingPaymentRequests indicates an expected call of GetOutgoingPaymentRequests.
+func (mr *MockPaymentRequestRepositoryMockRecorder) GetOutgoingPaymentRequests(ctx, requesterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingPaymentRequests", reflect.TypeOf((*MockPaymentRequestRepository)(nil).GetOutgoingPaymentRequests), ctx, requesterID)
+}
+
+// GetPaymentRequest mocks base method.
+func (m *MockPaymentRequestRepository) GetPaymentRequest(ctx context.Context, requestID uuid.UUID) (core.PaymentRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPaymentRequest", ctx, requestID)
+	ret0, _ := ret[0].(core.PaymentRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPaymentRequest indicates an expected call of GetPaymentRequest.
+func (mr *MockPaymentRequestRepositoryMockRecorder) GetPaymentRequest(ctx, requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaymentRequest", reflect.TypeOf((*MockPaymentRequestRepository)(nil).GetPaymentRequest), ctx, requestID)
+}
+
+// PayPaymentRequest mocks base method.
+func (m *MockPaymentRequestRepository) PayPaymentRequest(ctx context.Context, requestID uuid.UUID, fromAccountID int64, fromCard string) (core.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayPaymentRequest", ctx, requestID, fromAccountID, fromCard)
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PayPaymentRequest indicates an expected call of PayPaymentRequest.
+func (mr *MockPaymentRequestRepositoryMockRecorder) PayPaymentRequest(ctx, requestID, fromAccountID, fromCard interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayPaymentRequest", reflect.TypeOf((*MockPaymentRequestRepository)(nil).PayPaymentRequest), ctx, requestID, fromAccountID, fromCard)
+}
+
+// MockSplitRepository is a mock of SplitRepository interface.
+type MockSplitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSplitRepositoryMockRecorder
+}
+
+// MockSplitRepositoryMockRecorder is the mock recorder for MockSplitRepository.
+type MockSplitRepositoryMockRecorder struct {
+	mock *MockSplitRepository
+}
+
+// NewMockSplitRepository creates a new mock instance.
+func NewMockSplitRepository(ctrl *gomock.Controller) *MockSplitRepository {
+	mock := &MockSplitRepository{ctrl: ctrl}
+	mock.recorder = &MockSplitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSplitRepository) EXPECT() *MockSplitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateSplit mocks base method.
+func (m *MockSplitRepository) CreateSplit(ctx context.Context, params core.CreateSplitParams) (core.Split, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSplit", ctx, params)
+	ret0, _ := ret[0].(core.Split)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSplit indicates an expected call of CreateSplit.
+func (mr *MockSplitRepositoryMockRecorder) CreateSplit(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSplit", reflect.TypeOf((*MockSplitRepository)(nil).CreateSplit), ctx, params)
+}
+
+// GetSplit mocks base method.
+func (m *MockSplitRepository) GetSplit(ctx context.Context, splitID uuid.UUID) (core.Split, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSplit", ctx, splitID)
+	ret0, _ := ret[0].(core.Split)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSplit indicates an expected call of GetSplit.
+func (mr *MockSplitRepositoryMockRecorder) GetSplit(ctx, splitID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSplit", reflect.TypeOf((*MockSplitRepository)(nil).GetSplit), ctx, splitID)
+}
+
+// GetSplits mocks base method.
+func (m *MockSplitRepository) GetSplits(ctx context.Context, requesterID int64) ([]core.Split, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSplits", ctx, requesterID)
+	ret0, _ := ret[0].([]core.Split)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSplits indicates an expected call of GetSplits.
+func (mr *MockSplitRepositoryMockRecorder) GetSplits(ctx, requesterID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSplits", reflect.TypeOf((*MockSplitRepository)(nil).GetSplits), ctx, requesterID)
+}
+
+// MockLimitRepository is a mock of LimitRepository interface.
+type MockLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLimitRepositoryMockRecorder
+}
+
+// MockLimitRepositoryMockRecorder is the mock recorder for MockLimitRepository.
+type MockLimitRepositoryMockRecorder struct {
+	mock *MockLimitRepository
+}
+
+// NewMockLimitRepository creates a new mock instance.
+func NewMockLimitRepository(ctrl *gomock.Controller) *MockLimitRepository {
+	mock := &MockLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLimitRepository) EXPECT() *MockLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAccountLimits mocks base method.
+func (m *MockLimitRepository) GetAccountLimits(ctx context.Context, accountID int64) ([]core.SpendingLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountLimits", ctx, accountID)
+	ret0, _ := ret[0].([]core.SpendingLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountLimits indicates an expected call of GetAccountLimits.
+func (mr *MockLimitRepositoryMockRecorder) GetAccountLimits(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountLimits", reflect.TypeOf((*MockLimitRepository)(nil).GetAccountLimits), ctx, accountID)
+}
+
+// GetCardLimits mocks base method.
+func (m *MockLimitRepository) GetCardLimits(ctx context.Context, cardNumber string) ([]core.SpendingLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCardLimits", ctx, cardNumber)
+	ret0, _ := ret[0].([]core.SpendingLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCardLimits indicates an expected call of GetCardLimits.
+func (mr *MockLimitRepositoryMockRecorder) GetCardLimits(ctx, cardNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCardLimits", reflect.TypeOf((*MockLimitRepository)(nil).GetCardLimits), ctx, cardNumber)
+}
+
+// GetSpending mocks base method.
+func (m *MockLimitRepository) GetSpending(ctx context.Context, limit core.SpendingLimit, now time.Time) (core.Spending, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpending", ctx, limit, now)
+	ret0, _ := ret[0].(core.Spending)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpending indicates an expected call of GetSpending.
+func (mr *MockLimitRepositoryMockRecorder) GetSpending(ctx, limit, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpending", reflect.TypeOf((*MockLimitRepository)(nil).GetSpending), ctx, limit, now)
+}
+
+// GetTransactionLimits mocks base method.
+func (m *MockLimitRepository) GetTransactionLimits(ctx context.Context, t core.TransactionType, accountID int64, cardNumber string) ([]core.SpendingLimit, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransactionLimits", ctx, t, accountID, cardNumber)
+	ret0, _ := ret[0].([]core.SpendingLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransactionLimits indicates an expected call of GetTransactionLimits.
+func (mr *MockLimitRepositoryMockRecorder) GetTransactionLimits(ctx, t, accountID, cardNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionLimits", reflect.TypeOf((*MockLimitRepository)(nil).GetTransactionLimits), ctx, t, accountID, cardNumber)
+}
+
+// SetLimit mocks base method.
+func (m *MockLimitRepository) SetLimit(ctx context.Context, limit core.SpendingLimit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLimit", ctx, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLimit indicates an expected call of SetLimit.
+func (mr *MockLimitRepositoryMockRecorder) SetLimit(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLimit", reflect.TypeOf((*MockLimitRepository)(nil).SetLimit), ctx, limit)
+}
+
+// MockBudgetRepository is a mock of BudgetRepository interface.
+type MockBudgetRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBudgetRepositoryMockRecorder
+}
+
+// MockBudgetRepositoryMockRecorder is the mock recorder for MockBudgetRepository.
+type MockBudgetRepositoryMockRecorder struct {
+	mock *MockBudgetRepository
+}
+
+// NewMockBudgetRepository creates a new mock instance.
+func NewMockBudgetRepository(ctrl *gomock.Controller) *MockBudgetRepository {
+	mock := &MockBudgetRepository{ctrl: ctrl}
+	mock.recorder = &MockBudgetRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBudgetRepository) EXPECT() *MockBudgetRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteBudget mocks base method.
+func (m *MockBudgetRepository) DeleteBudget(ctx context.Context, accountID int64, category core.Category) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBudget", ctx, accountID, category)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBudget indicates an expected call of DeleteBudget.
+func (mr *MockBudgetRepositoryMockRecorder) DeleteBudget(ctx, accountID, category interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBudget", reflect.TypeOf((*MockBudgetRepository)(nil).DeleteBudget), ctx, accountID, category)
+}
+
+// GetAccountBudgets mocks base method.
+func (m *MockBudgetRepository) GetAccountBudgets(ctx context.Context, accountID int64) ([]core.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountBudgets", ctx, accountID)
+	ret0, _ := ret[0].([]core.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountBudgets indicates an expected call of GetAccountBudgets.
+func (mr *MockBudgetRepositoryMockRecorder) GetAccountBudgets(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountBudgets", reflect.TypeOf((*MockBudgetRepository)(nil).GetAccountBudgets), ctx, accountID)
+}
+
+// GetBudgets mocks base method.
+func (m *MockBudgetRepository) GetBudgets(ctx context.Context, afterAccountID int64, limit int32) ([]core.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBudgets", ctx, afterAccountID, limit)
+	ret0, _ := ret[0].([]core.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBudgets indicates an expected call of GetBudgets.
+func (mr *MockBudgetRepositoryMockRecorder) GetBudgets(ctx, afterAccountID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBudgets", reflect.TypeOf((*MockBudgetRepository)(nil).GetBudgets), ctx, afterAccountID, limit)
+}
+
+// SetBudget mocks base method.
+func (m *MockBudgetRepository) SetBudget(ctx context.Context, params core.SetBudgetParams) (core.Budget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBudget", ctx, params)
+	ret0, _ := ret[0].(core.Budget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetBudget indicates an expected call of SetBudget.
+func (mr *MockBudgetRepositoryMockRecorder) SetBudget(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBudget", reflect.TypeOf((*MockBudgetRepository)(nil).SetBudget), ctx, params)
+}
+
+// SetBudgetAlerted mocks base method.
+func (m *MockBudgetRepository) SetBudgetAlerted(ctx context.Context, accountID int64, category core.Category, month time.Time, threshold int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetBudgetAlerted", ctx, accountID, category, month, threshold)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetBudgetAlerted indicates an expected call of SetBudgetAlerted.
+func (mr *MockBudgetRepositoryMockRecorder) SetBudgetAlerted(ctx, accountID, category, month, threshold interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetBudgetAlerted", reflect.TypeOf((*MockBudgetRepository)(nil).SetBudgetAlerted), ctx, accountID, category, month, threshold)
+}
+
+// MockPotRepository is a mock of PotRepository interface.
+type MockPotRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPotRepositoryMockRecorder
+}
+
+// MockPotRepositoryMockRecorder is the mock recorder for MockPotRepository.
+type MockPotRepositoryMockRecorder struct {
+	mock *MockPotRepository
+}
+
+// NewMockPotRepository creates a new mock instance.
+func NewMockPotRepository(ctrl *gomock.Controller) *MockPotRepository {
+	mock := &MockPotRepository{ctrl: ctrl}
+	mock.recorder = &MockPotRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPotRepository) EXPECT() *MockPotRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreatePot mocks base method.
+func (m *MockPotRepository) CreatePot(ctx context.Context, params core.CreatePotParams) (core.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePot", ctx, params)
+	ret0, _ := ret[0].(core.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePot indicates an expected call of CreatePot.
+func (mr *MockPotRepositoryMockRecorder) CreatePot(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePot", reflect.TypeOf((*MockPotRepository)(nil).CreatePot), ctx, params)
+}
+
+// DeletePot mocks base method.
+func (m *MockPotRepository) DeletePot(ctx context.Context, potID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePot", ctx, potID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePot indicates an expected call of DeletePot.
+func (mr *MockPotRepositoryMockRecorder) DeletePot(ctx, potID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePot", reflect.TypeOf((*MockPotRepository)(nil).DeletePot), ctx, potID)
+}
+
+// GetAccountPots mocks base method.
+func (m *MockPotRepository) GetAccountPots(ctx context.Context, accountID int64) ([]core.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAccountPots", ctx, accountID)
+	ret0, _ := ret[0].([]core.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAccountPots indicates an expected call of GetAccountPots.
+func (mr *MockPotRepositoryMockRecorder) GetAccountPots(ctx, accountID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountPots", reflect.TypeOf((*MockPotRepository)(nil).GetAccountPots), ctx, accountID)
+}
+
+// GetPot mocks base method.
+func (m *MockPotRepository) GetPot(ctx context.Context, potID uuid.UUID) (core.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPot", ctx, potID)
+	ret0, _ := ret[0].(core.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPot indicates an expected call of GetPot.
+func (mr *MockPotRepositoryMockRecorder) GetPot(ctx, potID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPot", reflect.TypeOf((*MockPotRepository)(nil).GetPot), ctx, potID)
+}
+
+// GetUserPots mocks base method.
+func (m *MockPotRepository) GetUserPots(ctx context.Context, userID int64) ([]core.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPots", ctx, userID)
+	ret0, _ := ret[0].([]core.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserPots indicates an expected call of GetUserPots.
+func (mr *MockPotRepositoryMockRecorder) GetUserPots(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPots", reflect.TypeOf((*MockPotRepository)(nil).GetUserPots), ctx, userID)
+}
+
+// MovePotMoney mocks base method.
+func (m *MockPotRepository) MovePotMoney(ctx context.Context, params core.MovePotMoneyParams) (core.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MovePotMoney", ctx, params)
+	ret0, _ := ret[0].(core.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MovePotMoney indicates an expected call of MovePotMoney.
+func (mr *MockPotRepositoryMockRecorder) MovePotMoney(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MovePotMoney", reflect.TypeOf((*MockPotRepository)(nil).MovePotMoney), ctx, params)
+}
+
+// UpdatePot mocks base method.
+func (m *MockPotRepository) UpdatePot(ctx context.Context, params core.UpdatePotParams) (core.Pot, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePot", ctx, params)
+	ret0, _ := ret[0].(core.Pot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePot indicates an expected call of UpdatePot.
+func (mr *MockPotRepositoryMockRecorder) UpdatePot(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePot", reflect.TypeOf((*MockPotRepository)(nil).UpdatePot), ctx, params)
+}
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Reconcile mocks base method.
+func (m *MockLedgerRepository) Reconcile(ctx context.Context) ([]core.LedgerMismatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile", ctx)
+	ret0, _ := ret[0].([]core.LedgerMismatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockLedgerRepositoryMockRecorder) Reconcile(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockLedgerRepository)(nil).Reconcile), ctx)
+}
+
+// MockExchangeRateProvider is a mock of ExchangeRateProvider interface.
+type MockExchangeRateProvider struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateProviderMockRecorder
+}
+
+// MockExchangeRateProviderMockRecorder is the mock recorder for MockExchangeRateProvider.
+type MockExchangeRateProviderMockRecorder struct {
+	mock *MockExchangeRateProvider
+}
+
+// NewMockExchangeRateProvider creates a new mock instance.
+func NewMockExchangeRateProvider(ctrl *gomock.Controller) *MockExchangeRateProvider {
+	mock := &MockExchangeRateProvider{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateProviderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateProvider) EXPECT() *MockExchangeRateProviderMockRecorder {
+	return m.recorder
+}
+
+// GetRate mocks base method.
+func (m *MockExchangeRateProvider) GetRate(ctx context.Context, from, to core.Currency) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRate", ctx, from, to)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRate indicates an expected call of GetRate.
+func (mr *MockExchangeRateProviderMockRecorder) GetRate(ctx, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRate", reflect.TypeOf((*MockExchangeRateProvider)(nil).GetRate), ctx, from, to)
+}
+
+// MockUserDirectory is a mock of UserDirectory interface.
+type MockUserDirectory struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDirectoryMockRecorder
+}
+
+// MockUserDirectoryMockRecorder is the mock recorder for MockUserDirectory.
+type MockUserDirectoryMockRecorder struct {
+	mock *MockUserDirectory
+}
+
+// NewMockUserDirectory creates a new mock instance.
+func NewMockUserDirectory(ctrl *gomock.Controller) *MockUserDirectory {
+	mock := &MockUserDirectory{ctrl: ctrl}
+	mock.recorder = &MockUserDirectoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDirectory) EXPECT() *MockUserDirectoryMockRecorder {
+	return m.recorder
+}
+
+// LookupUser mocks base method.
+func (m *MockUserDirectory) LookupUser(ctx context.Context, params core.LookupUserParams) (core.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupUser", ctx, params)
+	ret0, _ := ret[0].(core.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupUser indicates an expected call of LookupUser.
+func (mr *MockUserDirectoryMockRecorder) LookupUser(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupUser", reflect.TypeOf((*MockUserDirectory)(nil).LookupUser), ctx, params)
+}
+
+// MockCardNumberGenerator is a mock of CardNumberGenerator interface.
+type MockCardNumberGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCardNumberGeneratorMockRecorder
+}
+
+// MockCardNumberGeneratorMockRecorder is the mock recorder for MockCardNumberGenerator.
+type MockCardNumberGeneratorMockRecorder struct {
+	mock *MockCardNumberGenerator
+}
+
+// NewMockCardNumberGenerator creates a new mock instance.
+func NewMockCardNumberGenerator(ctrl *gomock.Controller) *MockCardNumberGenerator {
+	mock := &MockCardNumberGenerator{ctrl: ctrl}
+	mock.recorder = &MockCardNumberGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCardNumberGenerator) EXPECT() *MockCardNumberGeneratorMockRecorder {
+	return m.recorder
+}
+
+// GenCVV mocks base method.
+func (m *MockCardNumberGenerator) GenCVV(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenCVV", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenCVV indicates an expected call of GenCVV.
+func (mr *MockCardNumberGeneratorMockRecorder) GenCVV(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenCVV", reflect.TypeOf((*MockCardNumberGenerator)(nil).GenCVV), ctx)
+}
+
+// GenCardNumber mocks base method.
+func (m *MockCardNumberGenerator) GenCardNumber(ctx context.Context) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenCardNumber", ctx)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenCardNumber indicates an expected call of GenCardNumber.
+func (mr *MockCardNumberGeneratorMockRecorder) GenCardNumber(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenCardNumber", reflect.TypeOf((*MockCardNumberGenerator)(nil).GenCardNumber), ctx)
+}
+
+// MockSecretHasher is a mock of SecretHasher interface.
+type MockSecretHasher struct {
+	ctrl     *gomock.Controller
+	recorder *MockSecretHasherMockRecorder
+}
+
+// MockSecretHasherMockRecorder is the mock recorder for MockSecretHasher.
+type MockSecretHasherMockRecorder struct {
+	mock *MockSecretHasher
+}
+
+// NewMockSecretHasher creates a new mock instance.
+func NewMockSecretHasher(ctrl *gomock.Controller) *MockSecretHasher {
+	mock := &MockSecretHasher{ctrl: ctrl}
+	mock.recorder = &MockSecretHasherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSecretHasher) EXPECT() *MockSecretHasherMockRecorder {
+	return m.recorder
+}
+
+// Compare mocks base method.
+func (m *MockSecretHasher) Compare(ctx context.Context, hash, secret string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compare", ctx, hash, secret)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// Compare indicates an expected call of Compare.
+func (mr *MockSecretHasherMockRecorder) Compare(ctx, hash, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compare", reflect.TypeOf((*MockSecretHasher)(nil).Compare), ctx, hash, secret)
+}
+
+// Hash mocks base method.
+func (m *MockSecretHasher) Hash(ctx context.Context, secret string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Hash", ctx, secret)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Hash indicates an expected call of Hash.
+func (mr *MockSecretHasherMockRecorder) Hash(ctx, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockSecretHasher)(nil).Hash), ctx, secret)
+}
+
+// MockCardVault is a mock of CardVault interface.
+type MockCardVault struct {
+	ctrl     *gomock.Controller
+	recorder *MockCardVaultMockRecorder
+}
+
+// MockCardVaultMockRecorder is the mock recorder for MockCardVault.
+type MockCardVaultMockRecorder struct {
+	mock *MockCardVault
+}
+
+// NewMockCardVault creates a new mock instance.
+func NewMockCardVault(ctrl *gomock.Controller) *MockCardVault {
+	mock := &MockCardVault{ctrl: ctrl}
+	mock.recorder = &MockCardVaultMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCardVault) EXPECT() *MockCardVaultMockRecorder {
+	return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockCardVault) Decrypt(ctx context.Context, token string, encrypted core.EncryptedCardNumber) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, token, encrypted)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockCardVaultMockRecorder) Decrypt(ctx, token, encrypted interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockCardVault)(nil).Decrypt), ctx, token, encrypted)
+}
+
+// Encrypt mocks base method.
+func (m *MockCardVault) Encrypt(ctx context.Context, number string) (core.EncryptedCardNumber, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, number)
+	ret0, _ := ret[0].(core.EncryptedCardNumber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockCardVaultMockRecorder) Encrypt(ctx, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockCardVault)(nil).Encrypt), ctx, number)
+}
+
+// Token mocks base method.
+func (m *MockCardVault) Token(ctx context.Context, number string) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Token", ctx, number)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// Token indicates an expected call of Token.
+func (mr *MockCardVaultMockRecorder) Token(ctx, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockCardVault)(nil).Token), ctx, number)
+}
+
+// MockStatementRenderer is a mock of StatementRenderer interface.
+type MockStatementRenderer struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatementRendererMockRecorder
+}
+
+// MockStatementRendererMockRecorder is the mock recorder for MockStatementRenderer.
+type MockStatementRendererMockRecorder struct {
+	mock *MockStatementRenderer
+}
+
+// NewMockStatementRenderer creates a new mock instance.
+func NewMockStatementRenderer(ctrl *gomock.Controller) *MockStatementRenderer {
+	mock := &MockStatementRenderer{ctrl: ctrl}
+	mock.recorder = &MockStatementRendererMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatementRenderer) EXPECT() *MockStatementRendererMockRecorder {
+	return m.recorder
+}
+
+// Render mocks base method.
+func (m *MockStatementRenderer) Render(ctx context.Context, statement core.Statement, format core.StatementFormat) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Render", ctx, statement, format)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Render indicates an expected call of Render.
+func (mr *MockStatementRendererMockRecorder) Render(ctx, statement, format interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Render", reflect.TypeOf((*MockStatementRenderer)(nil).Render), ctx, statement, format)
+}
+
+// MockMessageProducer is a mock of MessageProducer interface.
+type MockMessageProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageProducerMockRecorder
+}
+
+// MockMessageProducerMockRecorder is the mock recorder for MockMessageProducer.
+type MockMessageProducerMockRecorder struct {
+	mock *MockMessageProducer
+}
+
+// NewMockMessageProducer creates a new mock instance.
+func NewMockMessageProducer(ctrl *gomock.Controller) *MockMessageProducer {
+	mock := &MockMessageProducer{ctrl: ctrl}
+	mock.recorder = &MockMessageProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageProducer) EXPECT() *MockMessageProducerMockRecorder {
+	return m.recorder
+}
+
+// SendBudgetAlertMessage mocks base method.
+func (m *MockMessageProducer) SendBudgetAlertMessage(ctx context.Context, params core.BudgetAlertMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendBudgetAlertMessage", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendBudgetAlertMessage indicates an expected call of SendBudgetAlertMessage.
+func (mr *MockMessageProducerMockRecorder) SendBudgetAlertMessage(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendBudgetAlertMessage", reflect.TypeOf((*MockMessageProducer)(nil).SendBudgetAlertMessage), ctx, params)
+}
+
+// SendPaymentRequestMessage mocks base method.
+func (m *MockMessageProducer) SendPaymentRequestMessage(ctx context.Context, params core.PaymentRequestMessage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendPaymentRequestMessage", ctx, params)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendPaymentRequestMessage indicates an expected call of SendPaymentRequestMessage.
+func (mr *MockMessageProducerMockRecorder) SendPaymentRequestMessage(ctx, params interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPaymentRequestMessage", reflect.TypeOf((*MockMessageProducer)(nil).SendPaymentRequestMessage), ctx, params)
 }
 
 // MockSmsSender is a mock of SmsSender interface.